@@ -1,11 +1,9 @@
 package tonlib
 
-//#cgo linux CFLAGS: -I./lib/linux
-//#cgo darwin CFLAGS: -I./lib/darwin
-//#cgo linux LDFLAGS: -L./lib/linux -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
-//#cgo darwin LDFLAGS: -L./lib/darwin -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
-//#include <stdlib.h>
-//#include <./lib/tonlib_client_json.h>
+// the tonlib libraries bundled with the package are linked here, transport of v2 calls them
+
+//#cgo linux LDFLAGS: -L${SRCDIR}/lib/linux -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
+//#cgo darwin LDFLAGS: -L${SRCDIR}/lib/darwin -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
 import "C"
 import (
 	"encoding/json"
@@ -13,7 +11,9 @@ import (
 	"math/rand"
 	"sync"
 	"time"
-	"unsafe"
+
+	"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
+	"github.com/mercuryoio/tonlib-go/v2/transport"
 )
 
 const (
@@ -21,6 +21,7 @@ const (
 	DefaultRetries  = 10
 )
 
+//tlgen:type InputKey
 type InputKey struct {
 	Type          string        `json:"@type"`
 	LocalPassword string        `json:"local_password"`
@@ -31,6 +32,7 @@ type TONPrivateKey struct {
 	Secret    string `json:"secret"`
 }
 
+//tlgen:type SyncState
 type SyncState struct {
 	Type         string `json:"@type"`
 	FromSeqno    int    `json:"from_seqno"`
@@ -39,11 +41,15 @@ type SyncState struct {
 }
 
 // KeyStoreType directory
+//tlgen:type KeyStoreType
 type KeyStoreType struct {
 	Type      string `json:"@type"`
 	Directory string `json:"directory"`
 }
 
+// TonlibError is an error response of tonlib
+type TonlibError = tonlibjson.Error
+
 // TONResponse alias for use in TONResult
 type TONResponse map[string]interface{}
 
@@ -55,9 +61,9 @@ type TONResult struct {
 
 // Client is the Telegram TdLib client
 type Client struct {
-	client  unsafe.Pointer
-	config  Config
-	timeout int64
+	transport *transport.Transport
+	config    Config
+	timeout   int64
 	// wallet *TonWallet
 }
 
@@ -70,7 +76,7 @@ type TonInitRequest struct {
 func NewClient(tonCnf *TonInitRequest, config Config, timeout int64) (*Client, error) {
 	rand.Seed(time.Now().UnixNano())
 
-	client := Client{client: C.tonlib_client_json_create(), config: config, timeout: timeout}
+	client := Client{transport: transport.New(), config: config, timeout: timeout}
 	optionsInfo, err := client.Init(&tonCnf.Options)
 	//resp, err := client.executeAsynchronously(tonCnf)
 	if err != nil {
@@ -93,26 +99,14 @@ func (client *Client) executeAsynchronously(data interface{}) (*TONResult, error
 	if err != nil {
 		return &TONResult{}, err
 	}
-	cs := C.CString(string(req))
-	defer C.free(unsafe.Pointer(cs))
-
 	fmt.Println("call", string(req))
-	C.tonlib_client_json_send(client.client, cs)
-	result := C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
-
-	num := 0
-	for result == nil {
-		if num >= DefaultRetries {
-			return &TONResult{}, fmt.Errorf("Client.executeAsynchronously: exided limit of retries to get json response from TON C`s lib. ")
-		}
-		time.Sleep(1 * time.Second)
-		result = C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
-		num += 1
+	client.transport.Send(req)
+	resB, err := client.transport.ReceiveRetrying(DEFAULT_TIMEOUT, DefaultRetries)
+	if err != nil {
+		return &TONResult{}, err
 	}
 
 	var updateData TONResponse
-	res := C.GoString(result)
-	resB := []byte(res)
 	err = json.Unmarshal(resB, &updateData)
 	fmt.Println("fetch data: ", string(resB))
 	if st, ok := updateData["@type"]; ok && st == "updateSendLiteServerQuery" {
@@ -131,7 +125,7 @@ func (client *Client) executeAsynchronously(data interface{}) (*TONResult, error
 			return &TONResult{}, err
 		}
 		fmt.Println("run sync", updateData)
-		res, err = client.Sync(syncResp.SyncState)
+		res, err := client.Sync(syncResp.SyncState)
 		if err != nil {
 			return &TONResult{}, err
 		}
@@ -154,21 +148,18 @@ execute ton-lib synchronously
 */
 func (client *Client) executeSynchronously(data interface{}) (*TONResult, error) {
 	req, _ := json.Marshal(data)
-	cs := C.CString(string(req))
-	defer C.free(unsafe.Pointer(cs))
-	result := C.tonlib_client_json_execute(client.client, cs)
+	resB := client.transport.Execute(req)
 	var updateData TONResponse
-	res := C.GoString(result)
-	resB := []byte(res)
 	err := json.Unmarshal(resB, &updateData)
 	return &TONResult{Data: updateData, Raw: resB}, err
 }
 
 func (client *Client) Destroy() {
-	C.tonlib_client_json_destroy(client.client)
+	client.transport.Destroy()
 }
 
 //sync node`s blocks to current
+//tlgen:skip sync
 func (client *Client) Sync(syncState SyncState) (string, error) {
 	data := struct {
 		Type      string    `json:"@type"`
@@ -181,22 +172,19 @@ func (client *Client) Sync(syncState SyncState) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	cs := C.CString(string(req))
-	defer C.free(unsafe.Pointer(cs))
-	C.tonlib_client_json_send(client.client, cs)
+	client.transport.Send(req)
 	for {
-		result := C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
-		for result == nil {
+		resB := client.transport.Receive(DEFAULT_TIMEOUT)
+		for resB == nil {
 			fmt.Println("empty response. next attempt")
 			time.Sleep(1 * time.Second)
-			result = C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
+			resB = client.transport.Receive(DEFAULT_TIMEOUT)
 		}
 		syncResp := struct {
 			Type      string    `json:"@type"`
 			SyncState SyncState `json:"sync_state"`
 		}{}
-		res := C.GoString(result)
-		resB := []byte(res)
+		res := string(resB)
 		err = json.Unmarshal(resB, &syncResp)
 		fmt.Println("sync result #1: ", res)
 		if err != nil {
@@ -206,13 +194,11 @@ func (client *Client) Sync(syncState SyncState) (string, error) {
 			return "", fmt.Errorf("Got an error response from ton: `%s` ", res)
 		}
 		if syncResp.SyncState.Type == "syncStateDone" {
-			result := C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
+			resB := client.transport.Receive(DEFAULT_TIMEOUT)
 			syncResp = struct {
 				Type      string    `json:"@type"`
 				SyncState SyncState `json:"sync_state"`
 			}{}
-			res := C.GoString(result)
-			resB := []byte(res)
 			err = json.Unmarshal(resB, &syncResp)
 			fmt.Println("sync result #2: ", string(resB))
 			if err != nil {
@@ -235,6 +221,7 @@ func (client *Client) Sync(syncState SyncState) (string, error) {
 // sometimes it`s respond with "@type: ok" instead of "query.fees"
 // @param id
 // @param ignoreChksig
+//tlgen:skip query.estimateFees
 func (client *Client) QueryEstimateFees(id int64, ignoreChksig bool) (*QueryFees, error) {
 	callData := struct {
 		Type         string `json:"@type"`
@@ -280,7 +267,7 @@ func (client *Client) QueryEstimateFees(id int64, ignoreChksig bool) (*QueryFees
 			}
 
 			if result.Data["@type"].(string) == "error" {
-				resultChan <- Resp{nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])}
+				resultChan <- Resp{nil, tonlibjson.ParseError(result.Raw)}
 				return
 			}
 
@@ -309,6 +296,7 @@ func (client *Client) QueryEstimateFees(id int64, ignoreChksig bool) (*QueryFees
 
 // key struct cause it strings values no bytes
 // Key
+//tlgen:type key
 type Key struct {
 	tonCommon
 	PublicKey string `json:"public_key"` //
@@ -2,10 +2,7 @@ module github.com/mercuryoio/tonlib-go
 
 go 1.13
 
-require (
-	github.com/asaskevich/govalidator v0.0.0-20190424111038-f61b66f89f4a
-	github.com/dvsekhvalnov/jose2go v0.0.0-20180829124132-7f401d37b68a // indirect
-	github.com/joho/godotenv v1.3.0 // indirect
-	github.com/shopspring/decimal v0.0.0-20180709203117-cd690d0c9e24 // indirect
-	github.com/spf13/cobra v0.0.5
-)
+require github.com/mercuryoio/tonlib-go/v2 v2.0.0
+
+// the runtime shared with v2 is taken from this repository
+replace github.com/mercuryoio/tonlib-go/v2 => ./v2
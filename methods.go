@@ -3,6 +3,8 @@ package tonlib
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
 )
 
 // Init
@@ -23,7 +25,7 @@ func (client *Client) Init(options *Options) (*OptionsInfo, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var optionsInfo OptionsInfo
@@ -47,7 +49,7 @@ func (client *Client) Close() (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -74,7 +76,7 @@ func (client *Client) OptionsSetConfig(config *Config) (*OptionsConfigInfo, erro
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var optionsConfigInfo OptionsConfigInfo
@@ -101,7 +103,7 @@ func (client *Client) OptionsValidateConfig(config *Config) (*OptionsConfigInfo,
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var optionsConfigInfo OptionsConfigInfo
@@ -134,7 +136,7 @@ func (client *Client) CreateNewKey(localPassword *SecureBytes, mnemonicPassword
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var key Key
@@ -161,7 +163,7 @@ func (client *Client) DeleteKey(key *Key) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -185,7 +187,7 @@ func (client *Client) DeleteAllKeys() (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -212,7 +214,7 @@ func (client *Client) ExportKey(inputKey *InputKey) (*ExportedKey, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var exportedKey ExportedKey
@@ -242,7 +244,7 @@ func (client *Client) ExportPemKey(inputKey *InputKey, keyPassword *SecureBytes)
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var exportedPemKey ExportedPemKey
@@ -272,7 +274,7 @@ func (client *Client) ExportEncryptedKey(inputKey *InputKey, keyPassword *Secure
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var exportedEncryptedKey ExportedEncryptedKey
@@ -305,7 +307,7 @@ func (client *Client) ImportKey(exportedKey *ExportedKey, localPassword *SecureB
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var key Key
@@ -338,7 +340,7 @@ func (client *Client) ImportPemKey(exportedKey *ExportedPemKey, keyPassword *Sec
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var keyDummy Key
@@ -371,7 +373,7 @@ func (client *Client) ImportEncryptedKey(exportedEncryptedKey *ExportedEncrypted
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var keyDummy Key
@@ -401,7 +403,7 @@ func (client *Client) ChangeLocalPassword(inputKey *InputKey, newLocalPassword *
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var key Key
@@ -431,7 +433,7 @@ func (client *Client) Encrypt(decryptedData *SecureBytes, secret *SecureBytes) (
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var data Data
@@ -461,7 +463,7 @@ func (client *Client) Decrypt(encryptedData *SecureBytes, secret *SecureBytes) (
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var data Data
@@ -494,7 +496,7 @@ func (client *Client) Kdf(iterations int32, password *SecureBytes, salt *SecureB
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var data Data
@@ -521,7 +523,7 @@ func (client *Client) UnpackAccountAddress(accountAddress string) (*UnpackedAcco
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var unpackedAccountAddress UnpackedAccountAddress
@@ -548,7 +550,7 @@ func (client *Client) PackAccountAddress(accountAddress *UnpackedAccountAddress)
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var accountAddressDummy AccountAddress
@@ -575,7 +577,7 @@ func (client *Client) GetBip39Hints(prefix string) (*Bip39Hints, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var bip39Hints Bip39Hints
@@ -602,7 +604,7 @@ func (client *Client) RawGetAccountAddress(inititalAccountState *RawInitialAccou
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var accountAddress AccountAddress
@@ -629,7 +631,7 @@ func (client *Client) RawGetAccountState(accountAddress *AccountAddress) (*RawAc
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var rawAccountState RawAccountState
@@ -659,7 +661,7 @@ func (client *Client) RawGetTransactions(accountAddress *AccountAddress, fromTra
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var rawTransactions RawTransactions
@@ -686,12 +688,12 @@ func (client *Client) RawSendMessage(body []byte) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
-	var Ok Ok
-	err = json.Unmarshal(result.Raw, &Ok)
-	return &Ok, err
+	var ok Ok
+	err = json.Unmarshal(result.Raw, &ok)
+	return &ok, err
 
 }
 
@@ -719,7 +721,7 @@ func (client *Client) RawCreateAndSendMessage(data []byte, destination *AccountA
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -755,7 +757,7 @@ func (client *Client) RawCreateQuery(body []byte, destination *AccountAddress, i
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var queryInfo QueryInfo
@@ -782,7 +784,7 @@ func (client *Client) TestWalletInit(privateKey *InputKey) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -809,7 +811,7 @@ func (client *Client) TestWalletGetAccountAddress(inititalAccountState *TestWall
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var accountAddress AccountAddress
@@ -836,7 +838,7 @@ func (client *Client) TestWalletGetAccountState(accountAddress *AccountAddress)
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var testWalletAccountState TestWalletAccountState
@@ -875,7 +877,7 @@ func (client *Client) TestWalletSendGrams(amount JSONInt64, destination *Account
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var sendGramsResult SendGramsResult
@@ -902,7 +904,7 @@ func (client *Client) WalletInit(privateKey *InputKey) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -929,7 +931,7 @@ func (client *Client) WalletGetAccountAddress(inititalAccountState *WalletInitia
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var accountAddress AccountAddress
@@ -956,7 +958,7 @@ func (client *Client) WalletGetAccountState(accountAddress *AccountAddress) (*Wa
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var walletAccountState WalletAccountState
@@ -998,7 +1000,7 @@ func (client *Client) WalletSendGrams(amount JSONInt64, destination *AccountAddr
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var sendGramsResult SendGramsResult
@@ -1025,7 +1027,7 @@ func (client *Client) WalletV3GetAccountAddress(inititalAccountState *WalletV3In
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var accountAddress AccountAddress
@@ -1049,7 +1051,7 @@ func (client *Client) TestGiverGetAccountState() (*TestGiverAccountState, error)
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var testGiverAccountState TestGiverAccountState
@@ -1073,7 +1075,7 @@ func (client *Client) TestGiverGetAccountAddress() (*AccountAddress, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var accountAddress AccountAddress
@@ -1109,7 +1111,7 @@ func (client *Client) TestGiverSendGrams(amount JSONInt64, destination *AccountA
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var sendGramsResult SendGramsResult
@@ -1136,7 +1138,7 @@ func (client *Client) GenericGetAccountState(accountAddress *AccountAddress) (*G
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var genericAccountState GenericAccountState
@@ -1181,7 +1183,7 @@ func (client *Client) GenericSendGrams(allowSendToUninited bool, amount JSONInt6
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var sendGramsResult SendGramsResult
@@ -1226,7 +1228,7 @@ func (client *Client) GenericCreateSendGramsQuery(allowSendToUninited bool, amou
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var queryInfo QueryInfo
@@ -1253,7 +1255,7 @@ func (client *Client) QuerySend(id int64) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1280,7 +1282,7 @@ func (client *Client) QueryForget(id int64) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1307,7 +1309,7 @@ func (client *Client) QueryGetInfo(id int64) (*QueryInfo, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var queryInfo QueryInfo
@@ -1334,7 +1336,7 @@ func (client *Client) SmcLoad(accountAddress *AccountAddress) (*SmcInfo, error)
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var smcInfo SmcInfo
@@ -1361,7 +1363,7 @@ func (client *Client) SmcGetCode(id int64) (*TvmCell, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var tvmCell TvmCell
@@ -1388,7 +1390,7 @@ func (client *Client) SmcGetData(id int64) (*TvmCell, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var tvmCell TvmCell
@@ -1415,7 +1417,7 @@ func (client *Client) SmcGetState(id int64) (*TvmCell, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var tvmCell TvmCell
@@ -1448,7 +1450,7 @@ func (client *Client) SmcRunGetMethod(id int64, method *SmcMethodId, stack []Tvm
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var smcRunResult SmcRunResult
@@ -1478,7 +1480,7 @@ func (client *Client) OnLiteServerQueryResult(bytes []byte, id JSONInt64) (*Ok,
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1508,7 +1510,7 @@ func (client *Client) OnLiteServerQueryError(error *Error, id JSONInt64) (*Ok, e
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1535,7 +1537,7 @@ func (client *Client) RunTests(dir string) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1559,7 +1561,7 @@ func (client *Client) LiteServerGetInfo() (*LiteServerInfo, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var liteServerInfo LiteServerInfo
@@ -1586,7 +1588,7 @@ func (client *Client) SetLogStream(logStream LogStream) (*Ok, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1610,7 +1612,7 @@ func (client *Client) GetLogStream() (LogStream, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	switch LogStreamEnum(result.Data["@type"].(string)) {
@@ -1653,7 +1655,7 @@ func (client *Client) SetLogVerbosityLevel(newVerbosityLevel int32) (*Ok, error)
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1677,7 +1679,7 @@ func (client *Client) GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var logVerbosityLevel LogVerbosityLevel
@@ -1701,7 +1703,7 @@ func (client *Client) GetLogTags() (*LogTags, error) {
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var logTags LogTags
@@ -1731,7 +1733,7 @@ func (client *Client) SetLogTagVerbosityLevel(newVerbosityLevel int32, tag strin
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -1758,7 +1760,7 @@ func (client *Client) GetLogTagVerbosityLevel(tag string) (*LogVerbosityLevel, e
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var logVerbosityLevel LogVerbosityLevel
@@ -1788,7 +1790,7 @@ func (client *Client) AddLogMessage(text string, verbosityLevel int32) (*Ok, err
 	}
 
 	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+		return nil, tonlibjson.ParseError(result.Raw)
 	}
 
 	var ok Ok
@@ -3,8 +3,8 @@ package tonlib
 import (
 	"encoding/json"
 	"fmt"
-	"strconv"
-	"strings"
+
+	"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
 )
 
 type tonCommon struct {
@@ -21,25 +21,7 @@ type TvmNumber string
 type GenericAccountState string
 
 // JSONInt64 alias for int64, in order to deal with json big number problem
-type JSONInt64 int64
-
-// MarshalJSON marshals to json
-func (jsonInt *JSONInt64) MarshalJSON() ([]byte, error) {
-	intStr := strconv.FormatInt(int64(*jsonInt), 10)
-	return []byte(intStr), nil
-}
-
-// UnmarshalJSON unmarshals from json
-func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
-	intStr := string(b)
-	intStr = strings.Replace(intStr, "\"", "", 2)
-	jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
-	if err != nil {
-		return err
-	}
-	*jsonInt = JSONInt64(jsonBigInt)
-	return nil
-}
+type JSONInt64 = tonlibjson.JSONInt64
 
 // TonMessage is the interface for all messages send and received to/from tonlib
 type TonMessage interface {
@@ -0,0 +1,144 @@
+// Package address parses and formats TON account addresses in both raw
+// ("0:83df...") and user-friendly (base64, 48 chars) forms.
+package address
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mercuryoio/tonlib-go/v2/internal/crc16"
+)
+
+const (
+	flagBounceable    = 0x11
+	flagNonBounceable = 0x51
+	flagTestnet       = 0x80
+
+	MasterchainID = -1
+	BasechainID   = 0
+)
+
+// Address is a standard internal address: workchain id and 256 bits account id
+type Address struct {
+	Workchain  int32
+	Hash       [32]byte
+	Bounceable bool
+	Testnet    bool
+}
+
+// New creates a bounceable mainnet address
+func New(workchain int32, hash []byte) (*Address, error) {
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("invalid address hash length: %d", len(hash))
+	}
+	addr := &Address{Workchain: workchain, Bounceable: true}
+	copy(addr.Hash[:], hash)
+	return addr, nil
+}
+
+// Parse parses raw or user-friendly address
+func Parse(addr string) (*Address, error) {
+	if strings.Contains(addr, ":") {
+		return ParseRaw(addr)
+	}
+	return ParseFriendly(addr)
+}
+
+// MustParse is like Parse but panics on error. It is intended for constants
+func MustParse(addr string) *Address {
+	a, err := Parse(addr)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// ParseRaw parses address in "workchain:hex" form
+func ParseRaw(addr string) (*Address, error) {
+	parts := strings.SplitN(addr, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid raw address: %s", addr)
+	}
+	workchain, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workchain in address %s: %v", addr, err)
+	}
+	hash, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid account id in address %s: %v", addr, err)
+	}
+	return New(int32(workchain), hash)
+}
+
+// ParseFriendly parses base64 (std or url-safe) user-friendly address and checks its crc
+func ParseFriendly(addr string) (*Address, error) {
+	if len(addr) != 48 {
+		return nil, fmt.Errorf("invalid address length: %s", addr)
+	}
+	data, err := base64.URLEncoding.DecodeString(addr)
+	if err != nil {
+		data, err = base64.StdEncoding.DecodeString(addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode address %s: %v", addr, err)
+		}
+	}
+	if crc16.Checksum(data[:34]) != binary.BigEndian.Uint16(data[34:]) {
+		return nil, fmt.Errorf("invalid address checksum: %s", addr)
+	}
+
+	flags := data[0]
+	result := &Address{
+		Workchain: int32(int8(data[1])),
+		Testnet:   flags&flagTestnet != 0,
+	}
+	switch flags &^ flagTestnet {
+	case flagBounceable:
+		result.Bounceable = true
+	case flagNonBounceable:
+		result.Bounceable = false
+	default:
+		return nil, fmt.Errorf("invalid address flags %x: %s", flags, addr)
+	}
+	copy(result.Hash[:], data[2:34])
+	return result, nil
+}
+
+// String returns url-safe user-friendly form of the address
+func (addr *Address) String() string {
+	data := make([]byte, 36)
+	data[0] = flagNonBounceable
+	if addr.Bounceable {
+		data[0] = flagBounceable
+	}
+	if addr.Testnet {
+		data[0] |= flagTestnet
+	}
+	data[1] = byte(int8(addr.Workchain))
+	copy(data[2:34], addr.Hash[:])
+	binary.BigEndian.PutUint16(data[34:], crc16.Checksum(data[:34]))
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// Raw returns address in "workchain:hex" form
+func (addr *Address) Raw() string {
+	return fmt.Sprintf("%d:%s", addr.Workchain, hex.EncodeToString(addr.Hash[:]))
+}
+
+// WithBounceable returns a copy of the address with the bounceable flag changed
+func (addr *Address) WithBounceable(bounceable bool) *Address {
+	result := *addr
+	result.Bounceable = bounceable
+	return &result
+}
+
+// Equal reports whether both addresses point to the same account, ignoring flags
+func (addr *Address) Equal(other *Address) bool {
+	if addr == nil || other == nil {
+		return addr == other
+	}
+	return addr.Workchain == other.Workchain && addr.Hash == other.Hash
+}
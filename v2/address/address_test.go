@@ -0,0 +1,37 @@
+package address
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	const friendly = "EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a"
+	addr, err := Parse(friendly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.Workchain != 0 || !addr.Bounceable || addr.Testnet {
+		t.Fatalf("unexpected flags: %#v", addr)
+	}
+	if addr.String() != friendly {
+		t.Fatalf("expected %s, got %s", friendly, addr.String())
+	}
+
+	raw, err := Parse(addr.Raw())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !raw.Equal(addr) {
+		t.Fatalf("raw form mismatch: %s", addr.Raw())
+	}
+
+	nonBounceable, err := Parse(addr.WithBounceable(false).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonBounceable.Bounceable || !nonBounceable.Equal(addr) {
+		t.Fatalf("unexpected non bounceable address: %#v", nonBounceable)
+	}
+
+	if _, err := Parse(friendly[:47] + "b"); err == nil {
+		t.Fatal("expected checksum error")
+	}
+}
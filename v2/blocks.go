@@ -0,0 +1,86 @@
+package v2
+
+import (
+	"context"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/config"
+)
+
+// MasterchainShard is the shard of masterchain blocks, it covers the whole workchain
+const MasterchainShard JSONInt64 = -1 << 63
+
+// BlockClient runs reads against the state of a block instead of the last one,
+// e.g. config params at the block of an account state read before:
+//
+//	state, err := client.RawGetAccountState(*NewAccountAddress(wallet))
+//	params, err := client.AtBlock(*state.BlockId).GetConfigParams(ctx)
+type BlockClient struct {
+	client *Client
+	id     TonBlockIdExt
+}
+
+// AtBlock returns client whose reads run against the state of the block, a masterchain block
+// gives the state of all accounts at it
+func (client *Client) AtBlock(id TonBlockIdExt) *BlockClient {
+	return &BlockClient{client: client, id: id}
+}
+
+// Block returns id of the block the reads run against
+func (blockClient *BlockClient) Block() TonBlockIdExt {
+	return blockClient.id
+}
+
+// GetAccountState returns state of the account at the block
+func (blockClient *BlockClient) GetAccountState(ctx context.Context, accountAddress AccountAddress) (*FullAccountState, error) {
+	return Execute[*FullAccountState](ctx, blockClient.client,
+		WithBlock[*FullAccountState](blockClient.id, &GetAccountStateRequest{AccountAddress: accountAddress}))
+}
+
+// RawGetAccountState returns raw state of the account at the block
+func (blockClient *BlockClient) RawGetAccountState(ctx context.Context, accountAddress AccountAddress) (*RawFullAccountState, error) {
+	return Execute[*RawFullAccountState](ctx, blockClient.client,
+		WithBlock[*RawFullAccountState](blockClient.id, &RawGetAccountStateRequest{AccountAddress: accountAddress}))
+}
+
+// SmcLoad loads the contract state at the block, its get methods run against it
+func (blockClient *BlockClient) SmcLoad(ctx context.Context, accountAddress AccountAddress) (*SmcInfo, error) {
+	return Execute[*SmcInfo](ctx, blockClient.client,
+		WithBlock[*SmcInfo](blockClient.id, &SmcLoadRequest{AccountAddress: accountAddress}))
+}
+
+// RunGetMethod runs get method of the contract at the block and returns its stack, non-zero exit code is an error
+func (blockClient *BlockClient) RunGetMethod(ctx context.Context, contract string, method string, params []TvmStackEntry) ([]TvmStackEntry, error) {
+	smcInfo, err := blockClient.SmcLoad(ctx, *NewAccountAddress(contract))
+	if err != nil {
+		return nil, err
+	}
+	return blockClient.client.runLoadedGetMethod(smcInfo, method, params)
+}
+
+// GetConfigParams reads the config dictionary from the config contract at the masterchain block
+func (blockClient *BlockClient) GetConfigParams(ctx context.Context) (config.Params, error) {
+	state, err := blockClient.RawGetAccountState(ctx, *NewAccountAddress(config.Address))
+	if err != nil {
+		return nil, err
+	}
+	return parseConfigState(state)
+}
+
+// GetConfigParamCell fetches value cell of the config param n at the masterchain block
+func (blockClient *BlockClient) GetConfigParamCell(ctx context.Context, n int32) (*cell.Cell, error) {
+	params, err := blockClient.GetConfigParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return params.Cell(n)
+}
+
+// GetConfigParam fetches and decodes the config param n at the masterchain block like Client.GetConfigParam
+func (blockClient *BlockClient) GetConfigParam(ctx context.Context, n int32) (interface{}, error) {
+	params, err := blockClient.GetConfigParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return params.Get(n)
+}
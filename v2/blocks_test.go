@@ -0,0 +1,20 @@
+package v2
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/config"
+)
+
+func TestConfigStateWithBlock(t *testing.T) {
+	block := *NewTonBlockIdExt(Bytes{1}, Bytes{2}, 100, MasterchainShard, -1)
+	data, err := json.Marshal(WithBlock[*RawFullAccountState](block, &RawGetAccountStateRequest{AccountAddress: *NewAccountAddress(config.Address)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(string(data), `"function":{"@type":"raw.getAccountState","account_address":{"@type":"accountAddress","account_address":"`+config.Address+`"}}}`) {
+		t.Fatalf("unexpected request: %s", data)
+	}
+}
@@ -0,0 +1,28 @@
+package v2
+
+import "github.com/mercuryoio/tonlib-go/v2/tonlibjson"
+
+// BytesFromBase64 decodes standard or url base64, with or without padding
+func BytesFromBase64(s string) (Bytes, error) {
+	return tonlibjson.BytesFromBase64(s)
+}
+
+// BytesFromHex decodes hex string
+func BytesFromHex(s string) (Bytes, error) {
+	return tonlibjson.BytesFromHex(s)
+}
+
+// Int256FromBytes copies 32 bytes to Int256
+func Int256FromBytes(b []byte) (Int256, error) {
+	return tonlibjson.Int256FromBytes(b)
+}
+
+// Int256FromBase64 decodes base64 of 32 bytes
+func Int256FromBase64(s string) (Int256, error) {
+	return tonlibjson.Int256FromBase64(s)
+}
+
+// Int256FromHex decodes hex of 32 bytes, e.g. a hash printed by explorers
+func Int256FromHex(s string) (Int256, error) {
+	return tonlibjson.Int256FromHex(s)
+}
@@ -0,0 +1,327 @@
+package cell
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+var (
+	bocMagic            = []byte{0xb5, 0xee, 0x9c, 0x72}
+	bocMagicIndexed     = []byte{0x68, 0xff, 0x65, 0xf3}
+	bocMagicIndexedCrc  = []byte{0xac, 0xc3, 0xa7, 0x28}
+	crc32cTable         = crc32.MakeTable(crc32.Castagnoli)
+	errBocUnexpectedEnd = fmt.Errorf("unexpected end of boc")
+)
+
+// ToBOC serializes the cell tree into a bag of cells with crc32c checksum
+func (c *Cell) ToBOC() []byte {
+	return ToBOC([]*Cell{c}, true)
+}
+
+// ToBOCBase64 serializes the cell tree into base64 encoded bag of cells, as tonlib expects in JSON
+func (c *Cell) ToBOCBase64() string {
+	return base64.StdEncoding.EncodeToString(c.ToBOC())
+}
+
+// ToBOC serializes several cell trees into one bag of cells
+func ToBOC(roots []*Cell, withCRC bool) []byte {
+	order := topologicalOrder(roots)
+	index := make(map[string]int, len(order))
+	for i, c := range order {
+		index[string(c.Hash())] = i
+	}
+
+	sizeBytes := bytesFor(uint64(len(order)))
+	var cellsData bytes.Buffer
+	for _, c := range order {
+		d1, d2 := c.descriptors()
+		cellsData.WriteByte(d1)
+		cellsData.WriteByte(d2)
+		cellsData.Write(c.paddedData())
+		for _, ref := range c.refs {
+			cellsData.Write(uintBytes(uint64(index[string(ref.Hash())]), sizeBytes))
+		}
+	}
+	offBytes := bytesFor(uint64(cellsData.Len()))
+
+	var boc bytes.Buffer
+	boc.Write(bocMagic)
+	flags := byte(sizeBytes)
+	if withCRC {
+		flags |= 0x40
+	}
+	boc.WriteByte(flags)
+	boc.WriteByte(byte(offBytes))
+	boc.Write(uintBytes(uint64(len(order)), sizeBytes))
+	boc.Write(uintBytes(uint64(len(roots)), sizeBytes))
+	boc.Write(uintBytes(0, sizeBytes))
+	boc.Write(uintBytes(uint64(cellsData.Len()), offBytes))
+	for _, root := range roots {
+		boc.Write(uintBytes(uint64(index[string(root.Hash())]), sizeBytes))
+	}
+	boc.Write(cellsData.Bytes())
+
+	if withCRC {
+		crc := make([]byte, 4)
+		binary.LittleEndian.PutUint32(crc, crc32.Checksum(boc.Bytes(), crc32cTable))
+		boc.Write(crc)
+	}
+	return boc.Bytes()
+}
+
+// FromBOC parses a bag of cells with exactly one root
+func FromBOC(data []byte) (*Cell, error) {
+	roots, err := FromBOCMultiRoot(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) != 1 {
+		return nil, fmt.Errorf("expected 1 root cell, got %d", len(roots))
+	}
+	return roots[0], nil
+}
+
+// FromBOCBase64 parses base64 encoded bag of cells with exactly one root
+func FromBOCBase64(data string) (*Cell, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	return FromBOC(raw)
+}
+
+// FromBOCMultiRoot parses a bag of cells and returns all its roots
+func FromBOCMultiRoot(data []byte) ([]*Cell, error) {
+	if len(data) < 6 {
+		return nil, errBocUnexpectedEnd
+	}
+	magic := data[:4]
+	hasIndex, hasCRC := false, false
+	var sizeBytes, offBytes int
+	switch {
+	case bytes.Equal(magic, bocMagic):
+		hasIndex = data[4]&0x80 != 0
+		hasCRC = data[4]&0x40 != 0
+		sizeBytes = int(data[4] & 0x07)
+	case bytes.Equal(magic, bocMagicIndexed):
+		hasIndex = true
+		sizeBytes = int(data[4])
+	case bytes.Equal(magic, bocMagicIndexedCrc):
+		hasIndex, hasCRC = true, true
+		sizeBytes = int(data[4])
+	default:
+		return nil, fmt.Errorf("unknown boc magic: %x", magic)
+	}
+	offBytes = int(data[5])
+	if sizeBytes < 1 || sizeBytes > 4 || offBytes < 1 || offBytes > 8 {
+		return nil, fmt.Errorf("invalid boc header sizes: %d, %d", sizeBytes, offBytes)
+	}
+
+	if hasCRC {
+		if len(data) < 10 {
+			return nil, errBocUnexpectedEnd
+		}
+		body := data[:len(data)-4]
+		if crc32.Checksum(body, crc32cTable) != binary.LittleEndian.Uint32(data[len(data)-4:]) {
+			return nil, fmt.Errorf("boc crc32c mismatch")
+		}
+		data = body
+	}
+
+	r := &bocReader{data: data, pos: 6}
+	cellsCount, err := r.uint(sizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	rootsCount, err := r.uint(sizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.uint(sizeBytes); err != nil { // absent cells
+		return nil, err
+	}
+	totalSize, err := r.uint(offBytes)
+	if err != nil {
+		return nil, err
+	}
+	if cellsCount == 0 || rootsCount > cellsCount {
+		return nil, fmt.Errorf("invalid boc cells count: %d, roots: %d", cellsCount, rootsCount)
+	}
+
+	rootIndexes := make([]int, rootsCount)
+	if bytes.Equal(magic, bocMagic) {
+		for i := range rootIndexes {
+			idx, err := r.uint(sizeBytes)
+			if err != nil {
+				return nil, err
+			}
+			rootIndexes[i] = int(idx)
+		}
+	}
+	if hasIndex {
+		if _, err := r.bytes(int(cellsCount) * offBytes); err != nil {
+			return nil, err
+		}
+	}
+	cellsData, err := r.bytes(int(totalSize))
+	if err != nil {
+		return nil, err
+	}
+
+	cells := make([]*Cell, cellsCount)
+	refIndexes := make([][]int, cellsCount)
+	cr := &bocReader{data: cellsData}
+	for i := range cells {
+		c, refs, err := cr.cell(sizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cell %d: %v", i, err)
+		}
+		cells[i] = c
+		refIndexes[i] = refs
+	}
+	// references always point forward, so resolve them from the end
+	for i := len(cells) - 1; i >= 0; i-- {
+		for _, ref := range refIndexes[i] {
+			if ref <= i || ref >= len(cells) {
+				return nil, fmt.Errorf("invalid reference %d in cell %d", ref, i)
+			}
+			cells[i].refs = append(cells[i].refs, cells[ref])
+		}
+	}
+
+	roots := make([]*Cell, rootsCount)
+	for i, idx := range rootIndexes {
+		if idx >= len(cells) {
+			return nil, fmt.Errorf("invalid root index %d", idx)
+		}
+		roots[i] = cells[idx]
+	}
+	return roots, nil
+}
+
+type bocReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bocReader) bytes(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.data) {
+		return nil, errBocUnexpectedEnd
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *bocReader) uint(n int) (uint64, error) {
+	b, err := r.bytes(n)
+	if err != nil {
+		return 0, err
+	}
+	var value uint64
+	for _, v := range b {
+		value = value<<8 | uint64(v)
+	}
+	return value, nil
+}
+
+func (r *bocReader) cell(sizeBytes int) (*Cell, []int, error) {
+	d, err := r.bytes(2)
+	if err != nil {
+		return nil, nil, err
+	}
+	d1, d2 := d[0], d[1]
+	refsCount := int(d1 & 0x07)
+	if refsCount > MaxRefs {
+		return nil, nil, fmt.Errorf("too many references: %d", refsCount)
+	}
+	if d1&0x10 != 0 {
+		return nil, nil, fmt.Errorf("cells with stored hashes are not supported")
+	}
+	// hashes of exotic cells and cells with a level are calculated differently
+	if d1&0x08 != 0 {
+		return nil, nil, fmt.Errorf("exotic cells are not supported")
+	}
+	if level := d1 >> 5; level != 0 {
+		return nil, nil, fmt.Errorf("cells with level %d are not supported", level)
+	}
+	dataLen := (int(d2) + 1) / 2
+	data, err := r.bytes(dataLen)
+	if err != nil {
+		return nil, nil, err
+	}
+	bitsLen := dataLen * 8
+	payload := make([]byte, dataLen)
+	copy(payload, data)
+	if d2%2 == 1 {
+		// strip the completion tag
+		last := payload[dataLen-1]
+		if last == 0 {
+			return nil, nil, fmt.Errorf("missing completion tag")
+		}
+		trailing := 0
+		for last&1 == 0 {
+			last >>= 1
+			trailing++
+		}
+		bitsLen = (dataLen-1)*8 + 7 - trailing
+		payload[dataLen-1] &^= 1 << uint(trailing)
+	}
+
+	refs := make([]int, refsCount)
+	for i := range refs {
+		idx, err := r.uint(sizeBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		refs[i] = int(idx)
+	}
+	return &Cell{data: payload, bitsLen: bitsLen}, refs, nil
+}
+
+// topologicalOrder returns unique cells of the trees ordered so every reference points forward
+func topologicalOrder(roots []*Cell) []*Cell {
+	visited := map[string]bool{}
+	var reversed []*Cell
+	var visit func(c *Cell)
+	visit = func(c *Cell) {
+		key := string(c.Hash())
+		if visited[key] {
+			return
+		}
+		visited[key] = true
+		for i := len(c.refs) - 1; i >= 0; i-- {
+			visit(c.refs[i])
+		}
+		reversed = append(reversed, c)
+	}
+	for i := len(roots) - 1; i >= 0; i-- {
+		visit(roots[i])
+	}
+
+	order := make([]*Cell, len(reversed))
+	for i, c := range reversed {
+		order[len(reversed)-1-i] = c
+	}
+	return order
+}
+
+func bytesFor(value uint64) int {
+	n := 1
+	for value >= 1<<(8*uint(n)) && n < 8 {
+		n++
+	}
+	return n
+}
+
+func uintBytes(value uint64, n int) []byte {
+	b := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		b[i] = byte(value)
+		value >>= 8
+	}
+	return b
+}
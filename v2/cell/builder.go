@@ -0,0 +1,248 @@
+package cell
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+)
+
+// Builder accumulates bits and references of a new cell
+type Builder struct {
+	data    []byte
+	bitsLen int
+	refs    []*Cell
+}
+
+// NewBuilder creates an empty builder
+func NewBuilder() *Builder {
+	return &Builder{data: make([]byte, 0, (MaxBits+7)/8)}
+}
+
+// BitsLen returns amount of already stored bits
+func (b *Builder) BitsLen() int {
+	return b.bitsLen
+}
+
+// BitsLeft returns amount of bits that can still be stored
+func (b *Builder) BitsLeft() int {
+	return MaxBits - b.bitsLen
+}
+
+// RefsLeft returns amount of references that can still be stored
+func (b *Builder) RefsLeft() int {
+	return MaxRefs - len(b.refs)
+}
+
+func (b *Builder) appendBit(bit bool) {
+	if b.bitsLen%8 == 0 {
+		b.data = append(b.data, 0)
+	}
+	if bit {
+		b.data[b.bitsLen/8] |= 1 << uint(7-b.bitsLen%8)
+	}
+	b.bitsLen++
+}
+
+func (b *Builder) checkBits(bits int) error {
+	if bits < 0 {
+		return fmt.Errorf("negative bits amount: %d", bits)
+	}
+	if b.bitsLen+bits > MaxBits {
+		return fmt.Errorf("cell overflow: %d bits stored, %d more requested", b.bitsLen, bits)
+	}
+	return nil
+}
+
+// StoreBit stores a single bit
+func (b *Builder) StoreBit(bit bool) error {
+	if err := b.checkBits(1); err != nil {
+		return err
+	}
+	b.appendBit(bit)
+	return nil
+}
+
+// StoreUInt stores unsigned integer in bits bits
+func (b *Builder) StoreUInt(value uint64, bits int) error {
+	if bits > 64 {
+		return b.StoreBigUInt(new(big.Int).SetUint64(value), bits)
+	}
+	if bits < 64 && value>>uint(bits) != 0 {
+		return fmt.Errorf("value %d doesn't fit in %d bits", value, bits)
+	}
+	if err := b.checkBits(bits); err != nil {
+		return err
+	}
+	for i := bits - 1; i >= 0; i-- {
+		b.appendBit(value>>uint(i)&1 == 1)
+	}
+	return nil
+}
+
+// StoreInt stores signed integer in bits bits
+func (b *Builder) StoreInt(value int64, bits int) error {
+	return b.StoreBigInt(big.NewInt(value), bits)
+}
+
+// StoreBigUInt stores unsigned big integer in bits bits
+func (b *Builder) StoreBigUInt(value *big.Int, bits int) error {
+	if value.Sign() < 0 {
+		return fmt.Errorf("negative value %s for unsigned integer", value)
+	}
+	if value.BitLen() > bits {
+		return fmt.Errorf("value %s doesn't fit in %d bits", value, bits)
+	}
+	if err := b.checkBits(bits); err != nil {
+		return err
+	}
+	for i := bits - 1; i >= 0; i-- {
+		b.appendBit(value.Bit(i) == 1)
+	}
+	return nil
+}
+
+// StoreBigInt stores signed big integer in bits bits using two's complement
+func (b *Builder) StoreBigInt(value *big.Int, bits int) error {
+	if bits == 0 {
+		if value.Sign() != 0 {
+			return fmt.Errorf("value %s doesn't fit in 0 bits", value)
+		}
+		return nil
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	if value.Cmp(limit) >= 0 || value.Cmp(new(big.Int).Neg(limit)) < 0 {
+		return fmt.Errorf("value %s doesn't fit in %d bits", value, bits)
+	}
+	unsigned := new(big.Int).Set(value)
+	if value.Sign() < 0 {
+		unsigned.Add(unsigned, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	}
+	return b.StoreBigUInt(unsigned, bits)
+}
+
+// StoreBytes stores whole bytes
+func (b *Builder) StoreBytes(data []byte) error {
+	return b.StoreBits(data, len(data)*8)
+}
+
+// StoreBits stores first bits bits of data
+func (b *Builder) StoreBits(data []byte, bits int) error {
+	if bits > len(data)*8 {
+		return fmt.Errorf("not enough data for %d bits", bits)
+	}
+	if err := b.checkBits(bits); err != nil {
+		return err
+	}
+	for i := 0; i < bits; i++ {
+		b.appendBit(data[i/8]>>uint(7-i%8)&1 == 1)
+	}
+	return nil
+}
+
+// StoreRef stores a reference to the cell
+func (b *Builder) StoreRef(c *Cell) error {
+	if c == nil {
+		return fmt.Errorf("nil reference")
+	}
+	if len(b.refs) >= MaxRefs {
+		return fmt.Errorf("cell overflow: too many references")
+	}
+	b.refs = append(b.refs, c)
+	return nil
+}
+
+// StoreMaybeRef stores Maybe ^Cell: a bit flag followed by the reference if c is not nil
+func (b *Builder) StoreMaybeRef(c *Cell) error {
+	if err := b.StoreBit(c != nil); err != nil {
+		return err
+	}
+	if c == nil {
+		return nil
+	}
+	return b.StoreRef(c)
+}
+
+// StoreSlice stores all remaining bits and references of the slice
+func (b *Builder) StoreSlice(s *Slice) error {
+	bits := s.BitsLeft()
+	data, err := s.Copy().LoadBits(bits)
+	if err != nil {
+		return err
+	}
+	if err := b.StoreBits(data, bits); err != nil {
+		return err
+	}
+	for i := s.refsPos; i < len(s.cell.refs); i++ {
+		if err := b.StoreRef(s.cell.refs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StoreBuilder appends data and references of another builder
+func (b *Builder) StoreBuilder(other *Builder) error {
+	return b.StoreSlice(other.EndCell().BeginParse())
+}
+
+// StoreCoins stores amount in nanograms as VarUInteger 16
+func (b *Builder) StoreCoins(amount uint64) error {
+	return b.StoreBigCoins(new(big.Int).SetUint64(amount))
+}
+
+// StoreBigCoins stores big amount as VarUInteger 16
+func (b *Builder) StoreBigCoins(amount *big.Int) error {
+	return b.StoreVarUInt(amount, 16)
+}
+
+// StoreVarUInt stores VarUInteger n: byte length in log2(n) bits followed by the value
+func (b *Builder) StoreVarUInt(value *big.Int, n int) error {
+	if value.Sign() < 0 {
+		return fmt.Errorf("negative value %s for unsigned integer", value)
+	}
+	length := (value.BitLen() + 7) / 8
+	if length >= n {
+		return fmt.Errorf("value %s doesn't fit in VarUInteger %d", value, n)
+	}
+	if err := b.StoreUInt(uint64(length), lenBits(n)); err != nil {
+		return err
+	}
+	return b.StoreBigUInt(value, length*8)
+}
+
+// StoreAddress stores MsgAddressInt, or addr_none for nil address
+func (b *Builder) StoreAddress(addr *address.Address) error {
+	if addr == nil {
+		return b.StoreUInt(0, 2)
+	}
+	if err := b.checkBits(267); err != nil {
+		return err
+	}
+	// addr_std$10 anycast:(Maybe Anycast)
+	if err := b.StoreUInt(0x4, 3); err != nil {
+		return err
+	}
+	if err := b.StoreInt(int64(addr.Workchain), 8); err != nil {
+		return err
+	}
+	return b.StoreBytes(addr.Hash[:])
+}
+
+// EndCell finalizes the builder into a cell
+func (b *Builder) EndCell() *Cell {
+	data := make([]byte, len(b.data))
+	copy(data, b.data)
+	refs := make([]*Cell, len(b.refs))
+	copy(refs, b.refs)
+	return &Cell{data: data, bitsLen: b.bitsLen, refs: refs}
+}
+
+// lenBits returns amount of bits needed to store numbers below n
+func lenBits(n int) int {
+	bits := 0
+	for (1 << uint(bits)) < n {
+		bits++
+	}
+	return bits
+}
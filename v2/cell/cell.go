@@ -0,0 +1,111 @@
+// Package cell implements TVM cells with their builders, slices, hashing and BOC
+// (bag of cells) serialization, so messages can be built and parsed without tonlib.
+package cell
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	MaxBits = 1023
+	MaxRefs = 4
+)
+
+// Cell is an immutable ordinary TVM cell: up to 1023 bits of data and up to 4 references
+type Cell struct {
+	data    []byte
+	bitsLen int
+	refs    []*Cell
+
+	hash  []byte
+	depth int
+}
+
+// BitsLen returns amount of data bits in the cell
+func (c *Cell) BitsLen() int {
+	return c.bitsLen
+}
+
+// Data returns data bits of the cell, padded with zeros to a whole byte
+func (c *Cell) Data() []byte {
+	data := make([]byte, len(c.data))
+	copy(data, c.data)
+	return data
+}
+
+// Refs returns referenced cells
+func (c *Cell) Refs() []*Cell {
+	refs := make([]*Cell, len(c.refs))
+	copy(refs, c.refs)
+	return refs
+}
+
+// BeginParse returns a slice to read the cell from the beginning
+func (c *Cell) BeginParse() *Slice {
+	return &Slice{cell: c}
+}
+
+// Depth returns max depth of the cell tree
+func (c *Cell) Depth() int {
+	c.calcHash()
+	return c.depth
+}
+
+// Hash returns representation hash of the cell
+func (c *Cell) Hash() []byte {
+	c.calcHash()
+	hash := make([]byte, len(c.hash))
+	copy(hash, c.hash)
+	return hash
+}
+
+// String returns a short human readable form of the cell
+func (c *Cell) String() string {
+	return fmt.Sprintf("cell{bits: %d, refs: %d, data: %s}", c.bitsLen, len(c.refs), hex.EncodeToString(c.data))
+}
+
+// descriptors returns d1 and d2 descriptor bytes of the cell
+func (c *Cell) descriptors() (byte, byte) {
+	d1 := byte(len(c.refs))
+	d2 := byte(c.bitsLen/8 + (c.bitsLen+7)/8)
+	return d1, d2
+}
+
+// paddedData returns data with the completion tag if the last byte is incomplete
+func (c *Cell) paddedData() []byte {
+	data := make([]byte, (c.bitsLen+7)/8)
+	copy(data, c.data)
+	if c.bitsLen%8 != 0 {
+		data[len(data)-1] |= 1 << uint(7-c.bitsLen%8)
+	}
+	return data
+}
+
+func (c *Cell) calcHash() {
+	if c.hash != nil {
+		return
+	}
+	d1, d2 := c.descriptors()
+	repr := append([]byte{d1, d2}, c.paddedData()...)
+
+	depth := 0
+	for _, ref := range c.refs {
+		ref.calcHash()
+		if ref.depth+1 > depth {
+			depth = ref.depth + 1
+		}
+		depthBytes := make([]byte, 2)
+		binary.BigEndian.PutUint16(depthBytes, uint16(ref.depth))
+		repr = append(repr, depthBytes...)
+	}
+	for _, ref := range c.refs {
+		repr = append(repr, ref.hash...)
+	}
+
+	hash := sha256.Sum256(repr)
+	c.hash = hash[:]
+	c.depth = depth
+}
@@ -0,0 +1,203 @@
+package cell
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+)
+
+const walletV3R2CodeHash = "84dafa449f98a6987789ba232358072bc0f76dc4524002a5d0918b9a75d2d599"
+
+func TestFromBOC(t *testing.T) {
+	data, err := ioutil.ReadFile("../testgiver-query.boc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := FromBOC(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.BitsLen() != 317 || len(root.Refs()) != 1 {
+		t.Fatalf("unexpected root cell: %s", root)
+	}
+
+	// serialization uses minimal offsets, so compare trees instead of bytes
+	parsed, err := FromBOC(root.ToBOC())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(parsed.Hash(), root.Hash()) {
+		t.Fatalf("hash mismatch after roundtrip")
+	}
+
+	data[len(data)-1]++
+	if _, err := FromBOC(data); err == nil {
+		t.Fatal("expected crc error")
+	}
+}
+
+func TestFullCellBOC(t *testing.T) {
+	b := NewBuilder()
+	for i := 0; i < MaxBits; i++ {
+		if err := b.StoreBit(i%3 == 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	full := b.EndCell()
+	parsed, err := FromBOC(full.ToBOC())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.BitsLen() != MaxBits || !bytes.Equal(parsed.Data(), full.Data()) || !bytes.Equal(parsed.Hash(), full.Hash()) {
+		t.Fatalf("unexpected cell after roundtrip: %s", parsed)
+	}
+}
+
+func TestFromBOCExotic(t *testing.T) {
+	// the empty cell is serialized last as its two descriptor bytes
+	data := ToBOC([]*Cell{NewBuilder().EndCell()}, false)
+	for _, d1 := range []byte{0x08, 0x20} {
+		corrupted := append([]byte(nil), data...)
+		corrupted[len(corrupted)-2] |= d1
+		if _, err := FromBOC(corrupted); err == nil {
+			t.Fatalf("expected error for cell with d1 %#x", d1)
+		}
+	}
+}
+
+func TestCellHash(t *testing.T) {
+	code, err := FromBOCBase64("te6cckEBAQEAcQAA3v8AIN0gggFMl7ohggEznLqxn3Gw7UTQ0x/THzHXC//jBOCk8mCDCNcYINMf0x/TH/gjE7vyY+1E0NMf0x/T/9FRMrryoVFEuvKiBPkBVBBV+RDyo/gAkyDXSpbTB9QC+wDo0QGkyMsfyx/L/8ntVBC9ba0=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash := hex.EncodeToString(code.Hash()); hash != walletV3R2CodeHash {
+		t.Fatalf("unexpected code hash: %s", hash)
+	}
+}
+
+func TestBuilderSlice(t *testing.T) {
+	addr := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	amount, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	ref := NewBuilder().EndCell()
+
+	b := NewBuilder()
+	checks := []error{
+		b.StoreBit(true),
+		b.StoreUInt(0xdeadbeef, 32),
+		b.StoreInt(-5, 9),
+		b.StoreBigCoins(amount),
+		b.StoreAddress(addr),
+		b.StoreAddress(nil),
+		b.StoreMaybeRef(ref),
+	}
+	for i, err := range checks {
+		if err != nil {
+			t.Fatalf("store #%d failed: %v", i, err)
+		}
+	}
+	if err := b.StoreUInt(4, 2); err == nil {
+		t.Fatal("expected overflow error")
+	}
+
+	s := b.EndCell().BeginParse()
+	if bit, err := s.LoadBit(); err != nil || !bit {
+		t.Fatalf("LoadBit: %v %v", bit, err)
+	}
+	if v, err := s.LoadUInt(32); err != nil || v != 0xdeadbeef {
+		t.Fatalf("LoadUInt: %x %v", v, err)
+	}
+	if v, err := s.LoadInt(9); err != nil || v != -5 {
+		t.Fatalf("LoadInt: %d %v", v, err)
+	}
+	if v, err := s.LoadCoins(); err != nil || v.Cmp(amount) != 0 {
+		t.Fatalf("LoadCoins: %s %v", v, err)
+	}
+	if v, err := s.LoadAddress(); err != nil || !v.Equal(addr) {
+		t.Fatalf("LoadAddress: %v %v", v, err)
+	}
+	if v, err := s.LoadAddress(); err != nil || v != nil {
+		t.Fatalf("LoadAddress (none): %v %v", v, err)
+	}
+	if v, err := s.LoadMaybeRef(); err != nil || v == nil {
+		t.Fatalf("LoadMaybeRef: %v %v", v, err)
+	}
+	if s.BitsLeft() != 0 || s.RefsLeft() != 0 {
+		t.Fatalf("unread data left: %d bits, %d refs", s.BitsLeft(), s.RefsLeft())
+	}
+}
+
+func TestSnakeBytes(t *testing.T) {
+	text := strings.Repeat("snake format ", 50)
+	b := NewBuilder()
+	if err := b.StoreUInt(0, 32); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.StoreSnakeBytes([]byte(text)); err != nil {
+		t.Fatal(err)
+	}
+	root, err := FromBOC(b.EndCell().ToBOC())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := root.BeginParse()
+	if _, err := s.LoadUInt(32); err != nil {
+		t.Fatal(err)
+	}
+	data, err := s.LoadSnakeBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != text {
+		t.Fatalf("snake mismatch: %q", data)
+	}
+}
+
+func TestDict(t *testing.T) {
+	var entries []DictEntry
+	for _, key := range []int64{0, 1, 7, 200, 255, 128} {
+		b := NewBuilder()
+		if err := b.StoreUInt(uint64(key)*3, 16); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, DictEntry{Key: big.NewInt(key), Value: b.EndCell().BeginParse()})
+	}
+	b := NewBuilder()
+	if err := b.StoreDict(entries, 8); err != nil {
+		t.Fatal(err)
+	}
+	root, err := FromBOC(b.EndCell().ToBOC())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := root.BeginParse().LoadDict(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := []int64{0, 1, 7, 128, 200, 255}
+	if len(parsed) != len(keys) {
+		t.Fatalf("unexpected entries: %v", parsed)
+	}
+	for i, entry := range parsed {
+		value, err := entry.Value.LoadUInt(16)
+		if err != nil || entry.Key.Int64() != keys[i] || int64(value) != keys[i]*3 {
+			t.Fatalf("unexpected entry %d: %s=%d %v", i, entry.Key, value, err)
+		}
+	}
+
+	// single entry 0x01 is a leaf with hml_long$10 label: 4 bits of length 8 and the key
+	single, err := BuildDict([]DictEntry{{Key: big.NewInt(1), Value: NewBuilder().EndCell().BeginParse()}}, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single.BitsLen() != 14 || hex.EncodeToString(single.Data()) != "a004" {
+		t.Fatalf("unexpected label encoding: %s", single)
+	}
+	if _, err := BuildDict([]DictEntry{{Key: big.NewInt(256)}}, 8); err == nil {
+		t.Fatal("expected error for key overflow")
+	}
+}
@@ -0,0 +1,232 @@
+package cell
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// DictEntry is a key and value of a dictionary (HashmapE), keys are unsigned integers of the dictionary key size
+type DictEntry struct {
+	Key   *big.Int
+	Value *Slice
+}
+
+// LoadDict reads HashmapE keyBits: a maybe reference to the dictionary root, entries are returned in key order
+func (s *Slice) LoadDict(keyBits int) ([]DictEntry, error) {
+	root, err := s.LoadMaybeRef()
+	if err != nil || root == nil {
+		return nil, err
+	}
+	return ParseDict(root, keyBits)
+}
+
+// ParseDict reads non-empty dictionary (Hashmap) starting at its root cell
+func ParseDict(root *Cell, keyBits int) ([]DictEntry, error) {
+	if keyBits <= 0 || keyBits > MaxBits {
+		return nil, fmt.Errorf("invalid dictionary key size: %d", keyBits)
+	}
+	var entries []DictEntry
+	if err := parseDictNode(root.BeginParse(), new(big.Int), keyBits, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// parseDictNode reads hm_edge with n bits of the key left, prefix holds the bits read so far
+func parseDictNode(s *Slice, prefix *big.Int, n int, entries *[]DictEntry) error {
+	label, labelLen, err := loadDictLabel(s, n)
+	if err != nil {
+		return err
+	}
+	key := new(big.Int).Lsh(prefix, uint(labelLen))
+	key.Or(key, label)
+	n -= labelLen
+	if n == 0 {
+		*entries = append(*entries, DictEntry{Key: key, Value: s})
+		return nil
+	}
+	// hmn_fork left:^(Hashmap n X) right:^(Hashmap n X) for the remaining n-1 bits
+	for bit := int64(0); bit < 2; bit++ {
+		fork, err := s.LoadRef()
+		if err != nil {
+			return fmt.Errorf("invalid dictionary fork: %v", err)
+		}
+		forkPrefix := new(big.Int).Lsh(key, 1)
+		forkPrefix.Or(forkPrefix, big.NewInt(bit))
+		if err := parseDictNode(fork.BeginParse(), forkPrefix, n-1, entries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadDictLabel reads HmLabel of at most m bits and returns its value and length
+func loadDictLabel(s *Slice, m int) (*big.Int, int, error) {
+	short, err := s.LoadBit()
+	if err != nil {
+		return nil, 0, err
+	}
+	if !short {
+		// hml_short$0 len:(Unary ~n) s:(n * Bit)
+		n := 0
+		for {
+			bit, err := s.LoadBit()
+			if err != nil {
+				return nil, 0, err
+			}
+			if !bit {
+				break
+			}
+			n++
+		}
+		if n > m {
+			return nil, 0, fmt.Errorf("dictionary label is too long: %d bits, %d left", n, m)
+		}
+		label, err := s.LoadBigUInt(n)
+		return label, n, err
+	}
+	long, err := s.LoadBit()
+	if err != nil {
+		return nil, 0, err
+	}
+	if !long {
+		// hml_long$10 n:(#<= m) s:(n * Bit)
+		n, err := s.LoadUInt(lenBits(m + 1))
+		if err != nil {
+			return nil, 0, err
+		}
+		if int(n) > m {
+			return nil, 0, fmt.Errorf("dictionary label is too long: %d bits, %d left", n, m)
+		}
+		label, err := s.LoadBigUInt(int(n))
+		return label, int(n), err
+	}
+	// hml_same$11 v:Bit n:(#<= m)
+	v, err := s.LoadBit()
+	if err != nil {
+		return nil, 0, err
+	}
+	n, err := s.LoadUInt(lenBits(m + 1))
+	if err != nil {
+		return nil, 0, err
+	}
+	if int(n) > m {
+		return nil, 0, fmt.Errorf("dictionary label is too long: %d bits, %d left", n, m)
+	}
+	label := new(big.Int)
+	if v {
+		label.Sub(label.Lsh(big.NewInt(1), uint(n)), big.NewInt(1))
+	}
+	return label, int(n), nil
+}
+
+// StoreDict stores HashmapE keyBits with the entries, empty dictionary is stored as a single zero bit
+func (b *Builder) StoreDict(entries []DictEntry, keyBits int) error {
+	if len(entries) == 0 {
+		return b.StoreMaybeRef(nil)
+	}
+	root, err := BuildDict(entries, keyBits)
+	if err != nil {
+		return err
+	}
+	return b.StoreMaybeRef(root)
+}
+
+// BuildDict serializes non-empty dictionary (Hashmap) and returns its root cell
+func BuildDict(entries []DictEntry, keyBits int) (*Cell, error) {
+	if keyBits <= 0 || keyBits > MaxBits {
+		return nil, fmt.Errorf("invalid dictionary key size: %d", keyBits)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("empty dictionary")
+	}
+	sorted := make([]DictEntry, len(entries))
+	copy(sorted, entries)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key.Cmp(sorted[j].Key) < 0 })
+	for i, entry := range sorted {
+		if entry.Key.Sign() < 0 || entry.Key.BitLen() > keyBits {
+			return nil, fmt.Errorf("dictionary key %s doesn't fit in %d bits", entry.Key, keyBits)
+		}
+		if i > 0 && entry.Key.Cmp(sorted[i-1].Key) == 0 {
+			return nil, fmt.Errorf("duplicate dictionary key %s", entry.Key)
+		}
+	}
+	return buildDictNode(sorted, keyBits)
+}
+
+// buildDictNode serializes entries sorted by key which share all key bits above the lowest n
+func buildDictNode(entries []DictEntry, n int) (*Cell, error) {
+	// the label is the common prefix of the first and the last key, the sorted keys between share it
+	labelLen := 0
+	if len(entries) > 1 {
+		first, last := entries[0].Key, entries[len(entries)-1].Key
+		for labelLen < n && first.Bit(n-1-labelLen) == last.Bit(n-1-labelLen) {
+			labelLen++
+		}
+	} else {
+		labelLen = n
+	}
+	b := NewBuilder()
+	label := new(big.Int).Rsh(entries[0].Key, uint(n-labelLen))
+	if err := storeDictLabel(b, label, labelLen, n); err != nil {
+		return nil, err
+	}
+	n -= labelLen
+	if n == 0 {
+		if err := b.StoreSlice(entries[0].Value); err != nil {
+			return nil, err
+		}
+		return b.EndCell(), nil
+	}
+	split := sort.Search(len(entries), func(i int) bool { return entries[i].Key.Bit(n-1) == 1 })
+	for _, half := range [][]DictEntry{entries[:split], entries[split:]} {
+		fork, err := buildDictNode(half, n-1)
+		if err != nil {
+			return nil, err
+		}
+		if err := b.StoreRef(fork); err != nil {
+			return nil, err
+		}
+	}
+	return b.EndCell(), nil
+}
+
+// storeDictLabel stores the lowest n bits of label in the shortest HmLabel encoding for at most m bits
+func storeDictLabel(b *Builder, label *big.Int, n, m int) error {
+	ones := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(n)), big.NewInt(1))
+	label = new(big.Int).And(label, ones)
+	k := lenBits(m + 1)
+	shortLen, longLen, sameLen := 2*n+2, 2+k+n, 3+k
+	same := n > 0 && (label.Sign() == 0 || label.Cmp(ones) == 0)
+	if same && sameLen < shortLen && sameLen < longLen {
+		if err := b.StoreUInt(3, 2); err != nil {
+			return err
+		}
+		if err := b.StoreBit(label.Sign() != 0); err != nil {
+			return err
+		}
+		return b.StoreUInt(uint64(n), k)
+	}
+	if shortLen <= longLen {
+		if err := b.StoreBit(false); err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			if err := b.StoreBit(true); err != nil {
+				return err
+			}
+		}
+		if err := b.StoreBit(false); err != nil {
+			return err
+		}
+		return b.StoreBigUInt(label, n)
+	}
+	if err := b.StoreUInt(2, 2); err != nil {
+		return err
+	}
+	if err := b.StoreUInt(uint64(n), k); err != nil {
+		return err
+	}
+	return b.StoreBigUInt(label, n)
+}
@@ -0,0 +1,212 @@
+package cell
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+)
+
+// Slice reads bits and references of a cell sequentially
+type Slice struct {
+	cell    *Cell
+	bitsPos int
+	refsPos int
+}
+
+// BitsLeft returns amount of unread bits
+func (s *Slice) BitsLeft() int {
+	return s.cell.bitsLen - s.bitsPos
+}
+
+// RefsLeft returns amount of unread references
+func (s *Slice) RefsLeft() int {
+	return len(s.cell.refs) - s.refsPos
+}
+
+// Copy returns an independent slice at the same position
+func (s *Slice) Copy() *Slice {
+	c := *s
+	return &c
+}
+
+// ToCell returns the remaining part of the slice as a new cell
+func (s *Slice) ToCell() (*Cell, error) {
+	b := NewBuilder()
+	if err := b.StoreSlice(s); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+func (s *Slice) bit(i int) bool {
+	return s.cell.data[i/8]>>uint(7-i%8)&1 == 1
+}
+
+func (s *Slice) checkBits(bits int) error {
+	if bits < 0 {
+		return fmt.Errorf("negative bits amount: %d", bits)
+	}
+	if bits > s.BitsLeft() {
+		return fmt.Errorf("cell underflow: %d bits left, %d requested", s.BitsLeft(), bits)
+	}
+	return nil
+}
+
+// LoadBit reads a single bit
+func (s *Slice) LoadBit() (bool, error) {
+	if err := s.checkBits(1); err != nil {
+		return false, err
+	}
+	bit := s.bit(s.bitsPos)
+	s.bitsPos++
+	return bit, nil
+}
+
+// LoadUInt reads unsigned integer of up to 64 bits
+func (s *Slice) LoadUInt(bits int) (uint64, error) {
+	if bits > 64 {
+		return 0, fmt.Errorf("LoadUInt supports up to 64 bits, use LoadBigUInt for %d", bits)
+	}
+	if err := s.checkBits(bits); err != nil {
+		return 0, err
+	}
+	var value uint64
+	for i := 0; i < bits; i++ {
+		value <<= 1
+		if s.bit(s.bitsPos) {
+			value |= 1
+		}
+		s.bitsPos++
+	}
+	return value, nil
+}
+
+// PreloadUInt reads unsigned integer without moving the position
+func (s *Slice) PreloadUInt(bits int) (uint64, error) {
+	return s.Copy().LoadUInt(bits)
+}
+
+// LoadInt reads signed integer of up to 64 bits
+func (s *Slice) LoadInt(bits int) (int64, error) {
+	value, err := s.LoadBigInt(bits)
+	if err != nil {
+		return 0, err
+	}
+	if !value.IsInt64() {
+		return 0, fmt.Errorf("value %s overflows int64", value)
+	}
+	return value.Int64(), nil
+}
+
+// LoadBigUInt reads unsigned big integer
+func (s *Slice) LoadBigUInt(bits int) (*big.Int, error) {
+	if err := s.checkBits(bits); err != nil {
+		return nil, err
+	}
+	value := new(big.Int)
+	for i := 0; i < bits; i++ {
+		value.Lsh(value, 1)
+		if s.bit(s.bitsPos) {
+			value.SetBit(value, 0, 1)
+		}
+		s.bitsPos++
+	}
+	return value, nil
+}
+
+// LoadBigInt reads signed big integer stored in two's complement
+func (s *Slice) LoadBigInt(bits int) (*big.Int, error) {
+	value, err := s.LoadBigUInt(bits)
+	if err != nil {
+		return nil, err
+	}
+	if bits > 0 && value.Bit(bits-1) == 1 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	}
+	return value, nil
+}
+
+// LoadBits reads bits bits, returned data is padded with zeros to a whole byte
+func (s *Slice) LoadBits(bits int) ([]byte, error) {
+	if err := s.checkBits(bits); err != nil {
+		return nil, err
+	}
+	data := make([]byte, (bits+7)/8)
+	for i := 0; i < bits; i++ {
+		if s.bit(s.bitsPos) {
+			data[i/8] |= 1 << uint(7-i%8)
+		}
+		s.bitsPos++
+	}
+	return data, nil
+}
+
+// LoadBytes reads n whole bytes
+func (s *Slice) LoadBytes(n int) ([]byte, error) {
+	return s.LoadBits(n * 8)
+}
+
+// LoadRef reads the next reference
+func (s *Slice) LoadRef() (*Cell, error) {
+	if s.RefsLeft() < 1 {
+		return nil, fmt.Errorf("cell underflow: no references left")
+	}
+	ref := s.cell.refs[s.refsPos]
+	s.refsPos++
+	return ref, nil
+}
+
+// LoadMaybeRef reads Maybe ^Cell, returns nil cell if the flag is not set
+func (s *Slice) LoadMaybeRef() (*Cell, error) {
+	exists, err := s.LoadBit()
+	if err != nil || !exists {
+		return nil, err
+	}
+	return s.LoadRef()
+}
+
+// LoadCoins reads VarUInteger 16 amount
+func (s *Slice) LoadCoins() (*big.Int, error) {
+	return s.LoadVarUInt(16)
+}
+
+// LoadVarUInt reads VarUInteger n
+func (s *Slice) LoadVarUInt(n int) (*big.Int, error) {
+	length, err := s.LoadUInt(lenBits(n))
+	if err != nil {
+		return nil, err
+	}
+	return s.LoadBigUInt(int(length) * 8)
+}
+
+// LoadAddress reads MsgAddressInt, returns nil address for addr_none
+func (s *Slice) LoadAddress() (*address.Address, error) {
+	tag, err := s.LoadUInt(2)
+	if err != nil {
+		return nil, err
+	}
+	switch tag {
+	case 0:
+		return nil, nil
+	case 2:
+		anycast, err := s.LoadBit()
+		if err != nil {
+			return nil, err
+		}
+		if anycast {
+			return nil, fmt.Errorf("anycast addresses are not supported")
+		}
+		workchain, err := s.LoadInt(8)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := s.LoadBytes(32)
+		if err != nil {
+			return nil, err
+		}
+		return address.New(int32(workchain), hash)
+	default:
+		return nil, fmt.Errorf("unsupported address tag: %b", tag)
+	}
+}
@@ -0,0 +1,44 @@
+package cell
+
+import "fmt"
+
+// StoreSnakeBytes stores data in snake format: as many bytes as fit into the builder,
+// the rest goes into a chain of cells referenced one from another
+func (b *Builder) StoreSnakeBytes(data []byte) error {
+	fit := b.BitsLeft() / 8
+	if fit >= len(data) {
+		return b.StoreBytes(data)
+	}
+	if err := b.StoreBytes(data[:fit]); err != nil {
+		return err
+	}
+	tail := NewBuilder()
+	if err := tail.StoreSnakeBytes(data[fit:]); err != nil {
+		return err
+	}
+	return b.StoreRef(tail.EndCell())
+}
+
+// LoadSnakeBytes reads all remaining bytes of the slice and of the cells chained by first references
+func (s *Slice) LoadSnakeBytes() ([]byte, error) {
+	var result []byte
+	current := s
+	for {
+		if current.BitsLeft()%8 != 0 {
+			return nil, fmt.Errorf("snake data is not byte aligned: %d bits left", current.BitsLeft())
+		}
+		data, err := current.LoadBytes(current.BitsLeft() / 8)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data...)
+		if current.RefsLeft() == 0 {
+			return result, nil
+		}
+		next, err := current.LoadRef()
+		if err != nil {
+			return nil, err
+		}
+		current = next.BeginParse()
+	}
+}
@@ -1,11 +1,9 @@
 package v2
 
-//#cgo linux CFLAGS: -I./lib/linux
-//#cgo darwin CFLAGS: -I./lib/darwin
-//#cgo linux LDFLAGS: -L./lib/linux -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
-//#cgo darwin LDFLAGS: -L./lib/darwin -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
-//#include <stdlib.h>
-//#include <./lib/tonlib_client_json.h>
+// the tonlib libraries bundled with the package are linked here, transport calls them
+
+//#cgo linux LDFLAGS: -L${SRCDIR}/lib/linux -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
+//#cgo darwin LDFLAGS: -L${SRCDIR}/lib/darwin -ltonlibjson -ltonlibjson_private -ltonlibjson_static -ltonlib
 import "C"
 import (
 	"encoding/json"
@@ -13,7 +11,10 @@ import (
 	"math/rand"
 	"sync"
 	"time"
-	"unsafe"
+
+	"github.com/mercuryoio/tonlib-go/v2/internal/redact"
+	"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
+	"github.com/mercuryoio/tonlib-go/v2/transport"
 )
 
 const (
@@ -21,16 +22,18 @@ const (
 	DefaultRetries  = 10
 )
 
+//tlgen:type InputKey
 type InputKey struct {
 	Type          string        `json:"@type"`
-	LocalPassword string        `json:"local_password"`
+	LocalPassword SecureString  `json:"local_password"`
 	Key           TONPrivateKey `json:"key"`
 }
 type TONPrivateKey struct {
-	PublicKey string `json:"public_key"`
-	Secret    string `json:"secret"`
+	PublicKey string       `json:"public_key"`
+	Secret    SecureString `json:"secret"`
 }
 
+//tlgen:type SyncState
 type SyncState struct {
 	Type         string `json:"@type"`
 	FromSeqno    int    `json:"from_seqno"`
@@ -39,11 +42,15 @@ type SyncState struct {
 }
 
 // KeyStoreType directory
+//tlgen:type KeyStoreType
 type KeyStoreType struct {
 	Type      string `json:"@type"`
 	Directory string `json:"directory"`
 }
 
+// TonlibError is an error response of tonlib
+type TonlibError = tonlibjson.Error
+
 // TONResponse alias for use in TONResult
 type TONResponse map[string]interface{}
 
@@ -53,15 +60,26 @@ type TONResult struct {
 	Raw  []byte
 }
 
+// tonlibTransport sends json requests to tonlib, it is *transport.Transport outside of tests
+type tonlibTransport interface {
+	Send(req []byte)
+	Receive(timeout float64) []byte
+	ReceiveRetrying(timeout float64, retries int) ([]byte, error)
+	Execute(req []byte) []byte
+	Destroy()
+}
+
 // Client is the Telegram TdLib client
 type Client struct {
 	mu            sync.Mutex
-	client        unsafe.Pointer
+	transport     tonlibTransport
 	config        Config
 	timeout       int64
 	clientLogging bool
 	tonLogging    int32
 	options       Options
+	dnsOnce       sync.Once
+	dns           *DnsResolver
 }
 
 type TonInitRequest struct {
@@ -75,7 +93,7 @@ func NewClient(tonCnf *TonInitRequest, config Config, timeout int64, clientLoggi
 
 	client := Client{
 		mu:            sync.Mutex{},
-		client:        C.tonlib_client_json_create(),
+		transport:     transport.New(),
 		config:        config,
 		timeout:       timeout,
 		clientLogging: clientLogging,
@@ -115,13 +133,8 @@ func (client *Client) executeSetLogLevel(logLevel int32) error {
 	if err != nil {
 		return err
 	}
-	cs := C.CString(string(req))
-	defer C.free(unsafe.Pointer(cs))
-
-	if client.clientLogging {
-		fmt.Println("call execute setLogVerbosityLevel: ", string(req))
-	}
-	C.tonlib_client_json_execute(client.client, cs)
+	client.logJSON("call execute setLogVerbosityLevel: ", req)
+	client.transport.Execute(req)
 	return nil
 }
 
@@ -133,32 +146,16 @@ func (client *Client) executeAsynchronously(data interface{}) (*TONResult, error
 	if err != nil {
 		return &TONResult{}, err
 	}
-	cs := C.CString(string(req))
-	defer C.free(unsafe.Pointer(cs))
-
-	if client.clientLogging {
-		fmt.Println("call", string(req))
-	}
-	C.tonlib_client_json_send(client.client, cs)
-	result := C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
-
-	num := 0
-	for result == nil {
-		if num >= DefaultRetries {
-			return &TONResult{}, fmt.Errorf("Client.executeAsynchronously: exided limit of retries to get json response from TON C`s lib. ")
-		}
-		time.Sleep(1 * time.Second)
-		result = C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
-		num += 1
+	client.logJSON("call", req)
+	client.transport.Send(req)
+	resB, err := client.transport.ReceiveRetrying(DEFAULT_TIMEOUT, DefaultRetries)
+	if err != nil {
+		return &TONResult{}, err
 	}
 
 	var updateData TONResponse
-	res := C.GoString(result)
-	resB := []byte(res)
 	err = json.Unmarshal(resB, &updateData)
-	if client.clientLogging {
-		fmt.Println("fetch data: ", string(resB))
-	}
+	client.logJSON("fetch data: ", resB)
 	if st, ok := updateData["@type"]; ok && st == "updateSendLiteServerQuery" {
 		err = json.Unmarshal(resB, &updateData)
 		if err == nil {
@@ -175,9 +172,9 @@ func (client *Client) executeAsynchronously(data interface{}) (*TONResult, error
 			return &TONResult{}, err
 		}
 		if client.clientLogging {
-			fmt.Println("run sync", updateData)
+			fmt.Println("run sync", redact.Value(map[string]interface{}(updateData)))
 		}
-		res, err = client.Sync(syncResp.SyncState)
+		res, err := client.Sync(syncResp.SyncState)
 		if err != nil {
 			return &TONResult{}, err
 		}
@@ -199,12 +196,8 @@ execute ton-lib synchronously
 */
 func (client *Client) executeSynchronously(data interface{}) (*TONResult, error) {
 	req, _ := json.Marshal(data)
-	cs := C.CString(string(req))
-	defer C.free(unsafe.Pointer(cs))
-	result := C.tonlib_client_json_execute(client.client, cs)
+	resB := client.transport.Execute(req)
 	var updateData TONResponse
-	res := C.GoString(result)
-	resB := []byte(res)
 	err := json.Unmarshal(resB, &updateData)
 	return &TONResult{Data: updateData, Raw: resB}, err
 }
@@ -212,10 +205,11 @@ func (client *Client) executeSynchronously(data interface{}) (*TONResult, error)
 func (client *Client) Destroy() {
 	client.mu.Lock()
 	defer client.mu.Unlock()
-	C.tonlib_client_json_destroy(client.client)
+	client.transport.Destroy()
 }
 
 //sync node`s blocks to current
+//tlgen:skip sync
 func (client *Client) Sync(syncState SyncState) (string, error) {
 	data := struct {
 		Type      string    `json:"@type"`
@@ -228,31 +222,24 @@ func (client *Client) Sync(syncState SyncState) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	cs := C.CString(string(req))
-	defer C.free(unsafe.Pointer(cs))
-	if client.clientLogging {
-		fmt.Println("call (sync)", string(req))
-	}
-	C.tonlib_client_json_send(client.client, cs)
+	client.logJSON("call (sync)", req)
+	client.transport.Send(req)
 	for {
-		result := C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
-		for result == nil {
+		resB := client.transport.Receive(DEFAULT_TIMEOUT)
+		for resB == nil {
 			if client.clientLogging {
 				fmt.Println("empty response. next attempt")
 			}
 			time.Sleep(1 * time.Second)
-			result = C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
+			resB = client.transport.Receive(DEFAULT_TIMEOUT)
 		}
 		syncResp := struct {
 			Type      string    `json:"@type"`
 			SyncState SyncState `json:"sync_state"`
 		}{}
-		res := C.GoString(result)
-		resB := []byte(res)
+		res := string(resB)
 		err = json.Unmarshal(resB, &syncResp)
-		if client.clientLogging {
-			fmt.Println("sync result #1: ", res)
-		}
+		client.logJSON("sync result #1: ", resB)
 		if err != nil {
 			return "", err
 		}
@@ -260,17 +247,14 @@ func (client *Client) Sync(syncState SyncState) (string, error) {
 			return "", fmt.Errorf("Got an error response from ton: `%s` ", res)
 		}
 		if syncResp.SyncState.Type == "syncStateDone" {
-			result := C.tonlib_client_json_receive(client.client, DEFAULT_TIMEOUT)
+			resB := client.transport.Receive(DEFAULT_TIMEOUT)
 			syncResp = struct {
 				Type      string    `json:"@type"`
 				SyncState SyncState `json:"sync_state"`
 			}{}
-			res = C.GoString(result)
-			resB := []byte(res)
+			res = string(resB)
 			err = json.Unmarshal(resB, &syncResp)
-			if client.clientLogging {
-				fmt.Println("sync result #2: ", string(resB))
-			}
+			client.logJSON("sync result #2: ", resB)
 		}
 		if syncResp.Type == "ok" {
 			// continue updating
@@ -293,6 +277,7 @@ func (client *Client) Sync(syncState SyncState) (string, error) {
 // sometimes it`s respond with "@type: ok" instead of "query.fees"
 // @param id
 // @param ignoreChksig
+//tlgen:skip query.estimateFees
 func (client *Client) QueryEstimateFees(id int64, ignoreChksig bool) (*QueryFees, error) {
 	callData := struct {
 		Type         string `json:"@type"`
@@ -338,7 +323,7 @@ func (client *Client) QueryEstimateFees(id int64, ignoreChksig bool) (*QueryFees
 			}
 
 			if result.Data["@type"].(string) == "error" {
-				resultChan <- Resp{nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])}
+				resultChan <- Resp{nil, tonlibjson.ParseError(result.Raw)}
 				return
 			}
 
@@ -375,7 +360,7 @@ func (client *Client) UpdateTonConnection() error {
 	client.Destroy()
 
 	// create new C client
-	client.client = C.tonlib_client_json_create()
+	client.transport = transport.New()
 	// set log level
 	err = client.executeSetLogLevel(client.tonLogging)
 	if err != nil {
@@ -398,10 +383,11 @@ func (client *Client) UpdateTonConnection() error {
 
 // key struct cause it strings values no bytes
 // Key
+//tlgen:type key
 type Key struct {
 	tonCommon
-	PublicKey string `json:"public_key"` //
-	Secret    string `json:"secret"`     //
+	PublicKey string       `json:"public_key"` //
+	Secret    SecureString `json:"secret"`     //
 }
 
 // MessageType return the string telegram-type of Key
@@ -413,7 +399,7 @@ func (key *Key) MessageType() string {
 //
 // @param publicKey
 // @param secret
-func NewKey(publicKey string, secret string) *Key {
+func NewKey(publicKey string, secret SecureString) *Key {
 	keyTemp := Key{
 		tonCommon: tonCommon{Type: "key"},
 		PublicKey: publicKey,
@@ -422,18 +408,3 @@ func NewKey(publicKey string, secret string) *Key {
 
 	return &keyTemp
 }
-
-// because of different subclasses in common class InitialAccountState and  AccountState
-// InitialAccountState
-type InitialAccountState interface{ MessageType() string }
-
-type AccountState RawAccountState
-
-type MsgData interface{}
-type DnsEntryData string
-
-type Action interface{ MessageType() string }
-type DnsAction Action
-
-type PchanState interface{ MessageType() string }
-type PchanAction interface{ MessageType() string }
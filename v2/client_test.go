@@ -1,10 +1,18 @@
 package v2
 
 import (
+	"bytes"
+	"crypto/ed25519"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/mnemonic"
+	"github.com/mercuryoio/tonlib-go/v2/signer"
+	"github.com/mercuryoio/tonlib-go/v2/wallet"
 )
 
 const (
@@ -148,7 +156,7 @@ func TestClient_ExportKey(t *testing.T) {
 	// export key
 	exportedKey, err := cln.ExportKey(InputKey{
 		"inputKeyRegular",
-		base64.StdEncoding.EncodeToString(loc),
+		SecureString(base64.StdEncoding.EncodeToString(loc)),
 		TONPrivateKey{
 			pKey.PublicKey,
 			pKey.Secret,
@@ -195,7 +203,7 @@ func TestClient_ExportPemKey(t *testing.T) {
 	// export key
 	exportedKey, err := cln.ExportPemKey(InputKey{
 		"inputKeyRegular",
-		base64.StdEncoding.EncodeToString(loc),
+		SecureString(base64.StdEncoding.EncodeToString(loc)),
 		TONPrivateKey{
 			pKey.PublicKey,
 			pKey.Secret,
@@ -347,7 +355,7 @@ func TestClient_RawGetTransactions(t *testing.T) {
 	addr := NewAccountAddress(TestAccountAddress)
 	inputKey := InputKey{
 		Type:          "inputKeyRegular",
-		LocalPassword: base64.StdEncoding.EncodeToString(SecureBytes(TestAccountPassword)),
+		LocalPassword: SecureString(base64.StdEncoding.EncodeToString([]byte(TestAccountPassword))),
 		Key:           TONPrivateKey{PublicKey: TestAccountPublic, Secret: TestAccountSecret},
 	}
 
@@ -423,3 +431,162 @@ func TestClient_RawCreateAndSendMessage(t *testing.T) {
 	}
 	fmt.Printf("TestClient_RawCreateAndSendMessage: create and send msg msgSentOk: %#v, err: %v. ", msgSentOk, err)
 }
+
+// TestClient_WalletV3Transfer compares the transfer built by the wallet package with the query tonlib creates
+// for the same key, seqno and valid until, tonlib is the reference implementation of the wallet message
+func TestClient_WalletV3Transfer(t *testing.T) {
+	// parse config
+	options, err := ParseConfigFile("./tonlib.config.json.example")
+	if err != nil {
+		t.Fatal("TestClient_WalletV3Transfer failed parse config error. ", err)
+	}
+
+	// make req
+	req := TonInitRequest{
+		"init",
+		*options,
+	}
+
+	// create client
+	cln, err := NewClient(&req, Config{}, DefaultTestTimeout, true, 100)
+	if err != nil {
+		t.Fatal("TestClient_WalletV3Transfer Init client error. ", err)
+	}
+	defer cln.Destroy()
+
+	// create new key and export its seed
+	loc := SecureBytes(TestPassword)
+	pKey, err := cln.CreateNewKey(loc, SecureBytes(""), SecureBytes(""))
+	if err != nil {
+		t.Fatal("TestClient_WalletV3Transfer create key error", err)
+	}
+	inputKey := InputKey{
+		"inputKeyRegular",
+		SecureString(base64.StdEncoding.EncodeToString(loc)),
+		TONPrivateKey{
+			pKey.PublicKey,
+			pKey.Secret,
+		},
+	}
+	exportedKey, err := cln.ExportUnencryptedKey(inputKey)
+	if err != nil {
+		t.Fatal("TestClient_WalletV3Transfer export key error", err)
+	}
+	keySigner, err := signer.NewMemorySignerFromSeed(exportedKey.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if publicKey := signer.EncodePublicKey(keySigner.PublicKey()); publicKey != pKey.PublicKey {
+		t.Fatalf("TestClient_WalletV3Transfer public key %s doesn't match %s", publicKey, pKey.PublicKey)
+	}
+
+	// the address of the new wallet
+	w := wallet.NewV3(keySigner.PublicKey(), address.BasechainID)
+	initialState := NewWalletV3InitialAccountState(pKey.PublicKey, JSONInt64(w.WalletID))
+	walletAddress, err := cln.GetAccountAddress(initialState, 2, address.BasechainID)
+	if err != nil {
+		t.Fatal("TestClient_WalletV3Transfer failed to GetAccountAddress(): ", err)
+	}
+	expectedAddress, err := w.Address()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed, err := address.Parse(walletAddress.AccountAddress); err != nil || !parsed.Equal(expectedAddress) {
+		t.Fatalf("TestClient_WalletV3Transfer address %s doesn't match %s: %v", walletAddress.AccountAddress, expectedAddress, err)
+	}
+
+	// the first transfer of the wallet, amount is zero as the wallet has no funds
+	queryInfo, err := cln.CreateQuery(
+		NewActionMsg(true, []MsgMessage{*NewMsgMessage(0, NewMsgDataText(Bytes("hello")), NewAccountAddress(TestAccountAddress), "")}),
+		*walletAddress,
+		initialState,
+		inputKey,
+		60,
+	)
+	if err != nil {
+		t.Fatal("TestClient_WalletV3Transfer failed to CreateQuery(): ", err)
+	}
+	msg, err := w.BuildTransfer(keySigner, 0, uint32(queryInfo.ValidUntil), []wallet.Transfer{{
+		Destination: address.MustParse(TestAccountAddress),
+		Bounce:      false,
+		Comment:     "hello",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	refs := msg.Refs()
+	if len(refs) != 2 {
+		t.Fatalf("TestClient_WalletV3Transfer expected state init and body, got %d refs", len(refs))
+	}
+	if stateInit, err := cell.FromBOC(queryInfo.InitState); err != nil || !bytes.Equal(refs[0].Hash(), stateInit.Hash()) {
+		t.Fatalf("TestClient_WalletV3Transfer state init %x doesn't match tonlib %x: %v", refs[0].ToBOC(), queryInfo.InitState, err)
+	}
+	if !bytes.Equal(refs[1].Hash(), queryInfo.BodyHash) {
+		t.Fatalf("TestClient_WalletV3Transfer body %x doesn't match tonlib %x", refs[1].ToBOC(), queryInfo.Body)
+	}
+}
+
+// TestClient_Mnemonic checks the mnemonic package against keys created and exported by tonlib,
+// with and without a mnemonic password
+func TestClient_Mnemonic(t *testing.T) {
+	// parse config
+	options, err := ParseConfigFile("./tonlib.config.json.example")
+	if err != nil {
+		t.Fatal("TestClient_Mnemonic failed parse config error. ", err)
+	}
+
+	// make req
+	req := TonInitRequest{
+		"init",
+		*options,
+	}
+
+	// create client
+	cln, err := NewClient(&req, Config{}, DefaultTestTimeout, true, 100)
+	if err != nil {
+		t.Fatal("TestClient_Mnemonic Init client error. ", err)
+	}
+	defer cln.Destroy()
+
+	loc := SecureBytes(TestPassword)
+	for _, mnemonicPassword := range []string{"", TestPassword} {
+		pKey, err := cln.CreateNewKey(loc, SecureBytes(mnemonicPassword), SecureBytes(""))
+		if err != nil {
+			t.Fatal("TestClient_Mnemonic create key error", err)
+		}
+		inputKey := InputKey{
+			"inputKeyRegular",
+			SecureString(base64.StdEncoding.EncodeToString(loc)),
+			TONPrivateKey{
+				pKey.PublicKey,
+				pKey.Secret,
+			},
+		}
+		exportedKey, err := cln.ExportKey(inputKey)
+		if err != nil {
+			t.Fatal("TestClient_Mnemonic export key error", err)
+		}
+		unencryptedKey, err := cln.ExportUnencryptedKey(inputKey)
+		if err != nil {
+			t.Fatal("TestClient_Mnemonic export unencrypted key error", err)
+		}
+
+		phrase := make([]string, len(exportedKey.WordList))
+		for i, word := range exportedKey.WordList {
+			phrase[i] = string(word)
+		}
+		privateKey, err := mnemonic.NewKey(phrase, mnemonicPassword)
+		if err != nil {
+			t.Fatalf("TestClient_Mnemonic tonlib phrase %q is rejected: %v", phrase, err)
+		}
+		if !bytes.Equal(privateKey.Seed(), unencryptedKey.Data) {
+			t.Fatalf("TestClient_Mnemonic seed of phrase %q doesn't match tonlib", phrase)
+		}
+		if publicKey := signer.EncodePublicKey(privateKey.Public().(ed25519.PublicKey)); publicKey != pKey.PublicKey {
+			t.Fatalf("TestClient_Mnemonic public key %s of phrase %q doesn't match %s", publicKey, phrase, pKey.PublicKey)
+		}
+		if mnemonicPassword != "" && mnemonic.Validate(phrase, "") != mnemonic.ErrPasswordRequired {
+			t.Fatalf("TestClient_Mnemonic phrase %q is valid without password", phrase)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedPackages checks that the committed v1 and v2 packages are generated from their schemas
+func TestGeneratedPackages(t *testing.T) {
+	for _, target := range []struct {
+		name string
+		dir  string
+	}{
+		{name: "v1", dir: filepath.Join("..", "..", "..")},
+		{name: "v2", dir: filepath.Join("..", "..")},
+	} {
+		tlFile, err := os.Open(filepath.Join(target.dir, "lib", "tonlib_api.tl"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		overrides, err := loadOverrides(target.dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err, entities, interfaces, enums := parseTlFile(tlFile, overrides, target.name == "v2")
+		tlFile.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var structs, methods *string
+		if target.name == "v1" {
+			structs, methods = generateV1(entities, interfaces, enums, overrides)
+		} else {
+			structs, methods = generateStructsFromTnEntities("v2", entities, interfaces, enums, overrides)
+		}
+		for name, content := range map[string]*string{"structs.go": structs, "methods.go": methods} {
+			generated, err := format.Source([]byte(*content))
+			if err != nil {
+				t.Fatalf("%s %s: %v", target.name, name, err)
+			}
+			committed, err := ioutil.ReadFile(filepath.Join(target.dir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(generated, committed) {
+				t.Errorf("%s %s differs from the generated one, run tlgenerator --target %s", target.name, name, target.name)
+			}
+		}
+	}
+}
@@ -8,13 +8,69 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"path/filepath"
+
+	"github.com/mercuryoio/tonlib-go/v2/cmd/tlgenerator/tl"
 )
 
 var syncWithTlCmd = &cobra.Command{
 	Use:   "tlgenerator",
 	Short: "tlgenerator /path/to/tl/file.tl",
-	Long:  `tlgenerator`,
-	Run:   syncWtithTl,
+	Long: `tlgenerator generates structs.go and methods.go of the v2 package in the output directory,
+--target v1 generates the v1 package of the root module from its schema:
+  tlgenerator --target v1 --out .. ../lib/tonlib_api.tl
+Hand-written code of the package is marked with //tlgen: directives and is not generated:
+  //tlgen:type <tl constructor or class>
+  //tlgen:skip <tl function>`,
+	Args: cobra.ArbitraryArgs,
+	Run:  syncWtithTl,
+}
+
+var outDir string
+
+// target is the generated package version, v1 or v2
+var target string
+
+var diffCmd = &cobra.Command{
+	Use:   "diff old.tl new.tl",
+	Short: "report added, removed and changed constructors and functions",
+	Args:  cobra.ExactArgs(2),
+	Run:   diffTl,
+}
+
+func parseTlSchema(tlFilePath string) *tl.Schema {
+	tlFile, err := os.Open(tlFilePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer tlFile.Close()
+	schema, err := tl.Parse(tlFile)
+	if err != nil {
+		log.Fatalf("%s:%v", tlFilePath, err)
+	}
+	return schema
+}
+
+func diffTl(cmd *cobra.Command, args []string) {
+	oldSchema := parseTlSchema(args[0])
+	newSchema := parseTlSchema(args[1])
+
+	// changes of hand-written code have to be ported manually
+	overrides, err := loadOverrides(outDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, change := range tl.Diff(oldSchema, newSchema) {
+		combinator := change.Old
+		if combinator == nil {
+			combinator = change.New
+		}
+		line := change.String()
+		if pos, ok := overrides.overriddenBy(change.Name, combinator.Result.Name, change.IsFunction); ok {
+			line += fmt.Sprintf(" (overridden at %s)", pos)
+		}
+		fmt.Println(line)
+	}
 }
 
 func syncWtithTl(cmd *cobra.Command, args []string) {
@@ -35,18 +91,36 @@ func syncWtithTl(cmd *cobra.Command, args []string) {
 	fmt.Println("Read file")
 	tlReader := bufio.NewReader(tlFile)
 
-	// eide file string by strign and parse it
-	err, entities, interfaces, enums := parseTlFile(tlReader)
+	// customisations of the generated code in the package sources
+	overrides, err := loadOverrides(outDir)
 	if err != nil {
 		log.Fatal(err)
+	}
+
+	if target != "v1" && target != "v2" {
+		log.Fatalf("unknown target %s, expected v1 or v2", target)
+	}
+
+	// eide file string by strign and parse it
+	err, entities, interfaces, enums := parseTlFile(tlReader, overrides, target == "v2")
+	if err != nil {
+		log.Fatalf("%s:%v", tlFilePath, err)
 		return
 	}
 	fmt.Printf("Parsed entities: %d, interfaces: %d, enums: %d. ", len(*entities), len(*interfaces), len(*enums))
+	for _, directive := range overrides.unused(*entities) {
+		fmt.Printf("\nUnused directive %s", directive)
+	}
 
 	// generate gp`s structs based on parsed entities
-	structsContent, methodsContetnt := generateStructsFromTnEntities("v2", entities, interfaces, enums)
-	structsFilePath := "./structs.go"
-	methodsFilePath := "./methods.go"
+	var structsContent, methodsContetnt *string
+	if target == "v1" {
+		structsContent, methodsContetnt = generateV1(entities, interfaces, enums, overrides)
+	} else {
+		structsContent, methodsContetnt = generateStructsFromTnEntities("v2", entities, interfaces, enums, overrides)
+	}
+	structsFilePath := filepath.Join(outDir, "structs.go")
+	methodsFilePath := filepath.Join(outDir, "methods.go")
 
 	// delete and create new files
 	// structures file
@@ -84,6 +158,12 @@ func syncWtithTl(cmd *cobra.Command, args []string) {
 
 }
 
+func init() {
+	syncWithTlCmd.PersistentFlags().StringVar(&outDir, "out", ".", "directory of the package")
+	syncWithTlCmd.Flags().StringVar(&target, "target", "v2", "version of the package: v1 or v2")
+	syncWithTlCmd.AddCommand(diffCmd)
+}
+
 func main() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, os.Kill)
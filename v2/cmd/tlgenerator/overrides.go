@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// GeneratedFiles are written by the generator and are not scanned for overrides
+var GeneratedFiles = []string{"structs.go", "methods.go"}
+
+// Overrides are customisations of the generated code declared with comments in the package sources,
+// so they survive regeneration:
+//
+//	//tlgen:type key   constructor or abstract class key is defined by hand, no go code is generated for it
+//	//tlgen:skip sync  function sync is implemented by hand, no request type and method are generated for it
+type Overrides struct {
+	// Types and Methods map tl names to positions of their directives
+	Types   map[string]string
+	Methods map[string]string
+}
+
+func newOverrides() *Overrides {
+	return &Overrides{Types: map[string]string{}, Methods: map[string]string{}}
+}
+
+// HasType reports whether the tl constructor or abstract class is defined by hand
+func (overrides *Overrides) HasType(name string) bool {
+	_, ok := overrides.Types[name]
+	return ok
+}
+
+// HasMethod reports whether the tl function is implemented by hand
+func (overrides *Overrides) HasMethod(name string) bool {
+	_, ok := overrides.Methods[name]
+	return ok
+}
+
+// loadOverrides reads //tlgen: directives from go files of the package directory
+func loadOverrides(dir string) (*Overrides, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	overrides := newOverrides()
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if isGeneratedFile(path) || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				if err := overrides.add(comment.Text, fset.Position(comment.Pos())); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return overrides, nil
+}
+
+func (overrides *Overrides) add(comment string, pos token.Position) error {
+	if !strings.HasPrefix(comment, "//tlgen:") {
+		return nil
+	}
+	fields := strings.Fields(comment[len("//tlgen:"):])
+	if len(fields) != 2 {
+		return fmt.Errorf("%s: expected //tlgen:<directive> <tl name>, got %s", pos, comment)
+	}
+	var names map[string]string
+	switch fields[0] {
+	case "type":
+		names = overrides.Types
+	case "skip":
+		names = overrides.Methods
+	default:
+		return fmt.Errorf("%s: unknown directive tlgen:%s", pos, fields[0])
+	}
+	if previous, ok := names[fields[1]]; ok {
+		return fmt.Errorf("%s: %s is already overridden at %s", pos, fields[1], previous)
+	}
+	names[fields[1]] = fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+	return nil
+}
+
+// unused returns directives which match nothing in the schema, they are left after the tl name is removed or renamed
+func (overrides *Overrides) unused(entities []ClassInfo) []string {
+	types := map[string]bool{}
+	methods := map[string]bool{}
+	for _, itemInfo := range entities {
+		if itemInfo.IsFunction {
+			methods[itemInfo.Name] = true
+		} else {
+			types[itemInfo.Name] = true
+			types[itemInfo.RootName] = true
+		}
+	}
+	var unused []string
+	for name, pos := range overrides.Types {
+		if !types[name] {
+			unused = append(unused, fmt.Sprintf("%s: //tlgen:type %s", pos, name))
+		}
+	}
+	for name, pos := range overrides.Methods {
+		if !methods[name] {
+			unused = append(unused, fmt.Sprintf("%s: //tlgen:skip %s", pos, name))
+		}
+	}
+	sort.Strings(unused)
+	return unused
+}
+
+// overriddenBy returns position of the directive the declaration is customised by
+func (overrides *Overrides) overriddenBy(name, rootName string, isFunction bool) (string, bool) {
+	if isFunction {
+		pos, ok := overrides.Methods[name]
+		return pos, ok
+	}
+	if pos, ok := overrides.Types[name]; ok {
+		return pos, true
+	}
+	pos, ok := overrides.Types[rootName]
+	return pos, ok
+}
+
+func isGeneratedFile(path string) bool {
+	for _, name := range GeneratedFiles {
+		if filepath.Base(path) == name {
+			return true
+		}
+	}
+	return false
+}
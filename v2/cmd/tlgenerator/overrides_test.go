@@ -0,0 +1,81 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeSources(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "tlgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadOverrides(t *testing.T) {
+	dir := writeSources(t, map[string]string{
+		"client.go": `package v2
+
+//tlgen:type key
+type Key struct{}
+
+// Sync is implemented by hand
+//tlgen:skip sync
+func Sync() {}
+`,
+		"structs.go":     "package v2\n\n//tlgen:type generated\n",
+		"client_test.go": "package v2\n\n//tlgen:skip test\n",
+	})
+	defer os.RemoveAll(dir)
+
+	overrides, err := loadOverrides(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(overrides.Types, map[string]string{"key": "client.go:3"}) {
+		t.Fatalf("unexpected types: %v", overrides.Types)
+	}
+	if !reflect.DeepEqual(overrides.Methods, map[string]string{"sync": "client.go:7"}) {
+		t.Fatalf("unexpected methods: %v", overrides.Methods)
+	}
+
+	entities := []ClassInfo{{Name: "key", RootName: "Key"}, {Name: "getKey", RootName: "Key", IsFunction: true}}
+	if unused := overrides.unused(entities); !reflect.DeepEqual(unused, []string{"client.go:7: //tlgen:skip sync"}) {
+		t.Fatalf("unexpected unused directives: %v", unused)
+	}
+	if pos, ok := overrides.overriddenBy("key", "Key", false); !ok || pos != "client.go:3" {
+		t.Fatalf("key is not overridden: %s", pos)
+	}
+	if _, ok := overrides.overriddenBy("key", "Key", true); ok {
+		t.Fatal("function is overridden by type directive")
+	}
+}
+
+func TestLoadOverridesErrors(t *testing.T) {
+	tests := []struct {
+		src string
+		err string
+	}{
+		{"package v2\n\n//tlgen:rename key\n", "a.go:3:1: unknown directive tlgen:rename"},
+		{"package v2\n\n//tlgen:type\n", "a.go:3:1: expected //tlgen:<directive> <tl name>, got //tlgen:type"},
+		{"package v2\n\n//tlgen:skip sync\n//tlgen:skip sync\n", "a.go:4:1: sync is already overridden at a.go:3"},
+	}
+	for _, test := range tests {
+		dir := writeSources(t, map[string]string{"a.go": test.src})
+		_, err := loadOverrides(dir)
+		os.RemoveAll(dir)
+		if err == nil || !strings.HasSuffix(err.Error(), test.err) {
+			t.Errorf("%q: expected error %q, got %v", test.src, test.err, err)
+		}
+	}
+}
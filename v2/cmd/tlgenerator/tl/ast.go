@@ -0,0 +1,150 @@
+// Package tl parses TL schema files like tonlib_api.tl into a syntax tree of constructors,
+// functions and abstract class descriptions, keeping their doc comments.
+package tl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Position is a line and column in the schema, both start from 1
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (pos Position) String() string {
+	return fmt.Sprintf("%d:%d", pos.Line, pos.Column)
+}
+
+// Error is a syntax error at the position
+type Error struct {
+	Pos Position
+	Msg string
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("%s: %s", err.Pos, err.Msg)
+}
+
+// Schema is a parsed TL schema
+type Schema struct {
+	// Constructors are declarations before ---functions---
+	Constructors []*Combinator
+	Functions    []*Combinator
+	// Classes are abstract classes described with //@class comments
+	Classes []*Class
+}
+
+// Class is an abstract class described with //@class comment
+type Class struct {
+	Name        string
+	Description string
+	Pos         Position
+}
+
+// Combinator is a constructor or function declaration:
+// name#id {t:Type} param:type ... = ResultType;
+type Combinator struct {
+	Name string
+	// ID is the explicit #id of the declaration, 0 if it is not set
+	ID         uint32
+	TypeParams []Param
+	Params     []Param
+	Result     *Type
+	// Builtin declarations like "double ? = Double", "vector {t:Type} # [ t ] = Vector t"
+	// and "int256 8*[ int32 ] = Int256" have no regular params
+	Builtin     bool
+	IsFunction  bool
+	Description string
+	Pos         Position
+}
+
+// Param is a named parameter of a combinator
+type Param struct {
+	Name        string
+	Type        *Type
+	Description string
+	Pos         Position
+}
+
+// Type is a type expression: a name with optional arguments, e.g. vector<vector<string>>
+type Type struct {
+	Name string
+	Args []*Type
+	Pos  Position
+}
+
+// IsVector reports whether the type is vector<T>
+func (t *Type) IsVector() bool {
+	return (t.Name == "vector" || t.Name == "Vector") && len(t.Args) == 1
+}
+
+// Elem returns the type of vector items
+func (t *Type) Elem() *Type {
+	if !t.IsVector() {
+		return nil
+	}
+	return t.Args[0]
+}
+
+// String returns the type in TL syntax
+func (t *Type) String() string {
+	if len(t.Args) == 0 {
+		return t.Name
+	}
+	args := make([]string, len(t.Args))
+	for i, arg := range t.Args {
+		args[i] = arg.String()
+	}
+	return t.Name + "<" + strings.Join(args, ",") + ">"
+}
+
+// String returns the declaration in TL syntax without doc comments
+func (c *Combinator) String() string {
+	parts := []string{c.Name}
+	for _, param := range c.TypeParams {
+		parts = append(parts, "{"+param.String()+"}")
+	}
+	for _, param := range c.Params {
+		parts = append(parts, param.String())
+	}
+	if c.Builtin && len(c.Params) == 0 {
+		parts = append(parts, "?")
+	}
+	return strings.Join(parts, " ") + " = " + c.Result.String()
+}
+
+// String returns the parameter in TL syntax
+func (param Param) String() string {
+	return param.Name + ":" + param.Type.String()
+}
+
+// Param returns the parameter with the name
+func (c *Combinator) Param(name string) (*Param, bool) {
+	for i := range c.Params {
+		if c.Params[i].Name == name {
+			return &c.Params[i], true
+		}
+	}
+	return nil, false
+}
+
+// Constructor returns the constructor with the name
+func (schema *Schema) Constructor(name string) (*Combinator, bool) {
+	return findCombinator(schema.Constructors, name)
+}
+
+// Function returns the function with the name
+func (schema *Schema) Function(name string) (*Combinator, bool) {
+	return findCombinator(schema.Functions, name)
+}
+
+func findCombinator(combinators []*Combinator, name string) (*Combinator, bool) {
+	for _, combinator := range combinators {
+		if combinator.Name == name {
+			return combinator, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,116 @@
+package tl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeKind is a kind of declaration change between two schemas
+type ChangeKind int
+
+const (
+	Added ChangeKind = iota
+	Removed
+	Changed
+)
+
+func (kind ChangeKind) String() string {
+	switch kind {
+	case Added:
+		return "+"
+	case Removed:
+		return "-"
+	case Changed:
+		return "~"
+	}
+	return "?"
+}
+
+// Change is a difference of a constructor or function between two schemas
+type Change struct {
+	Kind       ChangeKind
+	Name       string
+	IsFunction bool
+	// Old is nil for added declarations, New is nil for removed ones
+	Old *Combinator
+	New *Combinator
+	// Details describe changes of params and result type of a changed declaration
+	Details []string
+}
+
+// String returns the change in the "+ constructor name a:int32 = Result" form
+func (change Change) String() string {
+	kind := "constructor"
+	if change.IsFunction {
+		kind = "function"
+	}
+	switch change.Kind {
+	case Added:
+		return fmt.Sprintf("%s %s %s", change.Kind, kind, change.New)
+	case Removed:
+		return fmt.Sprintf("%s %s %s", change.Kind, kind, change.Old)
+	}
+	return fmt.Sprintf("%s %s %s: %s", change.Kind, kind, change.Name, strings.Join(change.Details, ", "))
+}
+
+// Diff compares constructors and functions of the schemas by name. Changes of constructors go first,
+// both are sorted by name
+func Diff(old, updated *Schema) []Change {
+	changes := diffCombinators(old.Constructors, updated.Constructors)
+	return append(changes, diffCombinators(old.Functions, updated.Functions)...)
+}
+
+func diffCombinators(old, updated []*Combinator) []Change {
+	oldByName := make(map[string]*Combinator, len(old))
+	for _, combinator := range old {
+		oldByName[combinator.Name] = combinator
+	}
+	newByName := make(map[string]*Combinator, len(updated))
+	for _, combinator := range updated {
+		newByName[combinator.Name] = combinator
+	}
+
+	var changes []Change
+	for _, combinator := range updated {
+		previous, ok := oldByName[combinator.Name]
+		if !ok {
+			changes = append(changes, Change{Kind: Added, Name: combinator.Name, IsFunction: combinator.IsFunction, New: combinator})
+			continue
+		}
+		if details := diffCombinator(previous, combinator); len(details) > 0 {
+			changes = append(changes, Change{Kind: Changed, Name: combinator.Name, IsFunction: combinator.IsFunction,
+				Old: previous, New: combinator, Details: details})
+		}
+	}
+	for _, combinator := range old {
+		if _, ok := newByName[combinator.Name]; !ok {
+			changes = append(changes, Change{Kind: Removed, Name: combinator.Name, IsFunction: combinator.IsFunction, Old: combinator})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+func diffCombinator(old, updated *Combinator) []string {
+	var details []string
+	if old.Result.String() != updated.Result.String() {
+		details = append(details, fmt.Sprintf("result %s -> %s", old.Result, updated.Result))
+	}
+	for _, param := range old.Params {
+		newParam, ok := updated.Param(param.Name)
+		if !ok {
+			details = append(details, "removed param "+param.String())
+		} else if param.Type.String() != newParam.Type.String() {
+			details = append(details, fmt.Sprintf("param %s type %s -> %s", param.Name, param.Type, newParam.Type))
+		}
+	}
+	for _, param := range updated.Params {
+		if _, ok := old.Param(param.Name); !ok {
+			details = append(details, "added param "+param.String())
+		}
+	}
+	return details
+}
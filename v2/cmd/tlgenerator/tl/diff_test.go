@@ -0,0 +1,58 @@
+package tl
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	old, err := ParseString(`
+ok = Ok;
+raw.message source:accountAddress value:int64 msg_data:msg.Data = raw.Message;
+wallet.initialAccountState public_key:string = InitialAccountState;
+---functions---
+raw.getAccountState account_address:accountAddress = raw.FullAccountState;
+wallet.init private_key:InputKey = Ok;
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := ParseString(`
+ok = Ok;
+raw.message source:accountAddress value:int64 msg_data:msg.Data = raw.Message;
+raw.extMessage destination:accountAddress body:bytes = raw.Message;
+wallet.initialAccountState public_key:string wallet_id:int64 = InitialAccountState;
+---functions---
+raw.getAccountState account_address:accountAddress from:vector<int53> = raw.AccountState;
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := Diff(old, updated)
+	expected := []string{
+		"+ constructor raw.extMessage destination:accountAddress body:bytes = raw.Message",
+		"~ constructor wallet.initialAccountState: added param wallet_id:int64",
+		"~ function raw.getAccountState: result raw.FullAccountState -> raw.AccountState, added param from:vector<int53>",
+		"- function wallet.init private_key:InputKey = Ok",
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("unexpected changes: %v", changes)
+	}
+	for i, change := range changes {
+		if change.String() != expected[i] {
+			t.Errorf("change %d: %q, expected %q", i, change, expected[i])
+		}
+	}
+	if changes[1].Kind != Changed || changes[1].Old == nil || changes[1].New == nil {
+		t.Fatalf("unexpected change: %#v", changes[1])
+	}
+
+	changed, _ := ParseString("a x:int32 y:string = A;\n")
+	retyped, _ := ParseString("a x:int64 = A;\n")
+	changes = Diff(changed, retyped)
+	if len(changes) != 1 || changes[0].String() != "~ constructor a: param x type int32 -> int64, removed param y:string" {
+		t.Fatalf("unexpected changes: %v", changes)
+	}
+	if len(Diff(updated, updated)) != 0 {
+		t.Fatal("expected no changes of the same schema")
+	}
+}
@@ -0,0 +1,166 @@
+package tl
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenPunct
+	tokenComment
+	tokenSection
+)
+
+func (kind tokenKind) String() string {
+	switch kind {
+	case tokenEOF:
+		return "end of file"
+	case tokenIdent:
+		return "identifier"
+	case tokenNumber:
+		return "number"
+	case tokenPunct:
+		return "punctuation"
+	case tokenComment:
+		return "comment"
+	case tokenSection:
+		return "section"
+	}
+	return "unknown token"
+}
+
+type token struct {
+	kind tokenKind
+	// text is the identifier, the punctuation character, comment text after // or section name
+	text string
+	pos  Position
+	// joined is set when the token directly follows the previous one without spaces
+	joined bool
+}
+
+func (tok token) String() string {
+	switch tok.kind {
+	case tokenEOF:
+		return tok.kind.String()
+	case tokenComment:
+		return "comment"
+	case tokenSection:
+		return fmt.Sprintf("---%s---", tok.text)
+	}
+	return fmt.Sprintf("%q", tok.text)
+}
+
+const punctuation = ":;=<>,{}[]#?()!%*"
+
+type lexer struct {
+	src    string
+	offset int
+	pos    Position
+	joined bool
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, pos: Position{Line: 1, Column: 1}}
+}
+
+// tokens splits the whole source into tokens, the last one is tokenEOF
+func (l *lexer) tokens() ([]token, error) {
+	var tokens []token
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, tok)
+		if tok.kind == tokenEOF {
+			return tokens, nil
+		}
+	}
+}
+
+func (l *lexer) next() (token, error) {
+	l.joined = true
+	for l.offset < len(l.src) && isSpace(l.src[l.offset]) {
+		l.advance(1)
+		l.joined = false
+	}
+	tok := token{pos: l.pos, joined: l.joined}
+	if l.offset >= len(l.src) {
+		tok.kind = tokenEOF
+		return tok, nil
+	}
+	rest := l.src[l.offset:]
+	c := rest[0]
+	switch {
+	case strings.HasPrefix(rest, "//"):
+		end := strings.IndexByte(rest, '\n')
+		if end < 0 {
+			end = len(rest)
+		}
+		tok.kind, tok.text = tokenComment, strings.TrimRight(rest[2:end], "\r")
+		l.advance(end)
+	case strings.HasPrefix(rest, "/*"):
+		end := strings.Index(rest[2:], "*/")
+		if end < 0 {
+			return tok, &Error{Pos: tok.pos, Msg: "unterminated comment"}
+		}
+		tok.kind, tok.text = tokenComment, rest[2:end+2]
+		l.advance(end + 4)
+	case strings.HasPrefix(rest, "---"):
+		end := strings.Index(rest[3:], "---")
+		if end < 0 {
+			return tok, &Error{Pos: tok.pos, Msg: "unterminated section separator"}
+		}
+		tok.kind, tok.text = tokenSection, rest[3:end+3]
+		l.advance(end + 6)
+	case isLetter(c):
+		n := 1
+		for n < len(rest) && (isLetter(rest[n]) || isDigit(rest[n]) || rest[n] == '.') {
+			n++
+		}
+		tok.kind, tok.text = tokenIdent, rest[:n]
+		l.advance(n)
+	case isDigit(c):
+		n := 1
+		for n < len(rest) && (isLetter(rest[n]) || isDigit(rest[n])) {
+			n++
+		}
+		tok.kind, tok.text = tokenNumber, rest[:n]
+		l.advance(n)
+	case strings.IndexByte(punctuation, c) >= 0:
+		tok.kind, tok.text = tokenPunct, rest[:1]
+		l.advance(1)
+	default:
+		return tok, &Error{Pos: tok.pos, Msg: fmt.Sprintf("unexpected character %q", c)}
+	}
+	return tok, nil
+}
+
+func (l *lexer) advance(n int) {
+	for _, c := range l.src[l.offset : l.offset+n] {
+		if c == '\n' {
+			l.pos.Line++
+			l.pos.Column = 1
+		} else {
+			l.pos.Column++
+		}
+	}
+	l.offset += n
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
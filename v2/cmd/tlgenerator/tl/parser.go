@@ -0,0 +1,327 @@
+package tl
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// Parse reads and parses the whole schema
+func Parse(r io.Reader) (*Schema, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseString(string(src))
+}
+
+// ParseString parses the schema source
+func ParseString(src string) (*Schema, error) {
+	tokens, err := newLexer(src).tokens()
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	return p.parseSchema()
+}
+
+type parser struct {
+	tokens []token
+	index  int
+
+	isFunctions bool
+	// doc holds @tags of the comments before the next declaration
+	doc     map[string]string
+	lastTag string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.index]
+	if tok.kind != tokenEOF {
+		p.index++
+	}
+	return tok
+}
+
+func (p *parser) errorf(pos Position, format string, args ...interface{}) error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) isPunct(text string) bool {
+	tok := p.peek()
+	return tok.kind == tokenPunct && tok.text == text
+}
+
+func (p *parser) expectPunct(text string) (token, error) {
+	tok := p.next()
+	if tok.kind != tokenPunct || tok.text != text {
+		return tok, p.errorf(tok.pos, "expected %q, got %s", text, tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) expectIdent(what string) (token, error) {
+	tok := p.next()
+	if tok.kind != tokenIdent {
+		return tok, p.errorf(tok.pos, "expected %s, got %s", what, tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) parseSchema() (*Schema, error) {
+	schema := &Schema{}
+	for {
+		tok := p.peek()
+		switch tok.kind {
+		case tokenEOF:
+			return schema, nil
+		case tokenComment:
+			p.next()
+			if class := p.parseComment(tok); class != nil {
+				schema.Classes = append(schema.Classes, class)
+			}
+		case tokenSection:
+			p.next()
+			switch tok.text {
+			case "functions":
+				p.isFunctions = true
+			case "types":
+				p.isFunctions = false
+			default:
+				return nil, p.errorf(tok.pos, "unknown section %s", tok)
+			}
+			p.resetDoc()
+		case tokenIdent:
+			combinator, err := p.parseCombinator()
+			if err != nil {
+				return nil, err
+			}
+			if p.isFunctions {
+				schema.Functions = append(schema.Functions, combinator)
+			} else {
+				schema.Constructors = append(schema.Constructors, combinator)
+			}
+		default:
+			return nil, p.errorf(tok.pos, "expected declaration, got %s", tok)
+		}
+	}
+}
+
+func (p *parser) resetDoc() {
+	p.doc = nil
+	p.lastTag = ""
+}
+
+// parseComment collects //@tag value pairs and //- continuation lines, plain comments are skipped.
+// A //@class comment describes an abstract class and is returned instead of being collected
+func (p *parser) parseComment(tok token) *Class {
+	text := strings.TrimSpace(tok.text)
+	continued := strings.HasPrefix(text, "-")
+	if continued {
+		if p.lastTag == "" {
+			return nil
+		}
+		text = strings.TrimSpace(text[1:])
+	} else if strings.HasPrefix(text, "@") {
+		text = text[1:]
+	} else {
+		return nil
+	}
+
+	tags := map[string]string{}
+	var lastTag string
+	for i, part := range strings.Split(text, " @") {
+		if i == 0 && continued {
+			tags[p.lastTag] = strings.TrimSpace(p.doc[p.lastTag] + " " + part)
+			lastTag = p.lastTag
+			continue
+		}
+		name, value := part, ""
+		if i := strings.IndexAny(part, " \t"); i >= 0 {
+			name, value = part[:i], strings.TrimSpace(part[i+1:])
+		}
+		tags[name] = value
+		lastTag = name
+	}
+	if name, ok := tags["class"]; ok {
+		p.resetDoc()
+		return &Class{Name: name, Description: tags["description"], Pos: tok.pos}
+	}
+	if p.doc == nil {
+		p.doc = map[string]string{}
+	}
+	for name, value := range tags {
+		p.doc[name] = value
+	}
+	p.lastTag = lastTag
+	return nil
+}
+
+// parseCombinator parses name#id {t:Type} param:type ... = ResultType;
+func (p *parser) parseCombinator() (*Combinator, error) {
+	nameTok := p.next()
+	combinator := &Combinator{Name: nameTok.text, Pos: nameTok.pos, IsFunction: p.isFunctions}
+	if p.isPunct("#") && p.peek().joined {
+		p.next()
+		idTok := p.next()
+		if (idTok.kind != tokenNumber && idTok.kind != tokenIdent) || !idTok.joined {
+			return nil, p.errorf(idTok.pos, "expected constructor id, got %s", idTok)
+		}
+		id, err := strconv.ParseUint(idTok.text, 16, 32)
+		if err != nil {
+			return nil, p.errorf(idTok.pos, "invalid constructor id %s", idTok)
+		}
+		combinator.ID = uint32(id)
+	}
+
+	for !p.isPunct("=") {
+		tok := p.peek()
+		switch {
+		case tok.kind == tokenEOF:
+			return nil, p.errorf(tok.pos, "unexpected end of file in declaration of %s", combinator.Name)
+		case tok.kind == tokenPunct && tok.text == "{":
+			p.next()
+			param, err := p.parseParam()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expectPunct("}"); err != nil {
+				return nil, err
+			}
+			combinator.TypeParams = append(combinator.TypeParams, param)
+		case tok.kind == tokenPunct && tok.text == "?":
+			p.next()
+			combinator.Builtin = true
+		case tok.kind == tokenPunct && tok.text == "#":
+			// vector {t:Type} # [ t ] = Vector t;
+			p.next()
+			if err := p.skipRepetition(); err != nil {
+				return nil, err
+			}
+			combinator.Builtin = true
+		case tok.kind == tokenNumber:
+			// int256 8*[ int32 ] = Int256;
+			p.next()
+			if _, err := p.expectPunct("*"); err != nil {
+				return nil, err
+			}
+			if err := p.skipRepetition(); err != nil {
+				return nil, err
+			}
+			combinator.Builtin = true
+		case tok.kind == tokenIdent:
+			param, err := p.parseParam()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := combinator.Param(param.Name); ok {
+				return nil, p.errorf(param.Pos, "duplicate parameter %s of %s", param.Name, combinator.Name)
+			}
+			combinator.Params = append(combinator.Params, param)
+		default:
+			return nil, p.errorf(tok.pos, "expected parameter of %s, got %s", combinator.Name, tok)
+		}
+	}
+	p.next()
+
+	result, err := p.parseResultType()
+	if err != nil {
+		return nil, err
+	}
+	combinator.Result = result
+	if _, err := p.expectPunct(";"); err != nil {
+		return nil, err
+	}
+
+	combinator.Description = p.doc["description"]
+	for i := range combinator.Params {
+		param := &combinator.Params[i]
+		param.Description = p.doc[param.Name]
+		if param.Name == "description" {
+			param.Description = p.doc["param_description"]
+		}
+	}
+	p.resetDoc()
+	return combinator, nil
+}
+
+func (p *parser) parseParam() (Param, error) {
+	nameTok, err := p.expectIdent("parameter name")
+	if err != nil {
+		return Param{}, err
+	}
+	if _, err := p.expectPunct(":"); err != nil {
+		return Param{}, err
+	}
+	paramType, err := p.parseType()
+	if err != nil {
+		return Param{}, err
+	}
+	return Param{Name: nameTok.text, Type: paramType, Pos: nameTok.pos}, nil
+}
+
+// parseType parses name or name<type, ...>
+func (p *parser) parseType() (*Type, error) {
+	nameTok, err := p.expectIdent("type")
+	if err != nil {
+		return nil, err
+	}
+	t := &Type{Name: nameTok.text, Pos: nameTok.pos}
+	if !p.isPunct("<") {
+		return t, nil
+	}
+	p.next()
+	for {
+		arg, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		t.Args = append(t.Args, arg)
+		if p.isPunct(",") {
+			p.next()
+			continue
+		}
+		if _, err := p.expectPunct(">"); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+}
+
+// parseResultType parses the type after "=", type arguments may follow the name with spaces: Vector t
+func (p *parser) parseResultType() (*Type, error) {
+	t, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenIdent {
+		arg, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		t.Args = append(t.Args, arg)
+	}
+	return t, nil
+}
+
+// skipRepetition skips "[ t ]" following # in the vector declaration or 8* in the int256 one
+func (p *parser) skipRepetition() error {
+	if !p.isPunct("[") {
+		return nil
+	}
+	open := p.next()
+	for !p.isPunct("]") {
+		if p.peek().kind == tokenEOF {
+			return p.errorf(open.pos, "unclosed %q", "[")
+		}
+		p.next()
+	}
+	p.next()
+	return nil
+}
@@ -0,0 +1,140 @@
+package tl
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseTonlibSchema(t *testing.T) {
+	file, err := os.Open("../../../lib/tonlib_api.tl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	schema, err := Parse(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schema.Constructors) != 128 || len(schema.Functions) != 62 {
+		t.Fatalf("unexpected amount of declarations: %d constructors, %d functions", len(schema.Constructors), len(schema.Functions))
+	}
+	if len(schema.Classes) != 1 || schema.Classes[0].Name != "LogStream" ||
+		schema.Classes[0].Description != "Describes a stream to which tonlib internal log is written" {
+		t.Fatalf("unexpected classes: %#v", schema.Classes)
+	}
+
+	message, ok := schema.Constructor("raw.message")
+	if !ok {
+		t.Fatal("raw.message is not found")
+	}
+	if message.Result.String() != "raw.Message" || len(message.Params) != 8 {
+		t.Fatalf("unexpected raw.message: %#v", message)
+	}
+	if param, ok := message.Param("msg_data"); !ok || param.Type.String() != "msg.Data" {
+		t.Fatalf("unexpected msg_data: %#v", param)
+	}
+
+	transactions, _ := schema.Constructor("raw.transactions")
+	if param, ok := transactions.Param("transactions"); !ok || !param.Type.IsVector() || param.Type.Elem().Name != "raw.transaction" {
+		t.Fatalf("unexpected transactions: %#v", param)
+	}
+
+	vector, _ := schema.Constructor("vector")
+	if !vector.Builtin || len(vector.TypeParams) != 1 || vector.Result.String() != "Vector<t>" {
+		t.Fatalf("unexpected vector: %#v", vector)
+	}
+	if double, _ := schema.Constructor("double"); !double.Builtin || double.Result.Name != "Double" {
+		t.Fatalf("unexpected double: %#v", double)
+	}
+	if int256, _ := schema.Constructor("int256"); !int256.Builtin || len(int256.Params) != 0 || int256.Result.Name != "Int256" {
+		t.Fatalf("unexpected int256: %#v", int256)
+	}
+
+	logFile, _ := schema.Constructor("logStreamFile")
+	if logFile.Description != "The log is written to a file" {
+		t.Fatalf("unexpected description: %q", logFile.Description)
+	}
+	if param, _ := logFile.Param("max_file_size"); param.Description !=
+		"Maximum size of the file to where the internal tonlib log is written before the file will be auto-rotated" {
+		t.Fatalf("unexpected param description: %q", param.Description)
+	}
+
+	getState, ok := schema.Function("getAccountState")
+	if !ok || !getState.IsFunction || getState.Result.Name != "FullAccountState" {
+		t.Fatalf("unexpected getAccountState: %#v", getState)
+	}
+	setVerbosity, _ := schema.Function("setLogVerbosityLevel")
+	if param, _ := setVerbosity.Param("new_verbosity_level"); param.Description == "" {
+		t.Fatal("expected description of new_verbosity_level from the following comment line")
+	}
+	if _, ok := schema.Constructor("getAccountState"); ok {
+		t.Fatal("function is parsed as constructor")
+	}
+}
+
+func TestParseDeclarations(t *testing.T) {
+	schema, err := ParseString(`
+//@description A matrix @rows Matrix rows,
+//-top to bottom @param_description Description of the matrix
+matrix#1a2b3c4d rows:vector<vector<int32>> description:string = Matrix;
+pair {X:Type} {Y:Type} first:X second:Y = Pair X Y;
+map entries:vector<pair<string,int64>> = Map;
+---functions---
+// plain comments are skipped
+getMatrix id:int53 = Matrix;
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matrix := schema.Constructors[0]
+	if matrix.ID != 0x1a2b3c4d || matrix.Description != "A matrix" {
+		t.Fatalf("unexpected matrix: %#v", matrix)
+	}
+	if matrix.Params[0].Type.String() != "vector<vector<int32>>" || matrix.Params[0].Description != "Matrix rows, top to bottom" {
+		t.Fatalf("unexpected rows: %#v", matrix.Params[0])
+	}
+	if matrix.Params[1].Description != "Description of the matrix" {
+		t.Fatalf("unexpected description param: %#v", matrix.Params[1])
+	}
+	if matrix.Params[0].Pos != (Position{Line: 4, Column: 17}) {
+		t.Fatalf("unexpected position: %s", matrix.Params[0].Pos)
+	}
+
+	pair := schema.Constructors[1]
+	if len(pair.TypeParams) != 2 || pair.Result.String() != "Pair<X,Y>" || pair.Params[1].Type.Name != "Y" {
+		t.Fatalf("unexpected pair: %#v", pair)
+	}
+	if pair.Description != "" {
+		t.Fatalf("description is kept after declaration: %q", pair.Description)
+	}
+	if entries := schema.Constructors[2].Params[0].Type; entries.Elem().String() != "pair<string,int64>" {
+		t.Fatalf("unexpected entries: %s", entries)
+	}
+	if len(schema.Functions) != 1 || schema.Functions[0].Name != "getMatrix" || schema.Functions[0].Description != "" {
+		t.Fatalf("unexpected functions: %#v", schema.Functions)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		src string
+		err string
+	}{
+		{"foo a:int32 = Foo", `1:18: expected ";", got end of file`},
+		{"foo a int32 = Foo;", `1:7: expected ":", got "int32"`},
+		{"foo a:vector<int32 = Foo;", `1:20: expected ">", got "="`},
+		{"ok = Ok;\nfoo a:int32 a:int64 = Foo;", "2:13: duplicate parameter a of foo"},
+		{"foo a:int32 $ = Foo;", "1:13: unexpected character '$'"},
+		{"foo#xyz = Foo;", `1:5: invalid constructor id "xyz"`},
+		{"foo a:int32", "1:12: unexpected end of file in declaration of foo"},
+		{"= Foo;", `1:1: expected declaration, got "="`},
+		{"---methods---\n", "1:1: unknown section ---methods---"},
+		{"ok = Ok;\n/* unclosed", "2:1: unterminated comment"},
+	}
+	for _, test := range tests {
+		_, err := ParseString(test.src)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%q: expected error %q, got %v", test.src, test.err, err)
+		}
+	}
+}
@@ -8,16 +8,28 @@ import (
 	"github.com/asaskevich/govalidator"
 )
 
+// StructNamesExcludedFromGenerator are builtin tl types mapped to go types,
+// hand-written types are marked with //tlgen:type directives instead
 var StructNamesExcludedFromGenerator = []string{
-	"secureBytes", "secureString", "bytes", "vector", "key",
+	"secureBytes", "secureString", "bytes", "int256", "vector",
 }
 
-var SkipMethodNames = []string{
-	"sync", "query.estimateFees",
+// AbstractClassesExcludedFromGenerator are builtin abstract classes without generated interfaces: Bool is go bool
+var AbstractClassesExcludedFromGenerator = []string{
+	"Bool",
+}
+
+func isExcludedAbstractClass(name string, overrides *Overrides) bool {
+	for _, excluded := range AbstractClassesExcludedFromGenerator {
+		if excluded == name {
+			return true
+		}
+	}
+	return overrides.HasType(name)
 }
 
 func generateStructsFromTnEntities(
-	packageName string, entities *[]ClassInfo, interfaces *[]InterfaceInfo, enums *[]EnumInfo) (*string, *string) {
+	packageName string, entities *[]ClassInfo, interfaces *[]InterfaceInfo, enums *[]EnumInfo, overrides *Overrides) (*string, *string) {
 	structsContent := fmt.Sprintf("package %s\n\n", packageName)
 	structUnmarshals := ""
 	structsContent += `
@@ -25,8 +37,8 @@ func generateStructsFromTnEntities(
 	import (
 		"encoding/json"
 		"fmt"
-		"strconv"
-		"strings"
+
+		"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
 	)
 	
 	`
@@ -34,6 +46,7 @@ func generateStructsFromTnEntities(
 	methodsContent += `
 	
 	import (
+		"context"
 		"encoding/json"
 		"fmt"
 	)
@@ -48,34 +61,14 @@ func generateStructsFromTnEntities(
 	structsContent += `
 	type SecureBytes   []byte
 	type SecureString  string
-	type Bytes         []byte
-	type TvmStackEntry interface {}
-	type SmcMethodId   interface {} 
-	type TvmNumber     interface {} 
+	type Bytes         = tonlibjson.Bytes
+	type Int256        = tonlibjson.Int256
 	type GenericAccountState string
 	`
 
 	structsContent += `
 	// JSONInt64 alias for int64, in order to deal with json big number problem
-	type JSONInt64 int64
-	
-	// MarshalJSON marshals to json
-	func (jsonInt *JSONInt64) MarshalJSON() ([]byte, error) {
-		intStr := strconv.FormatInt(int64(*jsonInt), 10)
-		return []byte(intStr), nil
-	}
-	
-	// UnmarshalJSON unmarshals from json
-	func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
-		intStr := string(b)
-		intStr = strings.Replace(intStr, "\"", "", 2)
-		jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
-		if err != nil {
-			return err
-		}
-		*jsonInt = JSONInt64(jsonBigInt)
-		return nil
-	}
+	type JSONInt64 = tonlibjson.JSONInt64
 `
 
 	structsContent += `
@@ -97,8 +90,7 @@ func generateStructsFromTnEntities(
 
 		consts := ""
 		for _, item := range enum.Items {
-			consts += item + "Type " + enum.EnumType + " = \"" +
-				strings.ToLower(item[:1]) + item[1:] + "\"\n"
+			consts += getStructName(item) + "Type " + enum.EnumType + " = \"" + item + "\"\n"
 
 		}
 		structsContent += fmt.Sprintf(`
@@ -109,16 +101,43 @@ func generateStructsFromTnEntities(
 	}
 
 	for _, interfaceInfo := range *interfaces {
-		interfaceInfo.Name = interfaceInfo.Name
+		interfaceName := getStructName(interfaceInfo.Name)
 		typesCases := ""
 
-		structsContent += fmt.Sprintf("// %s %s \ntype %s interface {\nGet%sEnum() %sEnum\n}\n\n",
-			interfaceInfo.Name, interfaceInfo.Description, interfaceInfo.Name, interfaceInfo.Name, interfaceInfo.Name)
+		structsContent += fmt.Sprintf("// %s %s \ntype %s interface {\nTonMessage\nGet%sEnum() %sEnum\n}\n\n",
+			interfaceName, interfaceInfo.Description, interfaceName, interfaceName, interfaceName)
+
+		// newer tonlib may return constructors missing in the schema, they are kept as received
+		structsContent += fmt.Sprintf(`// Unknown%s holds any %s constructor missing in the schema, Raw keeps it as received
+			type Unknown%s struct {
+				Type string
+				Raw  json.RawMessage
+			}
+
+			// MessageType return the string telegram-type of Unknown%s
+			func (unknown *Unknown%s) MessageType() string {
+				return unknown.Type
+			}
+
+			// Get%sEnum return the enum type of Unknown%s
+			func (unknown *Unknown%s) Get%sEnum() %sEnum {
+				return %sEnum(unknown.Type)
+			}
+
+			// MarshalJSON returns the object as received
+			func (unknown *Unknown%s) MarshalJSON() ([]byte, error) {
+				return unknown.Raw, nil
+			}
+
+			`,
+			interfaceName, interfaceName, interfaceName, interfaceName, interfaceName,
+			interfaceName, interfaceName, interfaceName, interfaceName, interfaceName, interfaceName,
+			interfaceName)
 
 		for _, enum := range *enums {
-			if enum.EnumType == interfaceInfo.Name+"Enum" {
+			if enum.EnumType == interfaceName+"Enum" {
 				for _, enumItem := range enum.Items {
-					typeName := enumItem
+					typeName := getStructName(enumItem)
 					typeNameCamel := strings.ToLower(typeName[:1]) + typeName[1:]
 					typesCases += fmt.Sprintf(`case %s:
 						var %s %s
@@ -126,7 +145,7 @@ func generateStructsFromTnEntities(
 						return &%s, err
 						
 						`,
-						enumItem+"Type", typeNameCamel, typeName,
+						typeName+"Type", typeNameCamel, typeName,
 						typeNameCamel, typeNameCamel)
 				}
 				break
@@ -144,21 +163,28 @@ func generateStructsFromTnEntities(
 					if err != nil {
 						return nil, err
 					}
+					if objMap == nil {
+						return nil, nil
+					}
+					typeName, _ := objMap["@type"].(string)
 
-					switch %sEnum(objMap["@type"].(string)) {
+					switch %sEnum(typeName) {
 						%s
 					default:
-						return nil, fmt.Errorf("Error unmarshaling, unknown type:" +  objMap["@type"].(string))
+						return &Unknown%s{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
 					}
 				}
-				`, interfaceInfo.Name, interfaceInfo.Name, interfaceInfo.Name,
-			typesCases)
+				`, interfaceName, interfaceName, interfaceName,
+			typesCases, interfaceName)
 	}
 
+	// abstract classes used as vector items, unmarshal functions are generated for them after structs
+	var vectorInterfaces []string
+
 	// gen entity`s structs
 	for _, itemInfo := range *entities {
 		// skip generation
-		skip := false
+		skip := overrides.HasType(itemInfo.Name)
 		for _, name := range StructNamesExcludedFromGenerator {
 			if itemInfo.Name == name {
 				skip = true
@@ -191,7 +217,7 @@ func generateStructsFromTnEntities(
 				propName := govalidator.UnderscoreToCamelCase(prop.Name)
 				propName = propName
 
-				dataType, isPrimitive := convertDataType(prop.Type, true)
+				dataType, isPrimitive := convertDataType(prop.Type)
 				propsStrItem := ""
 				if isPrimitive || checkIsInterface(dataType, interfaces) {
 					propsStrItem += fmt.Sprintf("%s %s `json:\"%s\"` // %s", propName, dataType, prop.Name, prop.Description)
@@ -203,17 +229,39 @@ func generateStructsFromTnEntities(
 				}
 
 				propsStr += propsStrItem
-				if !checkIsInterface(prop.Type, interfaces) {
-					propsStrWithoutInterfaceOnes += propsStrItem
-					assignStr += fmt.Sprintf("%s.%s = tempObj.%s\n", structNameCamel, propName, propName)
-				} else {
+				if checkIsInterface(prop.Type.Name, interfaces) {
 					hasInterfaceProps = true
 					assignInterfacePropsStr += fmt.Sprintf(`
-						field%s, _  := 	unmarshal%s(objMap["%s"])
+						field%s, err := unmarshal%s(objMap["%s"])
+						if err != nil {
+							return err
+						}
 						%s.%s = field%s
 						`,
 						propName, dataType, prop.Name,
 						structNameCamel, propName, propName)
+				} else if checkIsInterfaceVector(prop.Type, interfaces) {
+					hasInterfaceProps = true
+					itemType := dataType[len("[]"):]
+					found := false
+					for _, name := range vectorInterfaces {
+						found = found || name == itemType
+					}
+					if !found {
+						vectorInterfaces = append(vectorInterfaces, itemType)
+					}
+					assignInterfacePropsStr += fmt.Sprintf(`
+						field%s, err := unmarshalListOf%s(objMap["%s"])
+						if err != nil {
+							return err
+						}
+						%s.%s = field%s
+						`,
+						propName, itemType, prop.Name,
+						structNameCamel, propName, propName)
+				} else {
+					propsStrWithoutInterfaceOnes += propsStrItem
+					assignStr += fmt.Sprintf("%s.%s = tempObj.%s\n", structNameCamel, propName, propName)
 				}
 			}
 			structsContent += fmt.Sprintf("// %s %s \ntype %s struct {\n"+
@@ -224,6 +272,8 @@ func generateStructsFromTnEntities(
 			structsContent += fmt.Sprintf("// MessageType return the string telegram-type of %s \nfunc (%s *%s) MessageType() string {\n return \"%s\" }\n\n",
 				structName, structNameCamel, structName, itemInfo.Name)
 
+			structsContent += generateValidate(itemInfo, structName, false, interfaces)
+
 			// empty parms thats uses for multiply lines
 			paramsStr := ""
 			paramsDesc := ""
@@ -232,7 +282,7 @@ func generateStructsFromTnEntities(
 			for i, param := range itemInfo.Properties {
 				propName := govalidator.UnderscoreToCamelCase(param.Name)
 				propName = propName
-				dataType, isPrimitive := convertDataType(param.Type, true)
+				dataType, isPrimitive := convertDataType(param.Type)
 				paramName := convertToArgumentName(param.Name)
 
 				if isPrimitive || checkIsInterface(dataType, interfaces) {
@@ -298,7 +348,7 @@ func generateStructsFromTnEntities(
 					assignStr, assignInterfacePropsStr)
 			}
 			if checkIsInterface(itemInfo.RootName, interfaces) {
-				rootName := itemInfo.RootName
+				rootName := getStructName(itemInfo.RootName)
 				structsContent += fmt.Sprintf(`
 					// Get%sEnum return the enum type of this object 
 					func (%s *%s) Get%sEnum() %sEnum {
@@ -313,34 +363,23 @@ func generateStructsFromTnEntities(
 			}
 
 		} else {
-			skip := false
-			for _, name := range SkipMethodNames {
-				if name == itemInfo.Name {
-					skip = true
-					break
-				}
-			}
-			if skip {
+			if overrides.HasMethod(itemInfo.Name) {
 				continue
 			}
 
 			methodName := convertToExternalMethodName(itemInfo.Name)
+			requestName := getStructName(itemInfo.Name) + "Request"
+			requestNameCamel := strings.ToLower(requestName[:1]) + requestName[1:]
 			returnType := getStructName(itemInfo.RootName)
-			returnTypeCamel := strings.ToLower(returnType[:1]) + returnType[1:]
-
-			returnIsInterface := checkIsInterface(returnType, interfaces)
 
-			asterike := "*"
-			ampersign := "&"
-			if returnIsInterface {
-				asterike = ""
-				ampersign = ""
+			// interfaces are returned as is, structs by pointer
+			respType := "*" + returnType
+			decodeStr := fmt.Sprintf("decodeResponse[%s](raw)", returnType)
+			if checkIsInterface(returnType, interfaces) {
+				respType = returnType
+				decodeStr = fmt.Sprintf("unmarshal%s((*json.RawMessage)(&raw))", returnType)
 			}
 
-			paramsStr := ""
-			clientCallStructAttrs := ""
-			paramsDesc := ""
-
 			// sort params to enshure the same params order in each generation
 			sort.Slice(itemInfo.Properties, func(i, j int) bool {
 				if (itemInfo.Properties[i].Name > itemInfo.Properties[j].Name){
@@ -349,124 +388,171 @@ func generateStructsFromTnEntities(
 				return true
 			})
 
+			paramsStr := ""
+			paramsDesc := ""
+			fieldsStr := ""
+			assignsStr := ""
 			for i, param := range itemInfo.Properties {
 				paramName := convertToArgumentName(param.Name)
-				dataType, isPrimitive := convertDataType(param.Type, false)
-				if isPrimitive || checkIsInterface(dataType, interfaces) {
-					paramsStr += paramName + " " + dataType
-					clientCallStructAttrs += fmt.Sprintf("%s %s `json:\"%s\"`\n", convertToExternalArgumentName(param.Name), dataType, param.Name)
-
-				} else {
-					paramsStr += paramName + " " + dataType
-					clientCallStructAttrs += fmt.Sprintf("%s %s `json:\"%s\"`\n", convertToExternalArgumentName(param.Name), dataType, param.Name)
-				}
+				fieldName := convertToExternalArgumentName(param.Name)
+				dataType, _ := convertDataType(param.Type)
 
+				paramsStr += paramName + " " + dataType
 				if i < len(itemInfo.Properties)-1 {
 					paramsStr += ", "
 				}
 				paramsDesc += "\n// @param " + paramName + " " + param.Description
+				fieldsStr += fmt.Sprintf("%s %s `json:\"%s\"`", fieldName, dataType, param.Name)
+				if param.Description != "" {
+					fieldsStr += " // " + param.Description
+				}
+				fieldsStr += "\n"
+				assignsStr += fmt.Sprintf("%s: %s,\n", fieldName, paramName)
 			}
 
+			// request objects can be queued, logged and serialised, Execute sends them
 			methodsContent += fmt.Sprintf(`
-				// %s %s %s
-				func (client *Client) %s(%s) (%s%s, error)`, methodName, itemInfo.Description, paramsDesc, methodName,
-				paramsStr, asterike, returnType)
+				// %s
+				type %s struct {
+					%s}
 
-			paramsStr = ""
-			for i, param := range itemInfo.Properties {
-				paramName := convertToArgumentName(param.Name)
+				// Type returns the tl name of the function
+				func (%s *%s) Type() string {
+					return "%s"
+				}
 
-				paramsStr += fmt.Sprintf(`%s:   %s,`, convertToExternalArgumentName(param.Name), paramName)
-				if i < len(itemInfo.Properties)-1 {
-					paramsStr += "\n"
+				// MarshalJSON marshals the request with its @type
+				func (%s *%s) MarshalJSON() ([]byte, error) {
+					type fields %s
+					return marshalRequest(%s.Type(), (*fields)(%s))
 				}
-			}
 
-			illStr := `fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])`
-			if strings.Contains(paramsStr, returnTypeCamel) {
-				returnTypeCamel = returnTypeCamel + "Dummy"
-			}
-			if returnIsInterface {
-				enumType := returnType + "Enum"
-				casesStr := ""
-
-				for _, enum := range *enums {
-					if enum.EnumType == enumType {
-						for _, item := range enum.Items {
-							casesStr += fmt.Sprintf(`
-								case %s:
-									var %s %s
-									err = json.Unmarshal(result.Raw, &%s)
-									return &%s, err
-									`, item+"Type", returnTypeCamel, item, returnTypeCamel,
-								returnTypeCamel)
-						}
-						break
-					}
+				// DecodeResponse decodes %s returned by tonlib
+				func (%s *%s) DecodeResponse(raw []byte) (%s, error) {
+					return %s
 				}
+				`, strings.TrimSpace(fmt.Sprintf("%s is %s function %s", requestName, itemInfo.Name, itemInfo.Description)),
+				requestName, fieldsStr,
+				requestNameCamel, requestName, itemInfo.Name,
+				requestNameCamel, requestName, requestName, requestNameCamel, requestNameCamel,
+				returnType, requestNameCamel, requestName, respType, decodeStr)
 
-				methodsContent += fmt.Sprintf(` {
-					result, err := client.executeAsynchronously(
-						struct {
-							Type string `+"`json:\"@type\"`"+`
-							%s	
-						}{
-							Type: "%s",
-							%s
-						},
-					)
-	
-					if err != nil {
-						return nil, err
-					}
-	
-					if result.Data["@type"].(string) == "error" {
-						return nil, %s
-					}
+			methodsContent += generateValidate(itemInfo, requestName, true, interfaces)
 
-					switch %s(result.Data["@type"].(string)) {
+			methodsContent += fmt.Sprintf(`
+				// %s %s %s
+				func (client *Client) %s(%s) (%s, error) {
+					return Execute[%s](context.Background(), client, &%s{
 						%s
-					default:
-						return nil, fmt.Errorf("Invalid type")
-					}
+					})
+				}
+				`, methodName, itemInfo.Description, paramsDesc, methodName, paramsStr, respType,
+				respType, requestName, assignsStr)
+		}
+	}
+
+	for _, interfaceName := range vectorInterfaces {
+		structUnmarshals += fmt.Sprintf(`
+				func unmarshalListOf%s(rawMsg *json.RawMessage) ([]%s, error){
+
+					if rawMsg == nil {
+						return nil, nil
 					}
-					
-					`, clientCallStructAttrs, itemInfo.Name, paramsStr, illStr,
-					enumType, casesStr)
-
-			} else {
-				methodsContent += fmt.Sprintf(` {
-					result, err := client.executeAsynchronously(
-						struct {
-							Type string `+"`json:\"@type\"`"+`
-							%s	
-						}{
-							Type: "%s",
-							%s
-						},
-					)
-	
+					var rawItems []*json.RawMessage
+					err := json.Unmarshal(*rawMsg, &rawItems)
 					if err != nil {
 						return nil, err
 					}
-	
-					if result.Data["@type"].(string) == "error" {
-						return nil, %s
-					}
-	
-					var %s %s
-					err = json.Unmarshal(result.Raw, &%s)
-					return %s%s, err
-	
+					var items []%s
+					for _, rawItem := range rawItems {
+						item, err := unmarshal%s(rawItem)
+						if err != nil {
+							return nil, err
+						}
+						items = append(items, item)
 					}
-					
-					`, clientCallStructAttrs, itemInfo.Name, paramsStr, illStr, returnTypeCamel,
-					returnType, returnTypeCamel, ampersign, returnTypeCamel)
-			}
-
-		}
+					return items, nil
+				}
+				`, interfaceName, interfaceName, interfaceName, interfaceName)
 	}
 
 	structsContent += "\n\n" + structUnmarshals
 	return &structsContent, &methodsContent
 }
+
+// generateValidate generates Validate method of the struct, which checks fields before the struct is sent to tonlib:
+// pointers are set, addresses are valid and amounts are not negative.
+// Nested objects are checked with validate and addresses with validateAddress of the generated package.
+// Fields of requests hold objects by value, so they are never missing
+func generateValidate(itemInfo ClassInfo, structName string, isRequest bool, interfaces *[]InterfaceInfo) string {
+	structNameCamel := strings.ToLower(structName[0:1]) + structName[1:]
+	if structNameCamel == "error" {
+		// the receiver must not shadow the result type
+		structNameCamel = "tonError"
+	}
+
+	checks := ""
+	for _, prop := range itemInfo.Properties {
+		field := structNameCamel + "." + govalidator.UnderscoreToCamelCase(prop.Name)
+		if isRequest {
+			field = structNameCamel + "." + convertToExternalArgumentName(prop.Name)
+		}
+		dataType, isPrimitive := convertDataType(prop.Type)
+		switch {
+		case prop.Type.Name == "int64" && prop.Name == "amount":
+			checks += fmt.Sprintf(`if %s < 0 {
+					return fmt.Errorf("%s: %s is negative")
+				}
+				`, field, itemInfo.Name, prop.Name)
+		case prop.Type.Name == "string" && prop.Name == "account_address":
+			checks += fmt.Sprintf(`if err := validateAddress(%s); err != nil {
+					return fmt.Errorf("%s: %s is not valid: %%v", err)
+				}
+				`, field, itemInfo.Name, prop.Name)
+		case checkIsInterface(dataType, interfaces):
+			checks += fmt.Sprintf(`if err := validate(%s); err != nil {
+					return fmt.Errorf("%s: %s: %%w", err)
+				}
+				`, field, itemInfo.Name, prop.Name)
+		case !isPrimitive && isRequest:
+			checks += fmt.Sprintf(`if err := validate(&%s); err != nil {
+					return fmt.Errorf("%s: %s: %%w", err)
+				}
+				`, field, itemInfo.Name, prop.Name)
+		case !isPrimitive:
+			checks += fmt.Sprintf(`if %s == nil {
+					return fmt.Errorf("%s: %s is required")
+				}
+				if err := validate(%s); err != nil {
+					return fmt.Errorf("%s: %s: %%w", err)
+				}
+				`, field, itemInfo.Name, prop.Name, field, itemInfo.Name, prop.Name)
+		case prop.Type.IsVector():
+			itemType, isItemPrimitive := convertDataType(prop.Type.Elem())
+			item := field + "[i]"
+			if !checkIsInterface(itemType, interfaces) {
+				if isItemPrimitive {
+					continue
+				}
+				item = "&" + item
+			}
+			checks += fmt.Sprintf(`for i := range %s {
+					if err := validate(%s); err != nil {
+						return fmt.Errorf("%s: %s[%%d]: %%w", i, err)
+					}
+				}
+				`, field, item, itemInfo.Name, prop.Name)
+		}
+	}
+
+	return fmt.Sprintf(`
+		// Validate checks %s before it is sent to tonlib
+		func (%s *%s) Validate() error {
+			if %s == nil {
+				return nil
+			}
+			%sreturn nil
+		}
+
+		`, structName, structNameCamel, structName, structNameCamel, checks)
+}
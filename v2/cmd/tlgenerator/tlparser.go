@@ -1,10 +1,9 @@
 package main
 
 import (
-	"bufio"
-	"fmt"
 	"io"
-	"strings"
+
+	"github.com/mercuryoio/tonlib-go/v2/cmd/tlgenerator/tl"
 )
 
 // ClassInfo holds info of a Class in .tl file
@@ -18,9 +17,9 @@ type ClassInfo struct {
 
 // ClassProperty holds info about properties of a class (or function)
 type ClassProperty struct {
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	Description string `json:"description"`
+	Name        string   `json:"name"`
+	Type        *tl.Type `json:"type"`
+	Description string   `json:"description"`
 }
 
 // InterfaceInfo equals to abstract base classes in .tl file
@@ -31,149 +30,89 @@ type InterfaceInfo struct {
 
 // EnumInfo ...
 type EnumInfo struct {
-	EnumType string   `json:"enumType"`
-	Items    []string `json:"description"`
+	EnumType string `json:"enumType"`
+	// Items are tl names of the class constructors
+	Items []string `json:"description"`
 }
 
-func parseTlFile(tlReader *bufio.Reader) (error, *[]ClassInfo, *[]InterfaceInfo, *[]EnumInfo) {
-	isFunctions := false
-	var entityDesc string
-	var paramDescs map[string]string
-	var params map[string]string
+// parseTlFile parses the schema, abstractClasses makes interfaces of classes without //@class line too
+func parseTlFile(tlReader io.Reader, overrides *Overrides, abstractClasses bool) (error, *[]ClassInfo, *[]InterfaceInfo, *[]EnumInfo) {
+	schema, err := tl.Parse(tlReader)
+	if err != nil {
+		return err, nil, nil, nil
+	}
+
 	var interfaces []InterfaceInfo
 	var enums []EnumInfo
-	var entities []ClassInfo
-
-	for {
-		line, err := tlReader.ReadString('\n')
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
+	for _, class := range schema.Classes {
+		interfaces = append(interfaces, InterfaceInfo{Name: class.Name, Description: class.Description})
+		enums = append(enums, EnumInfo{EnumType: getStructName(class.Name) + "Enum"})
+	}
 
-			return fmt.Errorf("read file line error: %v", err), nil, nil, nil
+	var entities []ClassInfo
+	for _, combinator := range append(schema.Constructors, schema.Functions...) {
+		classProps := make([]ClassProperty, 0, len(combinator.Params))
+		for _, param := range combinator.Params {
+			classProps = append(classProps, ClassProperty{
+				Name:        param.Name,
+				Type:        param.Type,
+				Description: param.Description,
+			})
 		}
-		if strings.Contains(line, "---functions---") {
-			isFunctions = true
-			continue
+		itemInfo := ClassInfo{
+			Name:        combinator.Name,
+			Description: combinator.Description,
+			RootName:    combinator.Result.Name,
+			Properties:  classProps,
+			IsFunction:  combinator.IsFunction,
 		}
-
-		if strings.HasPrefix(line, "//@class ") {
-			line = line[len("//@class "):]
-			interfaceName := line[:strings.Index(line, " ")]
-			line = line[len(interfaceName):]
-			line = line[len(" @description "):]
-			entityDesc = line[:len(line)-1]
-			interfaceInfo := InterfaceInfo{
-				Name:        interfaceName,
-				Description: entityDesc,
-			}
-			interfaces = append(interfaces, interfaceInfo)
-			enums = append(enums, EnumInfo{EnumType: interfaceName + "Enum"})
-
-		} else if strings.HasPrefix(line, "//@description ") { // Entity description
-			line = line[len("//@description "):]
-			indexOfFirstSign := strings.Index(line, "@")
-
-			entityDesc = line[:len(line)-1]
-			if indexOfFirstSign != -1 {
-				entityDesc = line[:indexOfFirstSign]
-			}
-
-			if indexOfFirstSign != -1 { // there is some parameter description inline, parse them
-				line = line[indexOfFirstSign+1:]
-				rd2 := bufio.NewReader(strings.NewReader(line))
-				for {
-					paramName, _ := rd2.ReadString(' ')
-					if paramName == "" {
-						break
-					}
-					paramName = paramName[:len(paramName)-1]
-					paramDesc, _ := rd2.ReadString('@')
-					if paramDesc == "" {
-						paramDesc, _ = rd2.ReadString('\n')
-
-						paramDescs[paramName] = paramDesc[:len(paramDesc)-1]
-						break
-					}
-
-					paramDescs[paramName] = paramDesc[:len(paramDesc)-1]
-				}
-			}
-		} else if entityDesc != "" && strings.HasPrefix(line, "//@") {
-			line = line[len("//@"):]
-			rd2 := bufio.NewReader(strings.NewReader(line))
-			for {
-				paramName, _ := rd2.ReadString(' ')
-				if paramName == "" {
-					break
-				}
-				paramName = paramName[:len(paramName)-1]
-				paramDesc, _ := rd2.ReadString('@')
-				if paramDesc == "" {
-					paramDesc, _ = rd2.ReadString('\n')
-
-					paramDescs[paramName] = paramDesc[:len(paramDesc)-1]
-					break
-				}
-
-				paramDescs[paramName] = paramDesc[:len(paramDesc)-1]
-			}
-
-		} else if !strings.HasPrefix(line, "//") && len(line) > 2 {
-			entityName := line[:strings.Index(line, " ")]
-
-			line = line[len(entityName)+1:]
-			for {
-				if strings.Index(line, ":") == -1 {
+		entities = append(entities, itemInfo)
+
+		// update enum`s items list
+		if !itemInfo.IsFunction {
+			for i, enumInfo := range enums {
+				if enumInfo.EnumType == getStructName(itemInfo.RootName)+"Enum" {
+					enumInfo.Items = append(enumInfo.Items, itemInfo.Name)
+					enums[i] = enumInfo
 					break
 				}
-				paramName := line[:strings.Index(line, ":")]
-				line = line[len(paramName)+1:]
-				paramType := line[:strings.Index(line, " ")]
-				params[paramName] = paramType
-				line = line[len(paramType)+1:]
-			}
-
-			rootName := line[len("= ") : len(line)-2]
-
-			var classProps []ClassProperty
-			classProps = make([]ClassProperty, 0, 0)
-
-			for paramName, paramType := range params {
-				classProp := ClassProperty{
-					Name:        paramName,
-					Type:        paramType,
-					Description: paramDescs[paramName],
-				}
-				classProps = append(classProps, classProp)
-			}
-
-			itemInfo := ClassInfo{
-				Name:        entityName,
-				Description: entityDesc,
-				RootName:    rootName,
-				Properties:  classProps,
-				IsFunction:  isFunctions,
 			}
+		}
+	}
+	if abstractClasses {
+		interfaces, enums = addAbstractClasses(schema.Constructors, interfaces, enums, overrides)
+	}
+	return nil, &entities, &interfaces, &enums
+}
 
-			entities = append(entities, itemInfo)
-			entityDesc = ""
-			paramDescs = make(map[string]string)
-			params = make(map[string]string)
+// addAbstractClasses adds interfaces for abstract classes which have no //@class line:
+// classes with several constructors or with a constructor named differently, e.g.
+// msg.dataRaw = msg.Data
+func addAbstractClasses(combinators []*tl.Combinator, interfaces []InterfaceInfo, enums []EnumInfo, overrides *Overrides) ([]InterfaceInfo, []EnumInfo) {
+	var rootNames []string
+	constructors := make(map[string][]string)
+	for _, combinator := range combinators {
+		// generic builtins like vector {t:Type}
+		if len(combinator.TypeParams) > 0 {
+			continue
+		}
+		rootName := combinator.Result.Name
+		if _, ok := constructors[rootName]; !ok {
+			rootNames = append(rootNames, rootName)
+		}
+		constructors[rootName] = append(constructors[rootName], combinator.Name)
+	}
 
-			// update enum`s items list
-			if !itemInfo.IsFunction {
-				for i, enumInfo := range enums {
-					if enumInfo.EnumType == itemInfo.RootName+"Enum" {
-						enumInfo.Items = append(enumInfo.Items,
-							strings.ToUpper(itemInfo.Name[0:1])+itemInfo.Name[1:])
-						enums[i] = enumInfo
-						break
-					}
-				}
-			}
+	for _, rootName := range rootNames {
+		items := constructors[rootName]
+		if checkIsInterface(rootName, &interfaces) || isExcludedAbstractClass(rootName, overrides) {
+			continue
 		}
+		if len(items) == 1 && items[0] == getConstructorName(rootName) {
+			continue
+		}
+		interfaces = append(interfaces, InterfaceInfo{Name: rootName})
+		enums = append(enums, EnumInfo{EnumType: getStructName(rootName) + "Enum", Items: items})
 	}
-	return nil, &entities, &interfaces, &enums
+	return interfaces, enums
 }
@@ -1,14 +1,19 @@
 package main
 
 import (
-	"fmt"
-	"github.com/asaskevich/govalidator"
 	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/mercuryoio/tonlib-go/v2/cmd/tlgenerator/tl"
 )
 
+// checkIsInterface reports whether the tl or go type name is an abstract class
 func checkIsInterface(input string, interfaces *[]InterfaceInfo) bool {
+	if input == "" {
+		return false
+	}
 	for _, interfaceInfo := range *interfaces {
-		if interfaceInfo.Name == input {
+		if getStructName(interfaceInfo.Name) == getStructName(input) {
 			return true
 		}
 	}
@@ -16,6 +21,18 @@ func checkIsInterface(input string, interfaces *[]InterfaceInfo) bool {
 	return false
 }
 
+// checkIsInterfaceVector reports whether the tl type is a vector of an abstract class
+func checkIsInterfaceVector(input *tl.Type, interfaces *[]InterfaceInfo) bool {
+	return input.IsVector() && checkIsInterface(input.Elem().Name, interfaces)
+}
+
+// getConstructorName returns name of the only constructor of a non-abstract class,
+// e.g. raw.message for raw.Message
+func getConstructorName(className string) string {
+	index := strings.LastIndex(className, ".") + 1
+	return className[:index] + strings.ToLower(className[index:index+1]) + className[index+1:]
+}
+
 func convertFromDots(paramName string) string {
 	splited := strings.Split(paramName, ".")
 	if len(splited) < 2 {
@@ -76,58 +93,33 @@ func getStructName(input string) string {
 	return paramName
 }
 
-func convertDataType(input string, changeBytesToString bool) (string, bool) {
-	propType := ""
-	isPrimitiveType := true
-	// cleanup from "="
-	input = strings.Trim(input, "=")
-
-	if strings.HasPrefix(input, "vector") {
-		input = "[]" + input[len("vector<"):len(input)-1]
-		isPrimitiveType = true
-	}
-	if strings.HasPrefix(input, "[]vector") {
-		input = "[][]" + input[len("[]vector<"):len(input)-1]
-
-	}
-	if strings.Contains(input, "string") || strings.Contains(input, "int32") ||
-		strings.Contains(input, "int64") {
-		propType = strings.Replace(input, "int64", "JSONInt64", 1)
-
-	} else if strings.Contains(input, "Bool") {
-		propType = strings.Replace(input, "Bool", "bool", 1)
-
-	} else if strings.Contains(input, "double") {
-		propType = strings.Replace(input, "double", "float64", 1)
-
-	} else if strings.Contains(input, "int53") {
-		propType = strings.Replace(input, "int53", "int64", 1)
-
-	} else if strings.Contains(input, "bytes") {
-		if changeBytesToString{
-			propType = strings.Replace(input, "bytes", "string", 1)
-		} else {
-			propType = strings.Replace(input, "bytes", "[]byte", 1)
-		}
-
-	} else {
-		if strings.HasPrefix(input, "[][]") {
-			propType = "[][]" + getStructName(input[len("[][]"):])
-		} else if strings.HasPrefix(input, "[]") {
-			propType = "[]" + getStructName(input[len("[]"):])
-		} else {
-			propType = getStructName(input)
-			isPrimitiveType = false
-		}
+// convertDataType returns go type of the tl type and whether it is used by value.
+// Byte types are the same in structs and method params: bytes is Bytes, int256 is Int256,
+// both are base64 in json, secure types are SecureBytes and SecureString
+func convertDataType(input *tl.Type) (string, bool) {
+	if input.IsVector() {
+		itemType, _ := convertDataType(input.Elem())
+		return "[]" + itemType, true
 	}
-
-	return propType, isPrimitiveType
-}
-
-func ChangeType(paramName string, fromType string, toType string) string {
-	if fromType == "SecureBytes" && toType == "string" {
-		return fmt.Sprintf("base64.StdEncoding.EncodeToString(%s)", paramName)
+	switch input.Name {
+	case "string", "int32":
+		return input.Name, true
+	case "int64":
+		return "JSONInt64", true
+	case "int53":
+		return "int64", true
+	case "double":
+		return "float64", true
+	case "Bool":
+		return "bool", true
+	case "bytes":
+		return "Bytes", true
+	case "int256":
+		return "Int256", true
+	case "secureBytes":
+		return "SecureBytes", true
+	case "secureString":
+		return "SecureString", true
 	}
-	return paramName
-
+	return getStructName(input.Name), false
 }
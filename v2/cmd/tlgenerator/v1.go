@@ -6,38 +6,35 @@ import (
 	"strings"
 
 	"github.com/asaskevich/govalidator"
+	"github.com/mercuryoio/tonlib-go/v2/cmd/tlgenerator/tl"
 )
 
-var StructNamesExcludedFromGenerator = []string{
-	"secureBytes", "secureString", "bytes", "vector", "key",
-}
-
-var SkipMethodNames = []string{
-	"sync", "query.estimateFees",
-}
-
-func generateStructsFromTnEntities(
-	packageName string, entities *[]ClassInfo, interfaces *[]InterfaceInfo, enums *[]EnumInfo) (*string, *string) {
-	structsContent := fmt.Sprintf("package %s\n\n", packageName)
+// generateV1 generates structs.go and methods.go of the v1 package of the root module.
+// The generated api of v1 is kept as it is: methods call tonlib directly instead of request types,
+// there is no Validate, bytes are strings in structs and only //@class classes are interfaces
+func generateV1(entities *[]ClassInfo, interfaces *[]InterfaceInfo, enums *[]EnumInfo, overrides *Overrides) (*string, *string) {
+	structsContent := "package tonlib\n\n"
 	structUnmarshals := ""
 	structsContent += `
-	
+
 	import (
 		"encoding/json"
 		"fmt"
-		"strconv"
-		"strings"
+
+		"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
 	)
-	
+
 	`
-	methodsContent := fmt.Sprintf("package %s\n\n", packageName)
+	methodsContent := "package tonlib\n\n"
 	methodsContent += `
-	
+
 	import (
 		"encoding/json"
 		"fmt"
+
+		"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
 	)
-	
+
 	`
 
 	structsContent += "type tonCommon struct {\n" +
@@ -50,32 +47,14 @@ func generateStructsFromTnEntities(
 	type SecureString  string
 	type Bytes         []byte
 	type TvmStackEntry interface {}
-	type SmcMethodId   int32 
+	type SmcMethodId   int32
 	type TvmNumber     string
 	type GenericAccountState string
 	`
 
 	structsContent += `
 	// JSONInt64 alias for int64, in order to deal with json big number problem
-	type JSONInt64 int64
-	
-	// MarshalJSON marshals to json
-	func (jsonInt *JSONInt64) MarshalJSON() ([]byte, error) {
-		intStr := strconv.FormatInt(int64(*jsonInt), 10)
-		return []byte(intStr), nil
-	}
-	
-	// UnmarshalJSON unmarshals from json
-	func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
-		intStr := string(b)
-		intStr = strings.Replace(intStr, "\"", "", 2)
-		jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
-		if err != nil {
-			return err
-		}
-		*jsonInt = JSONInt64(jsonBigInt)
-		return nil
-	}
+	type JSONInt64 = tonlibjson.JSONInt64
 `
 
 	structsContent += `
@@ -86,7 +65,6 @@ func generateStructsFromTnEntities(
 `
 
 	for _, enum := range *enums {
-
 		structsContent += fmt.Sprintf(`
 				// %s Alias for abstract %s 'Sub-Classes', used as constant-enum here
 				type %s string
@@ -97,9 +75,7 @@ func generateStructsFromTnEntities(
 
 		consts := ""
 		for _, item := range enum.Items {
-			consts += item + "Type " + enum.EnumType + " = \"" +
-				strings.ToLower(item[:1]) + item[1:] + "\"\n"
-
+			consts += getStructName(item) + "Type " + enum.EnumType + " = \"" + item + "\"\n"
 		}
 		structsContent += fmt.Sprintf(`
 				// %s enums
@@ -109,24 +85,24 @@ func generateStructsFromTnEntities(
 	}
 
 	for _, interfaceInfo := range *interfaces {
-		interfaceInfo.Name = interfaceInfo.Name
+		interfaceName := getStructName(interfaceInfo.Name)
 		typesCases := ""
 
 		structsContent += fmt.Sprintf("// %s %s \ntype %s interface {\nGet%sEnum() %sEnum\n}\n\n",
-			interfaceInfo.Name, interfaceInfo.Description, interfaceInfo.Name, interfaceInfo.Name, interfaceInfo.Name)
+			interfaceName, interfaceInfo.Description, interfaceName, interfaceName, interfaceName)
 
 		for _, enum := range *enums {
-			if enum.EnumType == interfaceInfo.Name+"Enum" {
+			if enum.EnumType == interfaceName+"Enum" {
 				for _, enumItem := range enum.Items {
-					typeName := enumItem
+					typeName := getStructName(enumItem)
 					typeNameCamel := strings.ToLower(typeName[:1]) + typeName[1:]
 					typesCases += fmt.Sprintf(`case %s:
 						var %s %s
 						err := json.Unmarshal(*rawMsg, &%s)
 						return &%s, err
-						
+
 						`,
-						enumItem+"Type", typeNameCamel, typeName,
+						typeName+"Type", typeNameCamel, typeName,
 						typeNameCamel, typeNameCamel)
 				}
 				break
@@ -151,14 +127,14 @@ func generateStructsFromTnEntities(
 						return nil, fmt.Errorf("Error unmarshaling, unknown type:" +  objMap["@type"].(string))
 					}
 				}
-				`, interfaceInfo.Name, interfaceInfo.Name, interfaceInfo.Name,
+				`, interfaceName, interfaceName, interfaceName,
 			typesCases)
 	}
 
 	// gen entity`s structs
 	for _, itemInfo := range *entities {
 		// skip generation
-		skip := false
+		skip := overrides.HasType(itemInfo.Name)
 		for _, name := range StructNamesExcludedFromGenerator {
 			if itemInfo.Name == name {
 				skip = true
@@ -169,6 +145,11 @@ func generateStructsFromTnEntities(
 			continue
 		}
 
+		// sort params to enshure the same params order in each generation
+		sort.Slice(itemInfo.Properties, func(i, j int) bool {
+			return itemInfo.Properties[i].Name < itemInfo.Properties[j].Name
+		})
+
 		if !itemInfo.IsFunction {
 			structName := getStructName(itemInfo.Name)
 			structNameCamel := strings.ToLower(structName[0:1]) + structName[1:]
@@ -179,19 +160,10 @@ func generateStructsFromTnEntities(
 			assignStr := fmt.Sprintf("%s.tonCommon = tempObj.tonCommon\n", structNameCamel)
 			assignInterfacePropsStr := ""
 
-			// sort params to enshure the same params order in each generation
-			sort.Slice(itemInfo.Properties, func(i, j int) bool {
-				if (itemInfo.Properties[i].Name > itemInfo.Properties[j].Name){
-					return false
-				}
-				return true
-			})
-
 			for i, prop := range itemInfo.Properties {
 				propName := govalidator.UnderscoreToCamelCase(prop.Name)
-				propName = propName
 
-				dataType, isPrimitive := convertDataType(prop.Type, true)
+				dataType, isPrimitive := convertV1DataType(prop.Type, true)
 				propsStrItem := ""
 				if isPrimitive || checkIsInterface(dataType, interfaces) {
 					propsStrItem += fmt.Sprintf("%s %s `json:\"%s\"` // %s", propName, dataType, prop.Name, prop.Description)
@@ -203,7 +175,7 @@ func generateStructsFromTnEntities(
 				}
 
 				propsStr += propsStrItem
-				if !checkIsInterface(prop.Type, interfaces) {
+				if !checkIsInterface(prop.Type.Name, interfaces) {
 					propsStrWithoutInterfaceOnes += propsStrItem
 					assignStr += fmt.Sprintf("%s.%s = tempObj.%s\n", structNameCamel, propName, propName)
 				} else {
@@ -231,13 +203,11 @@ func generateStructsFromTnEntities(
 
 			for i, param := range itemInfo.Properties {
 				propName := govalidator.UnderscoreToCamelCase(param.Name)
-				propName = propName
-				dataType, isPrimitive := convertDataType(param.Type, true)
+				dataType, isPrimitive := convertV1DataType(param.Type, true)
 				paramName := convertToArgumentName(param.Name)
 
 				if isPrimitive || checkIsInterface(dataType, interfaces) {
 					paramsStr += paramName + " " + dataType
-
 				} else { // if is not a primitive, use pointers
 					paramsStr += paramName + " *" + dataType
 				}
@@ -246,12 +216,7 @@ func generateStructsFromTnEntities(
 					paramsStr += ", "
 				}
 				paramsDesc += "\n// @param " + paramName + " " + param.Description
-
-				if isPrimitive || checkIsInterface(dataType, interfaces) {
-					assingsStr += fmt.Sprintf("%s : %s,\n", propName, paramName)
-				} else {
-					assingsStr += fmt.Sprintf("%s : %s,\n", propName, paramName)
-				}
+				assingsStr += fmt.Sprintf("%s : %s,\n", propName, paramName)
 			}
 
 			// Create New... constructors
@@ -290,19 +255,19 @@ func generateStructsFromTnEntities(
 
 						%s
 
-						%s	
-						
+						%s
+
 						return nil
 					}
 					`, structNameCamel, structName, propsStrWithoutInterfaceOnes,
 					assignStr, assignInterfacePropsStr)
 			}
 			if checkIsInterface(itemInfo.RootName, interfaces) {
-				rootName := itemInfo.RootName
+				rootName := getStructName(itemInfo.RootName)
 				structsContent += fmt.Sprintf(`
-					// Get%sEnum return the enum type of this object 
+					// Get%sEnum return the enum type of this object
 					func (%s *%s) Get%sEnum() %sEnum {
-						 return %s 
+						 return %s
 					}
 
 					`,
@@ -313,160 +278,146 @@ func generateStructsFromTnEntities(
 			}
 
 		} else {
-			skip := false
-			for _, name := range SkipMethodNames {
-				if name == itemInfo.Name {
-					skip = true
-					break
-				}
-			}
-			if skip {
+			if overrides.HasMethod(itemInfo.Name) {
 				continue
 			}
+			methodsContent += generateV1Method(itemInfo, interfaces, enums)
+		}
+	}
 
-			methodName := convertToExternalMethodName(itemInfo.Name)
-			returnType := getStructName(itemInfo.RootName)
-			returnTypeCamel := strings.ToLower(returnType[:1]) + returnType[1:]
+	structsContent += "\n\n" + structUnmarshals
+	return &structsContent, &methodsContent
+}
 
-			returnIsInterface := checkIsInterface(returnType, interfaces)
+// generateV1Method generates a Client method sending the function to tonlib and decoding its result
+func generateV1Method(itemInfo ClassInfo, interfaces *[]InterfaceInfo, enums *[]EnumInfo) string {
+	methodName := convertToExternalMethodName(itemInfo.Name)
+	returnType := getStructName(itemInfo.RootName)
+	returnTypeCamel := strings.ToLower(returnType[:1]) + returnType[1:]
 
-			asterike := "*"
-			ampersign := "&"
-			if returnIsInterface {
-				asterike = ""
-				ampersign = ""
-			}
+	returnIsInterface := checkIsInterface(returnType, interfaces)
 
-			paramsStr := ""
-			clientCallStructAttrs := ""
-			paramsDesc := ""
-
-			// sort params to enshure the same params order in each generation
-			sort.Slice(itemInfo.Properties, func(i, j int) bool {
-				if (itemInfo.Properties[i].Name > itemInfo.Properties[j].Name){
-					return false
-				}
-				return true
-			})
+	asterike := "*"
+	ampersign := "&"
+	if returnIsInterface {
+		asterike = ""
+		ampersign = ""
+	}
 
-			for i, param := range itemInfo.Properties {
-				paramName := convertToArgumentName(param.Name)
-				dataType, isPrimitive := convertDataType(param.Type, false)
-				if isPrimitive || checkIsInterface(dataType, interfaces) {
-					paramsStr += paramName + " " + dataType
-					clientCallStructAttrs += fmt.Sprintf("%s %s `json:\"%s\"`\n", convertToExternalArgumentName(param.Name), dataType, param.Name)
+	paramsStr := ""
+	clientCallStructAttrs := ""
+	paramsDesc := ""
+	for i, param := range itemInfo.Properties {
+		paramName := convertToArgumentName(param.Name)
+		dataType, isPrimitive := convertV1DataType(param.Type, false)
+		if isPrimitive || checkIsInterface(dataType, interfaces) {
+			paramsStr += paramName + " " + dataType
+			clientCallStructAttrs += fmt.Sprintf("%s %s `json:\"%s\"`\n", convertToExternalArgumentName(param.Name), dataType, param.Name)
+		} else {
+			paramsStr += paramName + " *" + dataType
+			clientCallStructAttrs += fmt.Sprintf("%s *%s `json:\"%s\"`\n", convertToExternalArgumentName(param.Name), dataType, param.Name)
+		}
 
-				} else {
-					paramsStr += paramName + " *" + dataType
-					clientCallStructAttrs += fmt.Sprintf("%s *%s `json:\"%s\"`\n", convertToExternalArgumentName(param.Name), dataType, param.Name)
-				}
+		if i < len(itemInfo.Properties)-1 {
+			paramsStr += ", "
+		}
+		paramsDesc += "\n// @param " + paramName + " " + param.Description
+	}
 
-				if i < len(itemInfo.Properties)-1 {
-					paramsStr += ", "
-				}
-				paramsDesc += "\n// @param " + paramName + " " + param.Description
-			}
+	methodContent := fmt.Sprintf(`
+		// %s %s %s
+		func (client *Client) %s(%s) (%s%s, error)`, methodName, itemInfo.Description, paramsDesc, methodName,
+		paramsStr, asterike, returnType)
 
-			methodsContent += fmt.Sprintf(`
-				// %s %s %s
-				func (client *Client) %s(%s) (%s%s, error)`, methodName, itemInfo.Description, paramsDesc, methodName,
-				paramsStr, asterike, returnType)
+	paramsStr = ""
+	for i, param := range itemInfo.Properties {
+		paramName := convertToArgumentName(param.Name)
 
-			paramsStr = ""
-			for i, param := range itemInfo.Properties {
-				paramName := convertToArgumentName(param.Name)
+		paramsStr += fmt.Sprintf(`%s:   %s,`, convertToExternalArgumentName(param.Name), paramName)
+		if i < len(itemInfo.Properties)-1 {
+			paramsStr += "\n"
+		}
+	}
 
-				paramsStr += fmt.Sprintf(`%s:   %s,`, convertToExternalArgumentName(param.Name), paramName)
-				if i < len(itemInfo.Properties)-1 {
-					paramsStr += "\n"
+	if strings.Contains(paramsStr, returnTypeCamel) {
+		returnTypeCamel = returnTypeCamel + "Dummy"
+	}
+	decodeStr := fmt.Sprintf(`var %s %s
+		err = json.Unmarshal(result.Raw, &%s)
+		return %s%s, err
+		`, returnTypeCamel, returnType, returnTypeCamel, ampersign, returnTypeCamel)
+	if returnIsInterface {
+		enumType := returnType + "Enum"
+		casesStr := ""
+		for _, enum := range *enums {
+			if enum.EnumType == enumType {
+				for _, item := range enum.Items {
+					casesStr += fmt.Sprintf(`
+						case %s:
+							var %s %s
+							err = json.Unmarshal(result.Raw, &%s)
+							return &%s, err
+							`, getStructName(item)+"Type", returnTypeCamel, getStructName(item), returnTypeCamel,
+						returnTypeCamel)
 				}
+				break
 			}
+		}
+		decodeStr = fmt.Sprintf(`switch %s(result.Data["@type"].(string)) {
+				%s
+			default:
+				return nil, fmt.Errorf("Invalid type")
+			}`, enumType, casesStr)
+	}
 
-			illStr := `fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])`
-			if strings.Contains(paramsStr, returnTypeCamel) {
-				returnTypeCamel = returnTypeCamel + "Dummy"
+	return methodContent + fmt.Sprintf(` {
+			result, err := client.executeAsynchronously(
+				struct {
+					Type string `+"`json:\"@type\"`"+`
+					%s
+				}{
+					Type: "%s",
+					%s
+				},
+			)
+
+			if err != nil {
+				return nil, err
 			}
-			if returnIsInterface {
-				enumType := returnType + "Enum"
-				casesStr := ""
-
-				for _, enum := range *enums {
-					if enum.EnumType == enumType {
-						for _, item := range enum.Items {
-							casesStr += fmt.Sprintf(`
-								case %s:
-									var %s %s
-									err = json.Unmarshal(result.Raw, &%s)
-									return &%s, err
-									`, item+"Type", returnTypeCamel, item, returnTypeCamel,
-								returnTypeCamel)
-						}
-						break
-					}
-				}
 
-				methodsContent += fmt.Sprintf(` {
-					result, err := client.executeAsynchronously(
-						struct {
-							Type string `+"`json:\"@type\"`"+`
-							%s	
-						}{
-							Type: "%s",
-							%s
-						},
-					)
-	
-					if err != nil {
-						return nil, err
-					}
-	
-					if result.Data["@type"].(string) == "error" {
-						return nil, %s
-					}
+			if result.Data["@type"].(string) == "error" {
+				return nil, tonlibjson.ParseError(result.Raw)
+			}
 
-					switch %s(result.Data["@type"].(string)) {
-						%s
-					default:
-						return nil, fmt.Errorf("Invalid type")
-					}
-					}
-					
-					`, clientCallStructAttrs, itemInfo.Name, paramsStr, illStr,
-					enumType, casesStr)
-
-			} else {
-				methodsContent += fmt.Sprintf(` {
-					result, err := client.executeAsynchronously(
-						struct {
-							Type string `+"`json:\"@type\"`"+`
-							%s	
-						}{
-							Type: "%s",
-							%s
-						},
-					)
-	
-					if err != nil {
-						return nil, err
-					}
-	
-					if result.Data["@type"].(string) == "error" {
-						return nil, %s
-					}
-	
-					var %s %s
-					err = json.Unmarshal(result.Raw, &%s)
-					return %s%s, err
-	
-					}
-					
-					`, clientCallStructAttrs, itemInfo.Name, paramsStr, illStr, returnTypeCamel,
-					returnType, returnTypeCamel, ampersign, returnTypeCamel)
+			%s
 			}
 
+			`, clientCallStructAttrs, itemInfo.Name, paramsStr, decodeStr)
+}
+
+// convertV1DataType returns go type of the tl type in v1 and whether it is used by value.
+// bytes are strings in structs and []byte in method params, secure types are used by pointer
+func convertV1DataType(input *tl.Type, inStruct bool) (string, bool) {
+	if input.IsVector() {
+		itemType, _ := convertV1DataType(input.Elem(), inStruct)
+		return "[]" + itemType, true
+	}
+	switch input.Name {
+	case "string", "int32":
+		return input.Name, true
+	case "int64":
+		return "JSONInt64", true
+	case "int53":
+		return "int64", true
+	case "double":
+		return "float64", true
+	case "Bool":
+		return "bool", true
+	case "bytes":
+		if inStruct {
+			return "string", true
 		}
+		return "[]byte", true
 	}
-
-	structsContent += "\n\n" + structUnmarshals
-	return &structsContent, &methodsContent
+	return getStructName(input.Name), false
 }
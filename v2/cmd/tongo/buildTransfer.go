@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/signer"
+	"github.com/mercuryoio/tonlib-go/v2/wallet"
+	"github.com/spf13/cobra"
+	"io/ioutil"
+	"log"
+	"strconv"
+)
+
+var buildTransferCmd = &cobra.Command{
+	Use:   "buildTransfer",
+	Short: "Build signed wallet v3 transfer offline",
+	Long: `Build signed wallet v3 external message without network access. The result can be sent later with sendFile. It contains seven attributes:
+- path2keyfile file with base64 encoded ed25519 seed, readable only by the owner
+- walletId wallet id, use 0 for the default one
+- seqno current wallet seqno, state init is attached when it's 0
+- validUntil unix time after which the message is rejected
+- addressDestination
+- amount in nanograms
+- path2boc path to write the result to
+- message for destination. not required
+`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 7 {
+			return fmt.Errorf("you have to use minimum seven args for this commaond \n")
+		}
+		return nil
+	},
+	Run: buildTransfer,
+}
+
+func buildTransfer(cmd *cobra.Command, args []string) {
+	keySigner, err := signer.NewFileSigner(args[0])
+	if err != nil {
+		log.Fatalf("failed to load key file: %v", err)
+	}
+	walletID, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		log.Fatalf("failed to parse wallet id argument: %s. err: %v. ", args[1], err)
+	}
+	seqno, err := strconv.ParseUint(args[2], 10, 32)
+	if err != nil {
+		log.Fatalf("failed to parse seqno argument: %s. err: %v. ", args[2], err)
+	}
+	validUntil, err := strconv.ParseUint(args[3], 10, 32)
+	if err != nil {
+		log.Fatalf("failed to parse valid until argument: %s. err: %v. ", args[3], err)
+	}
+	destination, err := address.Parse(args[4])
+	if err != nil {
+		log.Fatalf("failed to parse destination address: %v", err)
+	}
+	amount, err := strconv.ParseUint(args[5], 10, 64)
+	if err != nil {
+		log.Fatalf("failed to parse amount argument: %s. err: %v. ", args[5], err)
+	}
+	message := ""
+	if len(args) > 7 {
+		message = args[7]
+	}
+
+	w := wallet.NewV3(keySigner.PublicKey(), address.BasechainID)
+	if walletID != 0 {
+		w.WalletID = uint32(walletID)
+	}
+	boc, err := w.BuildTransferBOC(keySigner, uint32(seqno), uint32(validUntil), []wallet.Transfer{{
+		Destination: destination,
+		Amount:      amount,
+		Bounce:      destination.Bounceable,
+		Comment:     message,
+	}})
+	if err != nil {
+		log.Fatalf("failed to build transfer: %v", err)
+	}
+	err = ioutil.WriteFile(args[6], boc, 0644)
+	if err != nil {
+		log.Fatalf("failed to write boc file: %v", err)
+	}
+
+	source, err := w.Address()
+	if err != nil {
+		log.Fatalf("failed to get wallet address: %v", err)
+	}
+	fmt.Printf("transfer from %s written to %s \n", source.String(), args[6])
+}
@@ -52,7 +52,7 @@ func createPK(cmd *cobra.Command, args []string) {
 		log.Fatal("failed to create new key with error: ", err)
 		return
 	}
-	fmt.Printf("Got a result: publicKey :%v; secret: %s. Errors: %v. \n", pKey.PublicKey, pKey.Secret, err)
+	fmt.Printf("Got a result: publicKey :%v; secret: %s. Errors: %v. \n", pKey.PublicKey, string(pKey.Secret), err)
 
 	// prepare key for transffering
 	addr, err := tonClient.GetAccountAddress(tonlib.NewWalletInitialAccountState(pKey.PublicKey), 0, 0)
@@ -79,5 +79,5 @@ func createPK(cmd *cobra.Command, args []string) {
 	}
 
 	// print address
-	fmt.Printf("your new acount address: %s, public key: %s, secret key: %s \n", newAddr.AccountAddress, pKey.PublicKey, pKey.Secret)
+	fmt.Printf("your new acount address: %s, public key: %s, secret key: %s \n", newAddr.AccountAddress, pKey.PublicKey, string(pKey.Secret))
 }
@@ -32,7 +32,7 @@ var deletePKCmd = &cobra.Command{
 func deletePK(cmd *cobra.Command, args []string) {
 	confPath := args[0]
 	publicKey := args[1]
-	secret := args[2]
+	secret := tonlib.SecureString(args[2])
 	err := initClient(confPath)
 	if err != nil {
 		fmt.Println("init connection error: ", err)
@@ -39,7 +39,7 @@ var estimateFeeCmd = &cobra.Command{
 func estimateFee(cmd *cobra.Command, args []string) {
 	confPath := args[0]
 	publicKey := args[1]
-	secret := args[2]
+	secret := tonlib.SecureString(args[2])
 	password := args[3]
 	destinationAddr := args[4]
 	// parse amount
@@ -62,7 +62,7 @@ func estimateFee(cmd *cobra.Command, args []string) {
 	// prepare input key
 	inputKey := tonlib.InputKey{
 		Type:          "inputKeyRegular",
-		LocalPassword: base64.StdEncoding.EncodeToString(tonlib.SecureBytes(password)),
+		LocalPassword: tonlib.SecureString(base64.StdEncoding.EncodeToString([]byte(password))),
 		Key:           pKey,
 	}
 
@@ -79,7 +79,7 @@ func estimateFee(cmd *cobra.Command, args []string) {
 		true,
 		[]tonlib.MsgMessage{*tonlib.NewMsgMessage(
 			tonlib.JSONInt64(amount),
-			tonlib.NewMsgDataText(message),
+			tonlib.NewMsgDataText(tonlib.Bytes(message)),
 			tonlib.NewAccountAddress(destinationAddr),
 			"",
 		)},
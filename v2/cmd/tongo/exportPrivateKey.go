@@ -36,7 +36,7 @@ And returns words list of exporting mnemonics between [] and error.
 func exportPK(cmd *cobra.Command, args []string) {
 	confPath := args[0]
 	publicKey := args[1]
-	secret := args[2]
+	secret := tonlib.SecureString(args[2])
 	password := args[3]
 	err := initClient(confPath)
 	locPass := tonlib.SecureBytes(password)
@@ -49,7 +49,7 @@ func exportPK(cmd *cobra.Command, args []string) {
 
 	result, err := tonClient.ExportPemKey(tonlib.InputKey{
 		"inputKeyRegular",
-		base64.StdEncoding.EncodeToString(locPass),
+		tonlib.SecureString(base64.StdEncoding.EncodeToString(locPass)),
 		pKey,
 	}, locPass)
 
@@ -41,10 +41,10 @@ func transactions(cmd *cobra.Command, args []string) {
 	confPath := args[0]
 	address := args[1]
 	publicKey := args[2]
-	secret := args[3]
+	secret := tonlib.SecureString(args[3])
 	password := args[4]
 	var lt tonlib.JSONInt64
-	var hash string
+	var hash tonlib.Bytes
 	var err error
 	addr := tonlib.NewAccountAddress(address)
 
@@ -62,7 +62,10 @@ func transactions(cmd *cobra.Command, args []string) {
 			log.Fatalf("Failed to parse lt as integer number: `%s`. %s", args[2], err)
 		}
 		lt = tonlib.JSONInt64(ltInt)
-		hash = args[6]
+		hash, err = tonlib.BytesFromBase64(args[6])
+		if err != nil {
+			log.Fatalf("Failed to parse hash as base64: `%s`. %s", args[6], err)
+		}
 	} else {
 		accState, err := tonClient.GetAccountState(*addr)
 		if err != nil {
@@ -77,7 +80,7 @@ func transactions(cmd *cobra.Command, args []string) {
 	pKey := tonlib.TONPrivateKey{PublicKey: publicKey, Secret: secret}
 	inputKey := tonlib.InputKey{
 		Type:          "inputKeyRegular",
-		LocalPassword: base64.StdEncoding.EncodeToString(tonlib.SecureBytes(password)),
+		LocalPassword: tonlib.SecureString(base64.StdEncoding.EncodeToString([]byte(password))),
 		Key:           pKey,
 	}
 
@@ -36,7 +36,7 @@ func walletAddress(cmd *cobra.Command, args []string) {
 		os.Exit(0)
 	}
 
-	pKey := tonlib.TONPrivateKey{PublicKey: args[1], Secret: args[2]}
+	pKey := tonlib.TONPrivateKey{PublicKey: args[1], Secret: tonlib.SecureString(args[2])}
 
 	addr, err := tonClient.GetAccountAddress(tonlib.NewWalletInitialAccountState(pKey.PublicKey), 0, 0)
 	if err != nil {
@@ -0,0 +1,435 @@
+// Package config decodes blockchain configuration parameters, cells of the masterchain
+// config dictionary, into typed structs for the parameters commonly used by validators
+// and fee estimation.
+package config
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+)
+
+// commonly used config params
+const (
+	ParamElectionTimings     = 15
+	ParamValidatorsCount     = 16
+	ParamStakeLimits         = 17
+	ParamStoragePrices       = 18
+	ParamMasterchainGas      = 20
+	ParamBasechainGas        = 21
+	ParamMasterchainForward  = 24
+	ParamBasechainForward    = 25
+	ParamPrevValidatorSet    = 32
+	ParamCurrentValidatorSet = 34
+	ParamNextValidatorSet    = 36
+)
+
+// Address is the config contract, its data holds the config dictionary
+const Address = "-1:5555555555555555555555555555555555555555555555555555555555555555"
+
+// Params are value cells of the config params by their numbers
+type Params map[int32]*cell.Cell
+
+// ParseParams reads the config dictionary (Hashmap 32 ^Cell) referenced first by the config contract data
+func ParseParams(data *cell.Cell) (Params, error) {
+	root, err := data.BeginParse().LoadRef()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config contract data: %v", err)
+	}
+	entries, err := cell.ParseDict(root, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config dictionary: %v", err)
+	}
+	params := make(Params, len(entries))
+	for _, entry := range entries {
+		value, err := entry.Value.LoadRef()
+		if err != nil {
+			return nil, fmt.Errorf("invalid config param %s: %v", entry.Key, err)
+		}
+		// keys are signed, negative params are used by the config contract itself
+		params[int32(uint32(entry.Key.Uint64()))] = value
+	}
+	return params, nil
+}
+
+// Cell returns value cell of the param n
+func (params Params) Cell(n int32) (*cell.Cell, error) {
+	value, ok := params[n]
+	if !ok {
+		return nil, fmt.Errorf("config param %d is not set", n)
+	}
+	return value, nil
+}
+
+// Get decodes the param n with Parse
+func (params Params) Get(n int32) (interface{}, error) {
+	value, err := params.Cell(n)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(n, value)
+}
+
+// ElectionTimings is config param 15, all values are in seconds
+type ElectionTimings struct {
+	ValidatorsElectedFor uint32
+	ElectionsStartBefore uint32
+	ElectionsEndBefore   uint32
+	StakeHeldFor         uint32
+}
+
+// ValidatorsCount is config param 16
+type ValidatorsCount struct {
+	MaxValidators     uint16
+	MaxMainValidators uint16
+	MinValidators     uint16
+}
+
+// StakeLimits is config param 17, stakes are in nanograms
+type StakeLimits struct {
+	MinStake      *big.Int
+	MaxStake      *big.Int
+	MinTotalStake *big.Int
+	// MaxStakeFactor is a fixed point number with 16 fractional bits
+	MaxStakeFactor uint32
+}
+
+// StoragePrices is an element of config param 18, prices are in nanograms per second
+// for 2^16 bits or cells
+type StoragePrices struct {
+	UtimeSince    uint32
+	BitPricePs    uint64
+	CellPricePs   uint64
+	McBitPricePs  uint64
+	McCellPricePs uint64
+}
+
+// GasPrices is config params 20 and 21. GasPrice is in nanograms per 2^16 gas units
+type GasPrices struct {
+	// FlatGasLimit gas is charged FlatGasPrice at once, zero if there is no flat price
+	FlatGasLimit    uint64
+	FlatGasPrice    uint64
+	GasPrice        uint64
+	GasLimit        uint64
+	SpecialGasLimit uint64
+	GasCredit       uint64
+	BlockGasLimit   uint64
+	FreezeDueLimit  uint64
+	DeleteDueLimit  uint64
+}
+
+// MsgForwardPrices is config params 24 and 25. Prices are in nanograms, bit and cell prices
+// per 2^16 units, fractions are of 2^16
+type MsgForwardPrices struct {
+	LumpPrice      uint64
+	BitPrice       uint64
+	CellPrice      uint64
+	IhrPriceFactor uint32
+	FirstFrac      uint16
+	NextFrac       uint16
+}
+
+// Validator is an element of a validator set
+type Validator struct {
+	PublicKey []byte
+	Weight    uint64
+	// AdnlAddress is empty for validators without the address in the set
+	AdnlAddress []byte
+}
+
+// ValidatorSet is config params 32, 34 and 36
+type ValidatorSet struct {
+	UtimeSince  uint32
+	UtimeUntil  uint32
+	Total       uint16
+	Main        uint16
+	TotalWeight uint64
+	Validators  []Validator
+}
+
+// Parse decodes value of the config param n. Params without a typed form are returned as *cell.Cell
+func Parse(n int32, value *cell.Cell) (interface{}, error) {
+	s := value.BeginParse()
+	var result interface{}
+	var err error
+	switch n {
+	case ParamElectionTimings:
+		result, err = parseElectionTimings(s)
+	case ParamValidatorsCount:
+		result, err = parseValidatorsCount(s)
+	case ParamStakeLimits:
+		result, err = parseStakeLimits(s)
+	case ParamStoragePrices:
+		result, err = parseStoragePrices(value)
+	case ParamMasterchainGas, ParamBasechainGas:
+		result, err = ParseGasPrices(s)
+	case ParamMasterchainForward, ParamBasechainForward:
+		result, err = ParseMsgForwardPrices(s)
+	case ParamPrevValidatorSet, ParamCurrentValidatorSet, ParamNextValidatorSet:
+		result, err = ParseValidatorSet(s)
+	default:
+		return value, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid config param %d: %v", n, err)
+	}
+	return result, nil
+}
+
+func parseElectionTimings(s *cell.Slice) (*ElectionTimings, error) {
+	var values [4]uint64
+	for i := range values {
+		value, err := s.LoadUInt(32)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return &ElectionTimings{
+		ValidatorsElectedFor: uint32(values[0]),
+		ElectionsStartBefore: uint32(values[1]),
+		ElectionsEndBefore:   uint32(values[2]),
+		StakeHeldFor:         uint32(values[3]),
+	}, nil
+}
+
+func parseValidatorsCount(s *cell.Slice) (*ValidatorsCount, error) {
+	var values [3]uint64
+	for i := range values {
+		value, err := s.LoadUInt(16)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return &ValidatorsCount{
+		MaxValidators:     uint16(values[0]),
+		MaxMainValidators: uint16(values[1]),
+		MinValidators:     uint16(values[2]),
+	}, nil
+}
+
+func parseStakeLimits(s *cell.Slice) (*StakeLimits, error) {
+	var limits StakeLimits
+	var err error
+	if limits.MinStake, err = s.LoadCoins(); err != nil {
+		return nil, err
+	}
+	if limits.MaxStake, err = s.LoadCoins(); err != nil {
+		return nil, err
+	}
+	if limits.MinTotalStake, err = s.LoadCoins(); err != nil {
+		return nil, err
+	}
+	factor, err := s.LoadUInt(32)
+	if err != nil {
+		return nil, err
+	}
+	limits.MaxStakeFactor = uint32(factor)
+	return &limits, nil
+}
+
+// parseStoragePrices decodes Hashmap 32 StoragePrices keyed by utime_since, the value cell is the dictionary root
+func parseStoragePrices(root *cell.Cell) ([]StoragePrices, error) {
+	entries, err := cell.ParseDict(root, 32)
+	if err != nil {
+		return nil, err
+	}
+	prices := make([]StoragePrices, 0, len(entries))
+	for _, entry := range entries {
+		tag, err := entry.Value.LoadUInt(8)
+		if err != nil {
+			return nil, err
+		}
+		if tag != 0xcc {
+			return nil, fmt.Errorf("unexpected storage prices tag: %#x", tag)
+		}
+		var values [5]uint64
+		for i := range values {
+			bits := 64
+			if i == 0 {
+				bits = 32
+			}
+			if values[i], err = entry.Value.LoadUInt(bits); err != nil {
+				return nil, err
+			}
+		}
+		prices = append(prices, StoragePrices{
+			UtimeSince:    uint32(values[0]),
+			BitPricePs:    values[1],
+			CellPricePs:   values[2],
+			McBitPricePs:  values[3],
+			McCellPricePs: values[4],
+		})
+	}
+	return prices, nil
+}
+
+// ParseGasPrices decodes GasLimitsPrices in any of gas_prices#dd, gas_prices_ext#de and gas_flat_pfx#d1 forms
+func ParseGasPrices(s *cell.Slice) (*GasPrices, error) {
+	tag, err := s.LoadUInt(8)
+	if err != nil {
+		return nil, err
+	}
+	var prices GasPrices
+	var fields []*uint64
+	switch tag {
+	case 0xd1:
+		if prices.FlatGasLimit, err = s.LoadUInt(64); err != nil {
+			return nil, err
+		}
+		if prices.FlatGasPrice, err = s.LoadUInt(64); err != nil {
+			return nil, err
+		}
+		other, err := ParseGasPrices(s)
+		if err != nil {
+			return nil, err
+		}
+		if other.FlatGasLimit != 0 {
+			return nil, fmt.Errorf("nested flat gas prices")
+		}
+		other.FlatGasLimit, other.FlatGasPrice = prices.FlatGasLimit, prices.FlatGasPrice
+		return other, nil
+	case 0xdd:
+		fields = []*uint64{&prices.GasPrice, &prices.GasLimit, &prices.GasCredit, &prices.BlockGasLimit,
+			&prices.FreezeDueLimit, &prices.DeleteDueLimit}
+	case 0xde:
+		fields = []*uint64{&prices.GasPrice, &prices.GasLimit, &prices.SpecialGasLimit, &prices.GasCredit,
+			&prices.BlockGasLimit, &prices.FreezeDueLimit, &prices.DeleteDueLimit}
+	default:
+		return nil, fmt.Errorf("unexpected gas prices tag: %#x", tag)
+	}
+	for _, field := range fields {
+		if *field, err = s.LoadUInt(64); err != nil {
+			return nil, err
+		}
+	}
+	if tag == 0xdd {
+		prices.SpecialGasLimit = prices.GasLimit
+	}
+	return &prices, nil
+}
+
+// ParseMsgForwardPrices decodes msg_forward_prices#ea
+func ParseMsgForwardPrices(s *cell.Slice) (*MsgForwardPrices, error) {
+	tag, err := s.LoadUInt(8)
+	if err != nil {
+		return nil, err
+	}
+	if tag != 0xea {
+		return nil, fmt.Errorf("unexpected forward prices tag: %#x", tag)
+	}
+	var prices MsgForwardPrices
+	if prices.LumpPrice, err = s.LoadUInt(64); err != nil {
+		return nil, err
+	}
+	if prices.BitPrice, err = s.LoadUInt(64); err != nil {
+		return nil, err
+	}
+	if prices.CellPrice, err = s.LoadUInt(64); err != nil {
+		return nil, err
+	}
+	factor, err := s.LoadUInt(32)
+	if err != nil {
+		return nil, err
+	}
+	first, err := s.LoadUInt(16)
+	if err != nil {
+		return nil, err
+	}
+	next, err := s.LoadUInt(16)
+	if err != nil {
+		return nil, err
+	}
+	prices.IhrPriceFactor, prices.FirstFrac, prices.NextFrac = uint32(factor), uint16(first), uint16(next)
+	return &prices, nil
+}
+
+// ParseValidatorSet decodes validators#11 and validators_ext#12
+func ParseValidatorSet(s *cell.Slice) (*ValidatorSet, error) {
+	tag, err := s.LoadUInt(8)
+	if err != nil {
+		return nil, err
+	}
+	if tag != 0x11 && tag != 0x12 {
+		return nil, fmt.Errorf("unexpected validator set tag: %#x", tag)
+	}
+	var set ValidatorSet
+	since, err := s.LoadUInt(32)
+	if err != nil {
+		return nil, err
+	}
+	until, err := s.LoadUInt(32)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.LoadUInt(16)
+	if err != nil {
+		return nil, err
+	}
+	main, err := s.LoadUInt(16)
+	if err != nil {
+		return nil, err
+	}
+	set.UtimeSince, set.UtimeUntil, set.Total, set.Main = uint32(since), uint32(until), uint16(total), uint16(main)
+
+	var entries []cell.DictEntry
+	if tag == 0x12 {
+		if set.TotalWeight, err = s.LoadUInt(64); err != nil {
+			return nil, err
+		}
+		entries, err = s.LoadDict(16)
+	} else {
+		// list:(Hashmap 16 ValidatorDescr) is stored in place
+		var root *cell.Cell
+		if root, err = s.ToCell(); err == nil {
+			entries, err = cell.ParseDict(root, 16)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid validators list: %v", err)
+	}
+	for _, entry := range entries {
+		validator, err := parseValidator(entry.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator %s: %v", entry.Key, err)
+		}
+		if tag == 0x11 {
+			set.TotalWeight += validator.Weight
+		}
+		set.Validators = append(set.Validators, *validator)
+	}
+	return &set, nil
+}
+
+// parseValidator decodes validator#53 and validator_addr#73 with ed25519_pubkey#8e81278a key
+func parseValidator(s *cell.Slice) (*Validator, error) {
+	tag, err := s.LoadUInt(8)
+	if err != nil {
+		return nil, err
+	}
+	if tag != 0x53 && tag != 0x73 {
+		return nil, fmt.Errorf("unexpected validator tag: %#x", tag)
+	}
+	keyTag, err := s.LoadUInt(32)
+	if err != nil {
+		return nil, err
+	}
+	if keyTag != 0x8e81278a {
+		return nil, fmt.Errorf("unexpected public key tag: %#x", keyTag)
+	}
+	var validator Validator
+	if validator.PublicKey, err = s.LoadBytes(32); err != nil {
+		return nil, err
+	}
+	if validator.Weight, err = s.LoadUInt(64); err != nil {
+		return nil, err
+	}
+	if tag == 0x73 {
+		if validator.AdnlAddress, err = s.LoadBytes(32); err != nil {
+			return nil, err
+		}
+	}
+	return &validator, nil
+}
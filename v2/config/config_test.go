@@ -0,0 +1,193 @@
+package config
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+)
+
+func build(t *testing.T, store func(b *cell.Builder) error) *cell.Cell {
+	b := cell.NewBuilder()
+	if err := store(b); err != nil {
+		t.Fatal(err)
+	}
+	return b.EndCell()
+}
+
+func storeUInts(b *cell.Builder, bits int, values ...uint64) error {
+	for _, value := range values {
+		if err := b.StoreUInt(value, bits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestParseSimpleParams(t *testing.T) {
+	timings, err := Parse(ParamElectionTimings, build(t, func(b *cell.Builder) error {
+		return storeUInts(b, 32, 65536, 32768, 8192, 32768)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *timings.(*ElectionTimings) != (ElectionTimings{65536, 32768, 8192, 32768}) {
+		t.Fatalf("unexpected timings: %#v", timings)
+	}
+
+	limits, err := Parse(ParamStakeLimits, build(t, func(b *cell.Builder) error {
+		for _, amount := range []uint64{10000e9, 10000000e9, 10000000e9} {
+			if err := b.StoreCoins(amount); err != nil {
+				return err
+			}
+		}
+		return b.StoreUInt(3<<16, 32)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := limits.(*StakeLimits); l.MinStake.Uint64() != 10000e9 || l.MaxStakeFactor != 3<<16 {
+		t.Fatalf("unexpected stake limits: %#v", l)
+	}
+
+	if _, err := Parse(ParamBasechainForward, cell.NewBuilder().EndCell()); err == nil {
+		t.Fatal("expected error for empty forward prices")
+	}
+	forward, err := Parse(ParamBasechainForward, build(t, func(b *cell.Builder) error {
+		if err := storeUInts(b, 8, 0xea); err != nil {
+			return err
+		}
+		if err := storeUInts(b, 64, 1000000, 65536000, 6553600000); err != nil {
+			return err
+		}
+		if err := b.StoreUInt(98304, 32); err != nil {
+			return err
+		}
+		return storeUInts(b, 16, 21845, 21845)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := forward.(*MsgForwardPrices); f.LumpPrice != 1000000 || f.CellPrice != 6553600000 || f.FirstFrac != 21845 {
+		t.Fatalf("unexpected forward prices: %#v", f)
+	}
+
+	raw := build(t, func(b *cell.Builder) error { return b.StoreUInt(1, 8) })
+	if value, err := Parse(99, raw); err != nil || value != raw {
+		t.Fatalf("unexpected unknown param: %v %v", value, err)
+	}
+}
+
+func TestParseGasPrices(t *testing.T) {
+	value := build(t, func(b *cell.Builder) error {
+		if err := storeUInts(b, 8, 0xd1); err != nil {
+			return err
+		}
+		if err := storeUInts(b, 64, 100, 100000); err != nil {
+			return err
+		}
+		if err := storeUInts(b, 8, 0xde); err != nil {
+			return err
+		}
+		return storeUInts(b, 64, 26214400, 1000000, 1000000, 10000, 10000000, 100000000, 1000000000)
+	})
+	prices, err := Parse(ParamBasechainGas, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := GasPrices{
+		FlatGasLimit: 100, FlatGasPrice: 100000, GasPrice: 26214400, GasLimit: 1000000, SpecialGasLimit: 1000000,
+		GasCredit: 10000, BlockGasLimit: 10000000, FreezeDueLimit: 100000000, DeleteDueLimit: 1000000000,
+	}
+	if *prices.(*GasPrices) != expected {
+		t.Fatalf("unexpected gas prices: %#v", prices)
+	}
+}
+
+func TestParseValidatorSet(t *testing.T) {
+	var entries []cell.DictEntry
+	for i := 0; i < 3; i++ {
+		descr := cell.NewBuilder()
+		checks := []error{
+			descr.StoreUInt(0x73, 8),
+			descr.StoreUInt(0x8e81278a, 32),
+			descr.StoreBytes(bytes.Repeat([]byte{byte(i + 1)}, 32)),
+			descr.StoreUInt(uint64(i+1)*10, 64),
+			descr.StoreBytes(bytes.Repeat([]byte{byte(i + 10)}, 32)),
+		}
+		for _, err := range checks {
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		entries = append(entries, cell.DictEntry{Key: big.NewInt(int64(i)), Value: descr.EndCell().BeginParse()})
+	}
+	value := build(t, func(b *cell.Builder) error {
+		if err := storeUInts(b, 8, 0x12); err != nil {
+			return err
+		}
+		if err := storeUInts(b, 32, 1600000000, 1600065536); err != nil {
+			return err
+		}
+		if err := storeUInts(b, 16, 3, 3); err != nil {
+			return err
+		}
+		if err := b.StoreUInt(60, 64); err != nil {
+			return err
+		}
+		return b.StoreDict(entries, 16)
+	})
+	set, err := Parse(ParamCurrentValidatorSet, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vset := set.(*ValidatorSet)
+	if vset.Total != 3 || vset.TotalWeight != 60 || len(vset.Validators) != 3 || vset.Validators[2].Weight != 30 ||
+		vset.Validators[0].PublicKey[0] != 1 || vset.Validators[1].AdnlAddress[0] != 11 {
+		t.Fatalf("unexpected validator set: %#v", vset)
+	}
+}
+
+func TestParseParams(t *testing.T) {
+	timings := build(t, func(b *cell.Builder) error {
+		return storeUInts(b, 32, 65536, 32768, 8192, 32768)
+	})
+	var entries []cell.DictEntry
+	for n, value := range map[int64]*cell.Cell{ParamElectionTimings: timings, -999: build(t, func(b *cell.Builder) error { return nil })} {
+		valueCell := build(t, func(b *cell.Builder) error { return b.StoreRef(value) })
+		entries = append(entries, cell.DictEntry{Key: big.NewInt(int64(uint32(n))), Value: valueCell.BeginParse()})
+	}
+	dict, err := cell.BuildDict(entries, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the config contract data starts with cfg_dict:^Cell seqno:uint32
+	data := build(t, func(b *cell.Builder) error {
+		if err := b.StoreRef(dict); err != nil {
+			return err
+		}
+		return storeUInts(b, 32, 7)
+	})
+
+	params, err := ParseParams(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(params) != 2 || params[-999] == nil {
+		t.Fatalf("unexpected params: %v", params)
+	}
+	value, err := params.Get(ParamElectionTimings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *value.(*ElectionTimings) != (ElectionTimings{65536, 32768, 8192, 32768}) {
+		t.Fatalf("unexpected timings: %#v", value)
+	}
+	if _, err := params.Get(ParamCurrentValidatorSet); err == nil {
+		t.Fatal("expected error for missing param")
+	}
+	if _, err := ParseParams(timings); err == nil {
+		t.Fatal("expected error for data without dictionary")
+	}
+}
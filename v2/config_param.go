@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/config"
+)
+
+// GetConfigParams reads the config dictionary from the data of the config contract at the last masterchain block
+func (client *Client) GetConfigParams(ctx context.Context) (config.Params, error) {
+	state, err := Execute[*RawFullAccountState](ctx, client, &RawGetAccountStateRequest{
+		AccountAddress: *NewAccountAddress(config.Address),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseConfigState(state)
+}
+
+// GetConfigParamCell fetches value cell of the config param n from the last masterchain block
+func (client *Client) GetConfigParamCell(ctx context.Context, n int32) (*cell.Cell, error) {
+	params, err := client.GetConfigParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return params.Cell(n)
+}
+
+// GetConfigParam fetches and decodes the config param n. The result is one of config package types
+// for the params it knows, e.g. *config.GasPrices for 20 and 21, and *cell.Cell for the rest
+func (client *Client) GetConfigParam(ctx context.Context, n int32) (interface{}, error) {
+	params, err := client.GetConfigParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return params.Get(n)
+}
+
+// parseConfigState reads the config dictionary from raw state of the config contract
+func parseConfigState(state *RawFullAccountState) (config.Params, error) {
+	if len(state.Data) == 0 {
+		return nil, fmt.Errorf("config contract has no data")
+	}
+	data, err := cell.FromBOC(state.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config contract data: %v", err)
+	}
+	return config.ParseParams(data)
+}
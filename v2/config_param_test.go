@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/config"
+)
+
+func TestParseConfigState(t *testing.T) {
+	b := cell.NewBuilder()
+	for _, value := range []uint64{65536, 32768, 8192, 32768} {
+		if err := b.StoreUInt(value, 32); err != nil {
+			t.Fatal(err)
+		}
+	}
+	value := cell.NewBuilder()
+	if err := value.StoreRef(b.EndCell()); err != nil {
+		t.Fatal(err)
+	}
+	dict, err := cell.BuildDict([]cell.DictEntry{{Key: big.NewInt(config.ParamElectionTimings), Value: value.EndCell().BeginParse()}}, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := cell.NewBuilder()
+	if err := data.StoreRef(dict); err != nil {
+		t.Fatal(err)
+	}
+
+	params, err := parseConfigState(&RawFullAccountState{Data: data.EndCell().ToBOC()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	param, err := params.Get(config.ParamElectionTimings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timings := param.(*config.ElectionTimings); timings.ElectionsEndBefore != 8192 {
+		t.Fatalf("unexpected timings: %#v", timings)
+	}
+	if _, err := parseConfigState(&RawFullAccountState{}); err == nil {
+		t.Fatal("expected error for config contract without data")
+	}
+}
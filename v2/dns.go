@@ -0,0 +1,233 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mercuryoio/tonlib-go/v2/internal/lru"
+	"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
+)
+
+const (
+	// DnsCategoryAll asks for records of all categories
+	DnsCategoryAll = 0
+	// DnsCategoryNextResolver is the category of records delegating a subdomain to another resolver
+	DnsCategoryNextResolver = -1
+
+	DefaultDnsCacheSize = 1024
+	DefaultDnsCacheTTL  = 5 * time.Minute
+	DefaultDnsMaxDepth  = 16
+)
+
+var ErrDnsLoop = errors.New("dns resolution loop")
+
+// DnsEntryValue is one of DnsEntryDataText, DnsEntryDataNextResolver, DnsEntryDataSmcAddress,
+// DnsEntryDataAdnlAddress, DnsEntryDataUnknown or UnknownDnsEntryData for entries missing in the schema
+type DnsEntryValue = DnsEntryData
+
+// DnsRecord is a DnsEntry with decoded data
+type DnsRecord struct {
+	Name     string
+	Category int32
+	Value    DnsEntryValue
+}
+
+// DnsResolution is the result of recursive name resolution
+type DnsResolution struct {
+	Name     string
+	Category int32
+	// Resolver is the contract which returned Records, nil means the root resolver
+	Resolver *AccountAddress
+	Records  []DnsRecord
+}
+
+// SmcAddress returns the first smart contract address record
+func (resolution *DnsResolution) SmcAddress() (*AccountAddress, bool) {
+	for _, record := range resolution.Records {
+		if value, ok := record.Value.(*DnsEntryDataSmcAddress); ok {
+			return value.SmcAddress, true
+		}
+	}
+	return nil, false
+}
+
+// AdnlAddress returns the first ADNL address record
+func (resolution *DnsResolution) AdnlAddress() (*AdnlAddress, bool) {
+	for _, record := range resolution.Records {
+		if value, ok := record.Value.(*DnsEntryDataAdnlAddress); ok {
+			return value.AdnlAddress, true
+		}
+	}
+	return nil, false
+}
+
+// Text returns the first text record
+func (resolution *DnsResolution) Text() (string, bool) {
+	for _, record := range resolution.Records {
+		if value, ok := record.Value.(*DnsEntryDataText); ok {
+			return value.Text, true
+		}
+	}
+	return "", false
+}
+
+// DnsResolver resolves names step by step starting from the root DNS contract,
+// following next resolver records and caching every step
+type DnsResolver struct {
+	// Root is the root DNS contract, nil means the one from the network config
+	Root     *AccountAddress
+	CacheTTL time.Duration
+	MaxDepth int
+
+	cache *lru.Cache
+	step  func(resolver *AccountAddress, name string, category int32) ([]DnsRecord, error)
+}
+
+// NewDnsResolver creates resolver with cache of cacheSize steps
+func NewDnsResolver(client *Client, cacheSize int, cacheTTL time.Duration) *DnsResolver {
+	return &DnsResolver{
+		CacheTTL: cacheTTL,
+		MaxDepth: DefaultDnsMaxDepth,
+		cache:    lru.New(cacheSize),
+		step:     client.dnsResolveStep,
+	}
+}
+
+// ResolveName resolves name with the client's default resolver
+func (client *Client) ResolveName(ctx context.Context, name string, category int32) (*DnsResolution, error) {
+	client.dnsOnce.Do(func() {
+		client.dns = NewDnsResolver(client, DefaultDnsCacheSize, DefaultDnsCacheTTL)
+	})
+	return client.dns.Resolve(ctx, name, category)
+}
+
+// Resolve resolves name like "foo.ton". Every resolver handles the rightmost labels it knows
+// and delegates the rest of the name to the next resolver
+func (resolver *DnsResolver) Resolve(ctx context.Context, name string, category int32) (*DnsResolution, error) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if name == "" {
+		return nil, fmt.Errorf("empty dns name")
+	}
+	current := resolver.Root
+	visited := map[string]bool{}
+	for depth := 0; ; depth++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if depth >= resolver.MaxDepth {
+			return nil, fmt.Errorf("dns resolution of %s exceeded %d steps", name, resolver.MaxDepth)
+		}
+		key := dnsCacheKey(current, name, category)
+		if visited[key] {
+			return nil, fmt.Errorf("%w: %s", ErrDnsLoop, key)
+		}
+		visited[key] = true
+
+		records, err := resolver.resolveStep(current, name, category)
+		if err != nil {
+			return nil, err
+		}
+		next, rest, err := dnsDelegation(records, name, category)
+		if err != nil {
+			return nil, err
+		}
+		if next == nil {
+			return &DnsResolution{Name: name, Category: category, Resolver: current, Records: records}, nil
+		}
+		current, name = next, rest
+	}
+}
+
+func (resolver *DnsResolver) resolveStep(current *AccountAddress, name string, category int32) ([]DnsRecord, error) {
+	key := dnsCacheKey(current, name, category)
+	if cached, ok := resolver.cache.Get(key); ok {
+		return cached.([]DnsRecord), nil
+	}
+	records, err := resolver.step(current, name, category)
+	if err != nil {
+		return nil, err
+	}
+	if resolver.CacheTTL > 0 {
+		resolver.cache.Add(key, records, resolver.CacheTTL)
+	}
+	return records, nil
+}
+
+// Purge drops cached steps of the name, e.g. after its records have been updated
+func (resolver *DnsResolver) Purge(current *AccountAddress, name string, category int32) {
+	resolver.cache.Remove(dnsCacheKey(current, name, category))
+}
+
+func dnsCacheKey(resolver *AccountAddress, name string, category int32) string {
+	root := "root"
+	if resolver != nil {
+		root = resolver.AccountAddress
+	}
+	return fmt.Sprintf("%s/%s/%d", root, name, category)
+}
+
+// dnsDelegation returns the next resolver and the part of the name left for it, if records delegate the name.
+// Same rules as in tonlib: a single next resolver record for a dot separated suffix of the name
+func dnsDelegation(records []DnsRecord, name string, category int32) (*AccountAddress, string, error) {
+	if len(records) != 1 || records[0].Category != DnsCategoryNextResolver || records[0].Name == name ||
+		category == DnsCategoryNextResolver {
+		return nil, "", nil
+	}
+	value, ok := records[0].Value.(*DnsEntryDataNextResolver)
+	if !ok {
+		return nil, "", nil
+	}
+	resolved := records[0].Name
+	if resolved == "" || !strings.HasSuffix(name, "."+resolved) {
+		return nil, "", fmt.Errorf("resolver returned %q which is not a suffix of %q", resolved, name)
+	}
+	if value.Resolver == nil || value.Resolver.AccountAddress == "" {
+		return nil, "", fmt.Errorf("empty next resolver for %q", resolved)
+	}
+	return value.Resolver, name[:len(name)-len(resolved)-1], nil
+}
+
+// dnsResolveStep asks a single resolver without recursion on tonlib side.
+// DnsResolve can't be used: the generated DnsEntry can't hold entry data objects
+func (client *Client) dnsResolveStep(resolver *AccountAddress, name string, category int32) ([]DnsRecord, error) {
+	if err := validate(resolver); err != nil {
+		return nil, fmt.Errorf("dns.resolve: account_address: %w", err)
+	}
+	result, err := client.executeAsynchronously(
+		struct {
+			Type           string          `json:"@type"`
+			AccountAddress *AccountAddress `json:"account_address"`
+			Category       int32           `json:"category"`
+			Name           string          `json:"name"`
+			Ttl            int32           `json:"ttl"`
+		}{
+			Type:           "dns.resolve",
+			AccountAddress: resolver,
+			Category:       category,
+			Name:           name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.Data["@type"].(string) == "error" {
+		return nil, tonlibjson.ParseError(result.Raw)
+	}
+	return parseDnsResolved(result.Raw)
+}
+
+func parseDnsResolved(data []byte) ([]DnsRecord, error) {
+	var resolved DnsResolved
+	if err := json.Unmarshal(data, &resolved); err != nil {
+		return nil, err
+	}
+	records := make([]DnsRecord, 0, len(resolved.Entries))
+	for _, entry := range resolved.Entries {
+		records = append(records, DnsRecord{Name: entry.Name, Category: entry.Category, Value: entry.Entry})
+	}
+	return records, nil
+}
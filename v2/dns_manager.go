@@ -0,0 +1,206 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// DefaultDnsQueryTimeout is the validity of dns update queries in seconds
+const DefaultDnsQueryTimeout = 60
+
+// DnsManager updates records of a manual DNS contract (dns.accountState) owned by the key
+type DnsManager struct {
+	client       *Client
+	initialState *DnsInitialAccountState
+	key          InputKey
+	QueryTimeout int32
+	address      *AccountAddress
+}
+
+// NewDnsManager creates manager of the contract with the owner key and wallet id
+func NewDnsManager(client *Client, key InputKey, walletID int64) *DnsManager {
+	return &DnsManager{
+		client:       client,
+		initialState: NewDnsInitialAccountState(key.Key.PublicKey, JSONInt64(walletID)),
+		key:          key,
+		QueryTimeout: DefaultDnsQueryTimeout,
+	}
+}
+
+// Address returns the contract address
+func (manager *DnsManager) Address() (*AccountAddress, error) {
+	if manager.address != nil {
+		return manager.address, nil
+	}
+	addr, err := manager.client.GetAccountAddress(manager.initialState, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	manager.address = addr
+	return addr, nil
+}
+
+// ListRecords returns records of all categories the contract keeps for the name
+func (manager *DnsManager) ListRecords(name string) ([]DnsRecord, error) {
+	addr, err := manager.Address()
+	if err != nil {
+		return nil, err
+	}
+	records, err := manager.client.dnsResolveStep(addr, name, DnsCategoryAll)
+	if err != nil {
+		return nil, err
+	}
+	own := records[:0]
+	for _, record := range records {
+		if record.Name == name {
+			own = append(own, record)
+		}
+	}
+	return own, nil
+}
+
+// SetRecord sets the record of the name and category
+func (manager *DnsManager) SetRecord(name string, category int32, value DnsEntryValue) (*QueryInfo, error) {
+	return manager.send([]DnsAction{newDnsActionSetRecord(DnsRecord{Name: name, Category: category, Value: value})})
+}
+
+// DeleteRecord deletes the record of the name and category
+func (manager *DnsManager) DeleteRecord(name string, category int32) (*QueryInfo, error) {
+	return manager.send([]DnsAction{NewDnsActionDelete(category, name)})
+}
+
+// DeleteAll deletes all records of the contract
+func (manager *DnsManager) DeleteAll() (*QueryInfo, error) {
+	return manager.send([]DnsAction{NewDnsActionDeleteAll()})
+}
+
+// Plan compares desired records with the ones stored for the same names and returns the actions
+// making them equal. Names absent in desired are not touched
+func (manager *DnsManager) Plan(desired []DnsRecord) ([]DnsAction, error) {
+	names := map[string]bool{}
+	for _, record := range desired {
+		names[record.Name] = true
+	}
+	var current []DnsRecord
+	for name := range names {
+		records, err := manager.ListRecords(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list records of %s: %v", name, err)
+		}
+		current = append(current, records...)
+	}
+	return diffDnsRecords(current, desired)
+}
+
+// Apply sends the actions returned by Plan in one query. Nil query info is returned if nothing has to change
+func (manager *DnsManager) Apply(desired []DnsRecord) (*QueryInfo, []DnsAction, error) {
+	actions, err := manager.Plan(desired)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(actions) == 0 {
+		return nil, nil, nil
+	}
+	info, err := manager.send(actions)
+	return info, actions, err
+}
+
+func (manager *DnsManager) send(actions []DnsAction) (*QueryInfo, error) {
+	addr, err := manager.Address()
+	if err != nil {
+		return nil, err
+	}
+	info, err := manager.client.CreateQuery(NewActionDns(actions), *addr, manager.initialState, manager.key, manager.QueryTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dns query: %v", err)
+	}
+	if _, err := manager.client.QuerySend(info.Id); err != nil {
+		return nil, fmt.Errorf("failed to send dns query: %v", err)
+	}
+	return info, nil
+}
+
+type dnsRecordKey struct {
+	name     string
+	category int32
+}
+
+// diffDnsRecords returns set actions for new and changed records and delete actions for
+// current records absent in desired ones. Actions are sorted by name and category
+func diffDnsRecords(current, desired []DnsRecord) ([]DnsAction, error) {
+	want := map[dnsRecordKey]DnsRecord{}
+	for _, record := range desired {
+		if record.Category == DnsCategoryAll {
+			return nil, fmt.Errorf("record %s can't have category 0", record.Name)
+		}
+		if record.Value == nil {
+			return nil, fmt.Errorf("record %s/%d has no value", record.Name, record.Category)
+		}
+		key := dnsRecordKey{record.Name, record.Category}
+		if _, ok := want[key]; ok {
+			return nil, fmt.Errorf("duplicate record %s/%d", record.Name, record.Category)
+		}
+		want[key] = record
+	}
+	have := map[dnsRecordKey]DnsRecord{}
+	for _, record := range current {
+		have[dnsRecordKey{record.Name, record.Category}] = record
+	}
+
+	var keys []dnsRecordKey
+	for key := range want {
+		keys = append(keys, key)
+	}
+	for key := range have {
+		if _, ok := want[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].category < keys[j].category
+	})
+
+	var actions []DnsAction
+	for _, key := range keys {
+		wanted, ok := want[key]
+		if !ok {
+			actions = append(actions, NewDnsActionDelete(key.category, key.name))
+			continue
+		}
+		existing, ok := have[key]
+		if ok {
+			same, err := sameDnsValue(existing.Value, wanted.Value)
+			if err != nil {
+				return nil, err
+			}
+			if same {
+				continue
+			}
+		}
+		actions = append(actions, newDnsActionSetRecord(wanted))
+	}
+	return actions, nil
+}
+
+func sameDnsValue(a, b DnsEntryValue) (bool, error) {
+	if a == nil || b == nil || a.MessageType() != b.MessageType() {
+		return false, nil
+	}
+	dataA, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	dataB, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return string(dataA) == string(dataB), nil
+}
+
+func newDnsActionSetRecord(record DnsRecord) *DnsActionSet {
+	return NewDnsActionSet(NewDnsEntry(record.Category, record.Value, record.Name))
+}
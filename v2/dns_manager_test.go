@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDiffDnsRecords(t *testing.T) {
+	current := []DnsRecord{
+		{Name: "foo", Category: 1, Value: NewDnsEntryDataSmcAddress(NewAccountAddress("EQ_old"))},
+		{Name: "foo", Category: 2, Value: NewDnsEntryDataText("same")},
+		{Name: "foo", Category: 3, Value: NewDnsEntryDataText("stale")},
+	}
+	desired := []DnsRecord{
+		{Name: "foo", Category: 1, Value: NewDnsEntryDataSmcAddress(NewAccountAddress("EQ_new"))},
+		{Name: "foo", Category: 2, Value: NewDnsEntryDataText("same")},
+		{Name: "bar", Category: 1, Value: NewDnsEntryDataText("new")},
+	}
+	actions, err := diffDnsRecords(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(NewActionDns(actions))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"@type":"actionDns","@extra":"","actions":[` +
+		`{"@type":"dns.actionSet","@extra":"","entry":{"@type":"dns.entry","@extra":"","category":1,` +
+		`"entry":{"@type":"dns.entryDataText","@extra":"","text":"new"},"name":"bar"}},` +
+		`{"@type":"dns.actionSet","@extra":"","entry":{"@type":"dns.entry","@extra":"","category":1,` +
+		`"entry":{"@type":"dns.entryDataSmcAddress","@extra":"","smc_address":{"@type":"accountAddress","@extra":"","account_address":"EQ_new"}},"name":"foo"}},` +
+		`{"@type":"dns.actionDelete","@extra":"","category":3,"name":"foo"}]}`
+	if string(data) != expected {
+		t.Fatalf("unexpected actions:\n%s\nexpected:\n%s", data, expected)
+	}
+
+	actions, err = diffDnsRecords(current[:2], current[:2])
+	if err != nil || len(actions) != 0 {
+		t.Fatalf("expected no actions, got %v %v", actions, err)
+	}
+	_, err = diffDnsRecords(nil, append(desired, desired[0]))
+	if err == nil || !strings.Contains(err.Error(), "duplicate") {
+		t.Fatalf("expected duplicate record error, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mercuryoio/tonlib-go/v2/internal/lru"
+)
+
+const testDnsResolved = `{"@type":"dns.resolved","entries":[` +
+	`{"@type":"dns.entry","name":"ton","category":-1,"entry":{"@type":"dns.entryDataNextResolver",` +
+	`"resolver":{"@type":"accountAddress","account_address":"EQ_ton_resolver"}}}]}`
+
+func TestParseDnsResolved(t *testing.T) {
+	records, err := parseDnsResolved([]byte(testDnsResolved))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Name != "ton" || records[0].Category != DnsCategoryNextResolver {
+		t.Fatalf("unexpected records: %#v", records)
+	}
+	next, ok := records[0].Value.(*DnsEntryDataNextResolver)
+	if !ok || next.Resolver.AccountAddress != "EQ_ton_resolver" {
+		t.Fatalf("unexpected entry: %#v", records[0].Value)
+	}
+	records, err = parseDnsResolved([]byte(`{"entries":[{"entry":{"@type":"dns.entryDataFuture"}}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unknown, ok := records[0].Value.(*UnknownDnsEntryData); !ok || unknown.Type != "dns.entryDataFuture" {
+		t.Fatalf("unexpected entry: %#v", records[0].Value)
+	}
+}
+
+type fakeDnsStep struct {
+	calls   int
+	records map[string][]DnsRecord
+}
+
+func (step *fakeDnsStep) resolve(resolver *AccountAddress, name string, category int32) ([]DnsRecord, error) {
+	step.calls++
+	records, ok := step.records[dnsCacheKey(resolver, name, category)]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return records, nil
+}
+
+func nextResolver(name, resolver string) []DnsRecord {
+	return []DnsRecord{{Name: name, Category: DnsCategoryNextResolver, Value: NewDnsEntryDataNextResolver(NewAccountAddress(resolver))}}
+}
+
+func TestDnsResolverRecursion(t *testing.T) {
+	step := &fakeDnsStep{records: map[string][]DnsRecord{
+		"root/wallet.foo.ton/1":       nextResolver("ton", "EQ_ton"),
+		"EQ_ton/wallet.foo/1":         nextResolver("foo", "EQ_foo"),
+		"EQ_foo/wallet/1":             {{Name: "wallet", Category: 1, Value: NewDnsEntryDataSmcAddress(NewAccountAddress("EQ_wallet"))}},
+		"root/loop.ton/1":             nextResolver("ton", "EQ_loop"),
+		"EQ_loop/loop/1":              nextResolver("", "EQ_loop"),
+		"root/bad.ton/1":              nextResolver("com", "EQ_com"),
+		"root/ton/-1":                 nextResolver("ton", "EQ_ton"),
+		"EQ_ton/wallet.foo/-1":        nextResolver("foo", "EQ_foo"),
+		"root/self.ton/1":             nextResolver("ton", "EQ_self"),
+		"EQ_self/self/1":              nextResolver("self", "EQ_self"),
+		"root/cycle.a.ton/1":          nextResolver("ton", "EQ_a"),
+		"EQ_a/cycle.a/1":              nextResolver("a", "EQ_a"),
+		"EQ_a/cycle/1":                nextResolver("cycle", "EQ_a"),
+		"root/deep.deep.deep.ton/777": nil,
+	}}
+	resolver := &DnsResolver{CacheTTL: time.Minute, MaxDepth: DefaultDnsMaxDepth, cache: lru.New(16), step: step.resolve}
+
+	resolution, err := resolver.Resolve(context.Background(), "Wallet.Foo.TON.", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, ok := resolution.SmcAddress()
+	if !ok || addr.AccountAddress != "EQ_wallet" || resolution.Resolver.AccountAddress != "EQ_foo" {
+		t.Fatalf("unexpected resolution: %#v", resolution)
+	}
+	if step.calls != 3 {
+		t.Fatalf("expected 3 steps, got %d", step.calls)
+	}
+	if _, err := resolver.Resolve(context.Background(), "wallet.foo.ton", 1); err != nil || step.calls != 3 {
+		t.Fatalf("expected cached resolution, got %v after %d steps", err, step.calls)
+	}
+
+	// explicit next resolver request is not followed
+	resolution, err = resolver.Resolve(context.Background(), "ton", DnsCategoryNextResolver)
+	if err != nil || resolution.Resolver != nil || len(resolution.Records) != 1 {
+		t.Fatalf("unexpected next resolver resolution: %#v %v", resolution, err)
+	}
+
+	if _, err := resolver.Resolve(context.Background(), "loop.ton", 1); err == nil {
+		t.Fatal("expected error for empty resolved name")
+	}
+	if _, err := resolver.Resolve(context.Background(), "bad.ton", 1); err == nil {
+		t.Fatal("expected error for foreign suffix")
+	}
+	if _, err := resolver.Resolve(context.Background(), "self.ton", 1); err != nil {
+		// a resolver returning the whole name is the final answer
+		t.Fatal(err)
+	}
+
+	resolver.MaxDepth = 2
+	if _, err := resolver.Resolve(context.Background(), "cycle.a.ton", 1); err == nil {
+		t.Fatal("expected depth limit error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := resolver.Resolve(ctx, "deep.deep.deep.ton", 777); err != context.Canceled {
+		t.Fatalf("expected canceled context, got %v", err)
+	}
+}
+
+func TestDnsResolveStep(t *testing.T) {
+	client, fake := newFakeClient(func(req map[string]interface{}) string {
+		return testDnsResolved
+	})
+	records, err := client.dnsResolveStep(nil, "foo.ton", DnsCategoryAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || len(fake.requests) != 1 {
+		t.Fatalf("unexpected records %#v for requests %v", records, fake.requests)
+	}
+
+	// the resolver is validated before the request is sent
+	if _, err := client.dnsResolveStep(NewAccountAddress("not an address"), "foo.ton", DnsCategoryAll); err == nil {
+		t.Fatal("expected error for invalid resolver")
+	}
+	if len(fake.requests) != 1 {
+		t.Fatalf("invalid resolver has been sent: %v", fake.requests)
+	}
+}
@@ -0,0 +1,188 @@
+package v2
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/elector"
+	"github.com/mercuryoio/tonlib-go/v2/signer"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+// DefaultElectorAddress is the elector of the main and test networks, config param 1
+const DefaultElectorAddress = "-1:3333333333333333333333333333333333333333333333333333333333333333"
+
+// DefaultElectorQueryTimeout is the validity of stake queries in seconds
+const DefaultElectorQueryTimeout = 60
+
+var (
+	ErrNoActiveElection = errors.New("no active election")
+	ErrNothingToRecover = errors.New("no stake to recover")
+)
+
+// Elector takes part in validator elections on behalf of a validator through its masterchain wallet
+type Elector struct {
+	client       *Client
+	walletState  InitialAccountState
+	key          InputKey
+	wallet       *AccountAddress
+	Address      string
+	QueryTimeout int32
+	// Fee is attached to stake and recover requests, the excess is returned by the elector
+	Fee int64
+}
+
+// NewElector creates elector client sending messages from the masterchain wallet with the initial state and key
+func NewElector(client *Client, walletState InitialAccountState, key InputKey) *Elector {
+	return &Elector{
+		client:       client,
+		walletState:  walletState,
+		key:          key,
+		Address:      DefaultElectorAddress,
+		QueryTimeout: DefaultElectorQueryTimeout,
+		Fee:          elector.DefaultFee,
+	}
+}
+
+// Wallet returns the masterchain wallet address stakes are sent from and returned to
+func (e *Elector) Wallet() (*AccountAddress, error) {
+	if e.wallet != nil {
+		return e.wallet, nil
+	}
+	addr, err := e.client.GetAccountAddress(e.walletState, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	e.wallet = addr
+	return addr, nil
+}
+
+func (e *Elector) walletAddress() (*address.Address, error) {
+	wallet, err := e.Wallet()
+	if err != nil {
+		return nil, err
+	}
+	return address.Parse(wallet.AccountAddress)
+}
+
+// ActiveElectionID returns id of the active election, 0 if elections are not open
+func (e *Elector) ActiveElectionID() (int64, error) {
+	return e.client.GetActiveElectionID(e.Address)
+}
+
+// Participate sends the stake for the active election. The payload is signed by the validator key,
+// maxFactor limits the validator's stake to maxFactor times the minimal stake of the elected set
+func (e *Elector) Participate(validator signer.Signer, adnlAddress []byte, stake int64, maxFactor float64) (*QueryInfo, error) {
+	electionID, err := e.ActiveElectionID()
+	if err != nil {
+		return nil, err
+	}
+	if electionID == 0 {
+		return nil, ErrNoActiveElection
+	}
+	factor, err := elector.MaxFactor(maxFactor)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := e.walletAddress()
+	if err != nil {
+		return nil, err
+	}
+	request := elector.NewStake{
+		QueryID:         uint64(electionID),
+		ValidatorPubkey: validator.PublicKey(),
+		StakeAt:         uint32(electionID),
+		MaxFactor:       factor,
+		AdnlAddress:     adnlAddress,
+	}
+	payload, err := request.SignedPayload(wallet)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := validator.Sign(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign stake: %v", err)
+	}
+	body, err := request.Body(signature)
+	if err != nil {
+		return nil, err
+	}
+	return e.send(rawMsgData(body), stake+e.Fee)
+}
+
+// ReturnedStake returns the stake with rewards the elector keeps for the wallet after unfreezing
+func (e *Elector) ReturnedStake() (int64, error) {
+	wallet, err := e.walletAddress()
+	if err != nil {
+		return 0, err
+	}
+	return e.client.CheckReward(hex.EncodeToString(wallet.Hash[:]), e.Address)
+}
+
+// RecoverStake requests the returned stake back to the wallet
+func (e *Elector) RecoverStake() (*QueryInfo, error) {
+	returned, err := e.ReturnedStake()
+	if err != nil {
+		return nil, err
+	}
+	if returned == 0 {
+		return nil, ErrNothingToRecover
+	}
+	body, err := elector.RecoverStakeBody(0)
+	if err != nil {
+		return nil, err
+	}
+	return e.send(rawMsgData(body), e.Fee)
+}
+
+// Stake returns the validator's stake in the active election, 0 if it does not participate
+func (e *Elector) Stake(pubkey ed25519.PublicKey) (int64, error) {
+	return e.client.CheckParticipatesIn(hex.EncodeToString(pubkey), e.Address)
+}
+
+// PastElections returns elections whose stakes are still frozen
+func (e *Elector) PastElections() ([]elector.PastElection, error) {
+	stack, err := e.client.runGetMethodValues(e.Address, elector.PastElectionsMethod)
+	if err != nil {
+		return nil, err
+	}
+	return elector.ParsePastElections(stack)
+}
+
+// Complaints returns complaints against validators elected in the election
+func (e *Elector) Complaints(electionID int64) ([]elector.Complaint, error) {
+	stack, err := e.client.runGetMethodValues(e.Address, elector.ComplaintsMethod, tvm.NumberEntry(big.NewInt(electionID)))
+	if err != nil {
+		return nil, err
+	}
+	return elector.ParseComplaints(stack)
+}
+
+// History returns the validator's stakes and rewards in past elections
+func (e *Elector) History(pubkey ed25519.PublicKey) ([]elector.StakeRecord, error) {
+	elections, err := e.PastElections()
+	if err != nil {
+		return nil, err
+	}
+	return elector.History(elections, pubkey), nil
+}
+
+func (e *Elector) send(data MsgData, amount int64) (*QueryInfo, error) {
+	wallet, err := e.Wallet()
+	if err != nil {
+		return nil, err
+	}
+	msg := NewMsgMessage(JSONInt64(amount), data, NewAccountAddress(e.Address), "")
+	info, err := e.client.CreateQuery(NewActionMsg(false, []MsgMessage{*msg}), *wallet, e.walletState, e.key, e.QueryTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create elector query: %v", err)
+	}
+	if _, err := e.client.QuerySend(info.Id); err != nil {
+		return nil, fmt.Errorf("failed to send elector query: %v", err)
+	}
+	return info, nil
+}
@@ -0,0 +1,411 @@
+// Package elector builds messages to the elector smart contract and decodes results of its get methods,
+// covering validator elections: stake submission, stake recovery, past elections and complaints.
+package elector
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+const (
+	OpNewStake             = 0x4e73744b
+	OpNewStakeConfirmation = 0xf374484c
+	OpNewStakeRejected     = 0xee6f454c
+	OpRecoverStake         = 0x47657424
+	OpRecoverStakeOk       = 0xf96f7324
+	OpRecoverStakeError    = 0xfffffffe
+
+	// newStakeSignaturePrefix starts the payload signed by the validator key
+	newStakeSignaturePrefix = 0x654c5074
+
+	PastElectionsMethod = "past_elections"
+	ComplaintsMethod    = "complaints"
+
+	// DefaultFee is attached to stake and recover requests on top of the stake, the excess is returned, nanograms
+	DefaultFee = 1000000000
+
+	// MaxFactorOne is max_factor of 1.0: the factors are fixed point numbers with 16 fractional bits
+	MaxFactorOne = 1 << 16
+)
+
+// MaxFactor converts max stake factor, the ratio of the validator's stake to the minimal one it accepts, to the fixed point form
+func MaxFactor(factor float64) (uint32, error) {
+	if factor < 1 || factor > 100 {
+		return 0, fmt.Errorf("max factor %v is out of [1, 100]", factor)
+	}
+	return uint32(factor * MaxFactorOne), nil
+}
+
+// NewStake is the new_stake request sent by the validator's masterchain wallet
+type NewStake struct {
+	QueryID         uint64
+	ValidatorPubkey ed25519.PublicKey
+	// StakeAt is the id of the active election
+	StakeAt   uint32
+	MaxFactor uint32
+	// AdnlAddress is the validator's 32 bytes ADNL address
+	AdnlAddress []byte
+}
+
+func (stake NewStake) check() error {
+	if len(stake.ValidatorPubkey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid validator public key length: %d", len(stake.ValidatorPubkey))
+	}
+	if len(stake.AdnlAddress) != 32 {
+		return fmt.Errorf("invalid adnl address length: %d", len(stake.AdnlAddress))
+	}
+	if stake.MaxFactor < MaxFactorOne {
+		return fmt.Errorf("max factor %d is below %d", stake.MaxFactor, MaxFactorOne)
+	}
+	return nil
+}
+
+// SignedPayload returns the data the validator key signs: the election, the factor,
+// the address of the wallet sending the stake and the ADNL address
+func (stake NewStake) SignedPayload(wallet *address.Address) ([]byte, error) {
+	if err := stake.check(); err != nil {
+		return nil, err
+	}
+	if wallet == nil {
+		return nil, fmt.Errorf("empty wallet address")
+	}
+	payload := make([]byte, 12, 12+32+32)
+	binary.BigEndian.PutUint32(payload[0:], newStakeSignaturePrefix)
+	binary.BigEndian.PutUint32(payload[4:], stake.StakeAt)
+	binary.BigEndian.PutUint32(payload[8:], stake.MaxFactor)
+	payload = append(payload, wallet.Hash[:]...)
+	return append(payload, stake.AdnlAddress...), nil
+}
+
+// Body builds new_stake#4e73744b message body with the validator signature of SignedPayload
+func (stake NewStake) Body(signature []byte) (*cell.Cell, error) {
+	if err := stake.check(); err != nil {
+		return nil, err
+	}
+	if len(signature) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+	sig := cell.NewBuilder()
+	if err := sig.StoreBytes(signature); err != nil {
+		return nil, err
+	}
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(OpNewStake, 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(stake.QueryID, 64); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBytes(stake.ValidatorPubkey); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(uint64(stake.StakeAt), 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(uint64(stake.MaxFactor), 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBytes(stake.AdnlAddress); err != nil {
+		return nil, err
+	}
+	if err := b.StoreRef(sig.EndCell()); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// RecoverStakeBody builds recover_stake#47657424 message body
+func RecoverStakeBody(queryID uint64) (*cell.Cell, error) {
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(OpRecoverStake, 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(queryID, 64); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// FrozenStake is a validator's stake frozen by the elector after an election
+type FrozenStake struct {
+	// Wallet is the hash part of the masterchain wallet address the stake is returned to
+	Wallet []byte
+	Weight uint64
+	Stake  *big.Int
+	Banned bool
+}
+
+// PastElection is an element of past_elections
+type PastElection struct {
+	ID         int64
+	UnfreezeAt int64
+	StakeHeld  int64
+	VsetHash   []byte
+	// Frozen stakes by hex validator public key
+	Frozen     map[string]FrozenStake
+	TotalStake *big.Int
+	Bonuses    *big.Int
+}
+
+// Reward returns the part of the bonuses the validator gets back with its stake,
+// it is proportional to the stake. Banned validators get nothing
+func (election PastElection) Reward(pubkey ed25519.PublicKey) *big.Int {
+	frozen, ok := election.Frozen[hex.EncodeToString(pubkey)]
+	if !ok || frozen.Banned || election.TotalStake.Sign() == 0 {
+		return new(big.Int)
+	}
+	reward := new(big.Int).Mul(election.Bonuses, frozen.Stake)
+	return reward.Div(reward, election.TotalStake)
+}
+
+// ParsePastElections decodes past_elections stack: a list of
+// [election_id, unfreeze_at, stake_held, vset_hash, frozen_dict, total_stake, bonuses, complaints]
+func ParsePastElections(stack []interface{}) ([]PastElection, error) {
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%s returned %d values, expected 1", PastElectionsMethod, len(stack))
+	}
+	if tvm.IsNull(stack[0]) {
+		return nil, nil
+	}
+	items, err := tvm.List(stack[0])
+	if err != nil {
+		return nil, err
+	}
+	elections := make([]PastElection, 0, len(items))
+	for _, item := range items {
+		election, err := parsePastElection(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid past election: %v", err)
+		}
+		elections = append(elections, *election)
+	}
+	return elections, nil
+}
+
+func parsePastElection(item interface{}) (*PastElection, error) {
+	fields, err := tvm.Tuple(item)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) < 7 {
+		return nil, fmt.Errorf("%d fields, expected at least 7", len(fields))
+	}
+	var election PastElection
+	if election.ID, err = tvm.Int64(fields[0]); err != nil {
+		return nil, fmt.Errorf("invalid id: %v", err)
+	}
+	if election.UnfreezeAt, err = tvm.Int64(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid unfreeze time: %v", err)
+	}
+	if election.StakeHeld, err = tvm.Int64(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid stake held period: %v", err)
+	}
+	vsetHash, err := tvm.Number(fields[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid vset hash: %v", err)
+	}
+	election.VsetHash = uint256Bytes(vsetHash)
+	election.Frozen = map[string]FrozenStake{}
+	if !tvm.IsNull(fields[4]) {
+		frozen, err := tvm.Cell(fields[4])
+		if err != nil {
+			return nil, fmt.Errorf("invalid frozen stakes: %v", err)
+		}
+		if election.Frozen, err = ParseFrozen(frozen); err != nil {
+			return nil, fmt.Errorf("invalid frozen stakes: %v", err)
+		}
+	}
+	if election.TotalStake, err = tvm.Number(fields[5]); err != nil {
+		return nil, fmt.Errorf("invalid total stake: %v", err)
+	}
+	if election.Bonuses, err = tvm.Number(fields[6]); err != nil {
+		return nil, fmt.Errorf("invalid bonuses: %v", err)
+	}
+	return &election, nil
+}
+
+// ParseFrozen decodes frozen stakes dictionary: pubkey:uint256 -> addr:bits256 weight:uint64 stake:Grams banned:Bool
+func ParseFrozen(root *cell.Cell) (map[string]FrozenStake, error) {
+	entries, err := cell.ParseDict(root, 256)
+	if err != nil {
+		return nil, err
+	}
+	frozen := make(map[string]FrozenStake, len(entries))
+	for _, entry := range entries {
+		var stake FrozenStake
+		if stake.Wallet, err = entry.Value.LoadBytes(32); err != nil {
+			return nil, err
+		}
+		if stake.Weight, err = entry.Value.LoadUInt(64); err != nil {
+			return nil, err
+		}
+		if stake.Stake, err = entry.Value.LoadCoins(); err != nil {
+			return nil, err
+		}
+		if stake.Banned, err = entry.Value.LoadBit(); err != nil {
+			return nil, err
+		}
+		frozen[hex.EncodeToString(uint256Bytes(entry.Key))] = stake
+	}
+	return frozen, nil
+}
+
+// Complaint is an element of complaints: a complaint against a validator with the votes on it
+type Complaint struct {
+	Hash              []byte
+	ValidatorPubkey   []byte
+	Description       *cell.Cell
+	CreatedAt         int64
+	Severity          int64
+	RewardAddress     []byte
+	Paid              *big.Int
+	SuggestedFine     *big.Int
+	SuggestedFinePart int64
+	// Voters are indexes of validators that voted for the complaint
+	Voters          []int64
+	VsetID          []byte
+	WeightRemaining int64
+}
+
+// ParseComplaints decodes complaints stack: a list of
+// [hash, [[pubkey, description, created_at, severity, reward_addr, paid, suggested_fine, suggested_fine_part], voters, vset_id, weight_remaining]]
+func ParseComplaints(stack []interface{}) ([]Complaint, error) {
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%s returned %d values, expected 1", ComplaintsMethod, len(stack))
+	}
+	if tvm.IsNull(stack[0]) {
+		return nil, nil
+	}
+	items, err := tvm.List(stack[0])
+	if err != nil {
+		return nil, err
+	}
+	complaints := make([]Complaint, 0, len(items))
+	for _, item := range items {
+		complaint, err := parseComplaint(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid complaint: %v", err)
+		}
+		complaints = append(complaints, *complaint)
+	}
+	return complaints, nil
+}
+
+func parseComplaint(item interface{}) (*Complaint, error) {
+	pair, err := tvm.Tuple(item)
+	if err != nil {
+		return nil, err
+	}
+	if len(pair) != 2 {
+		return nil, fmt.Errorf("%d fields, expected 2", len(pair))
+	}
+	var complaint Complaint
+	hash, err := tvm.Number(pair[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %v", err)
+	}
+	complaint.Hash = uint256Bytes(hash)
+	status, err := tvm.Tuple(pair[1])
+	if err != nil {
+		return nil, err
+	}
+	if len(status) != 4 {
+		return nil, fmt.Errorf("%d status fields, expected 4", len(status))
+	}
+	fields, err := tvm.Tuple(status[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 8 {
+		return nil, fmt.Errorf("%d complaint fields, expected 8", len(fields))
+	}
+	numbers := make([]*big.Int, len(fields))
+	for i, field := range fields {
+		if i == 1 {
+			continue
+		}
+		if numbers[i], err = tvm.Number(field); err != nil {
+			return nil, fmt.Errorf("invalid complaint field %d: %v", i, err)
+		}
+	}
+	complaint.ValidatorPubkey = uint256Bytes(numbers[0])
+	if complaint.Description, err = tvm.Cell(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid description: %v", err)
+	}
+	complaint.CreatedAt = numbers[2].Int64()
+	complaint.Severity = numbers[3].Int64()
+	complaint.RewardAddress = uint256Bytes(numbers[4])
+	complaint.Paid = numbers[5]
+	complaint.SuggestedFine = numbers[6]
+	complaint.SuggestedFinePart = numbers[7].Int64()
+
+	if !tvm.IsNull(status[1]) {
+		voters, err := tvm.List(status[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid voters: %v", err)
+		}
+		for _, voter := range voters {
+			index, err := tvm.Int64(voter)
+			if err != nil {
+				return nil, fmt.Errorf("invalid voter: %v", err)
+			}
+			complaint.Voters = append(complaint.Voters, index)
+		}
+	}
+	vsetID, err := tvm.Number(status[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid vset id: %v", err)
+	}
+	complaint.VsetID = uint256Bytes(vsetID)
+	if complaint.WeightRemaining, err = tvm.Int64(status[3]); err != nil {
+		return nil, fmt.Errorf("invalid remaining weight: %v", err)
+	}
+	return &complaint, nil
+}
+
+// StakeRecord is the validator's participation in a past election
+type StakeRecord struct {
+	ElectionID int64
+	UnfreezeAt int64
+	Stake      *big.Int
+	Reward     *big.Int
+	Weight     uint64
+	Banned     bool
+}
+
+// History returns the validator's records of the elections it took part in, in the order of elections
+func History(elections []PastElection, pubkey ed25519.PublicKey) []StakeRecord {
+	key := hex.EncodeToString(pubkey)
+	var history []StakeRecord
+	for _, election := range elections {
+		frozen, ok := election.Frozen[key]
+		if !ok {
+			continue
+		}
+		history = append(history, StakeRecord{
+			ElectionID: election.ID,
+			UnfreezeAt: election.UnfreezeAt,
+			Stake:      frozen.Stake,
+			Reward:     election.Reward(pubkey),
+			Weight:     frozen.Weight,
+			Banned:     frozen.Banned,
+		})
+	}
+	return history
+}
+
+// uint256Bytes returns 32 bytes big endian form of a non-negative number
+func uint256Bytes(number *big.Int) []byte {
+	data := number.Bytes()
+	if len(data) >= 32 {
+		return data[len(data)-32:]
+	}
+	return append(make([]byte, 32-len(data)), data...)
+}
@@ -0,0 +1,135 @@
+package elector
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+func list(items ...tvm.Entry) tvm.ListEntry {
+	return tvm.ListEntry(items)
+}
+
+func tuple(items ...tvm.Entry) tvm.TupleEntry {
+	return tvm.TupleEntry(items)
+}
+
+func number(n int64) tvm.Entry {
+	return tvm.NumberEntry(big.NewInt(n))
+}
+
+func TestNewStake(t *testing.T) {
+	seed := bytes.Repeat([]byte{1}, ed25519.SeedSize)
+	validator := ed25519.NewKeyFromSeed(seed)
+	wallet := address.MustParse("Ef8zMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzMzM0vF")
+	maxFactor, err := MaxFactor(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stake := NewStake{
+		QueryID:         1,
+		ValidatorPubkey: validator.Public().(ed25519.PublicKey),
+		StakeAt:         1600000000,
+		MaxFactor:       maxFactor,
+		AdnlAddress:     bytes.Repeat([]byte{2}, 32),
+	}
+	payload, err := stake.SignedPayload(wallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(payload[:12]) != "654c50745f5e100000030000" || len(payload) != 76 ||
+		!bytes.Equal(payload[12:44], wallet.Hash[:]) {
+		t.Fatalf("unexpected payload: %x", payload)
+	}
+	signature := ed25519.Sign(validator, payload)
+	body, err := stake.Body(signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := body.BeginParse()
+	op, _ := s.LoadUInt(32)
+	queryID, _ := s.LoadUInt(64)
+	pubkey, _ := s.LoadBytes(32)
+	stakeAt, _ := s.LoadUInt(32)
+	factor, _ := s.LoadUInt(32)
+	adnl, _ := s.LoadBytes(32)
+	sig, err := s.LoadRef()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op != OpNewStake || queryID != 1 || !bytes.Equal(pubkey, stake.ValidatorPubkey) || stakeAt != 1600000000 ||
+		factor != 3*MaxFactorOne || !bytes.Equal(adnl, stake.AdnlAddress) || !bytes.Equal(sig.Data(), signature) {
+		t.Fatalf("unexpected body: %v", body)
+	}
+
+	if _, err := stake.Body(signature[:10]); err == nil {
+		t.Fatal("expected error for short signature")
+	}
+	if _, err := MaxFactor(0.5); err == nil {
+		t.Fatal("expected error for max factor below 1")
+	}
+}
+
+func TestParsePastElections(t *testing.T) {
+	pubkey := ed25519.PublicKey(bytes.Repeat([]byte{3}, 32))
+	value := cell.NewBuilder()
+	checks := []error{
+		value.StoreBytes(bytes.Repeat([]byte{4}, 32)),
+		value.StoreUInt(1000, 64),
+		value.StoreCoins(300),
+		value.StoreBit(false),
+	}
+	for _, err := range checks {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	frozen, err := cell.BuildDict([]cell.DictEntry{{Key: new(big.Int).SetBytes(pubkey), Value: value.EndCell().BeginParse()}}, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stack := []interface{}{list(
+		tuple(number(100), number(200), number(50), number(7), tvm.CellEntry(frozen), number(1000), number(90), list()),
+		tuple(number(300), number(400), number(50), number(8), list(), number(0), number(0), list()),
+	)}
+	elections, err := ParsePastElections(stack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elections) != 2 || elections[0].ID != 100 || elections[0].VsetHash[31] != 7 || len(elections[1].Frozen) != 0 {
+		t.Fatalf("unexpected elections: %#v", elections)
+	}
+	history := History(elections, pubkey)
+	if len(history) != 1 || history[0].ElectionID != 100 || history[0].Stake.Int64() != 300 ||
+		history[0].Reward.Int64() != 27 || history[0].Weight != 1000 {
+		t.Fatalf("unexpected history: %#v", history)
+	}
+}
+
+func TestParseComplaints(t *testing.T) {
+	description := cell.NewBuilder().EndCell()
+	complaint := tuple(number(11), tvm.CellEntry(description), number(12), number(2), number(13), number(0), number(500), number(1<<30))
+	stack := []interface{}{list(tuple(number(9), tuple(complaint, list(number(1), number(4)), number(10), number(77))))}
+	complaints, err := ParseComplaints(stack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(complaints) != 1 {
+		t.Fatalf("unexpected complaints: %#v", complaints)
+	}
+	c := complaints[0]
+	if c.Hash[31] != 9 || c.ValidatorPubkey[31] != 11 || c.CreatedAt != 12 || c.Severity != 2 || c.SuggestedFine.Int64() != 500 ||
+		len(c.Voters) != 2 || c.Voters[1] != 4 || c.VsetID[31] != 10 || c.WeightRemaining != 77 {
+		t.Fatalf("unexpected complaint: %#v", c)
+	}
+	if _, err := ParseComplaints(nil); err == nil {
+		t.Fatal("expected error for empty stack")
+	}
+}
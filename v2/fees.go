@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/config"
+	"github.com/mercuryoio/tonlib-go/v2/fees"
+)
+
+// FeeCalculator builds an offline fee calculator for the workchain from config params 18, 20/21 and 24/25
+// of the config contract at the last masterchain block
+func (client *Client) FeeCalculator(ctx context.Context, workchain int32) (*fees.Calculator, error) {
+	params, err := client.GetConfigParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newFeeCalculator(params, workchain)
+}
+
+func newFeeCalculator(params config.Params, workchain int32) (*fees.Calculator, error) {
+	masterchain := workchain == -1
+	gasParam, forwardParam := int32(config.ParamBasechainGas), int32(config.ParamBasechainForward)
+	if masterchain {
+		gasParam, forwardParam = config.ParamMasterchainGas, config.ParamMasterchainForward
+	}
+	gas, err := params.Get(gasParam)
+	if err != nil {
+		return nil, err
+	}
+	forward, err := params.Get(forwardParam)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := params.Get(config.ParamStoragePrices)
+	if err != nil {
+		return nil, err
+	}
+	return &fees.Calculator{
+		Gas:         *gas.(*config.GasPrices),
+		Forward:     *forward.(*config.MsgForwardPrices),
+		Storage:     storage.([]config.StoragePrices),
+		Masterchain: masterchain,
+	}, nil
+}
+
+// EstimateFees estimates fees of the query with tonlib and falls back to the offline calculator
+// when tonlib fails or times out. The offline estimate treats the query as a wallet transfer,
+// see fees.WalletTransaction, and has no destination fees
+func (client *Client) EstimateFees(info *QueryInfo, calculator *fees.Calculator, ignoreChksig bool) (*QueryFees, error) {
+	queryFees, err := client.QueryEstimateFees(info.Id, ignoreChksig)
+	if err == nil && queryFees != nil {
+		return queryFees, nil
+	}
+	offline, offlineErr := offlineQueryFees(info, calculator)
+	if offlineErr != nil {
+		return nil, fmt.Errorf("failed to estimate fees: %v, offline: %v", err, offlineErr)
+	}
+	return offline, nil
+}
+
+func offlineQueryFees(info *QueryInfo, calculator *fees.Calculator) (*QueryFees, error) {
+	body, err := optionalBOC(info.Body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query body: %v", err)
+	}
+	initState, err := optionalBOC(info.InitState)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query init state: %v", err)
+	}
+	computed, err := calculator.Fees(fees.WalletTransaction(body, initState))
+	if err != nil {
+		return nil, err
+	}
+	source := NewFees(int64(computed.FwdFee), int64(computed.GasFee), int64(computed.InFwdFee), int64(computed.StorageFee))
+	return NewQueryFees([]Fees{}, source), nil
+}
+
+func optionalBOC(data Bytes) (*cell.Cell, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return cell.FromBOC(data)
+}
@@ -0,0 +1,188 @@
+// Package fees computes transaction fees offline from the blockchain config prices:
+// gas fees (config params 20 and 21), forward fees (24 and 25) and storage fees (18).
+package fees
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/config"
+)
+
+// DefaultWalletGasUsed is gas a v3 wallet spends processing an external message with one transfer
+const DefaultWalletGasUsed = 3308
+
+// Size is amount of unique cells and their data bits
+type Size struct {
+	Cells uint64
+	Bits  uint64
+}
+
+// Add returns sum of the sizes
+func (size Size) Add(other Size) Size {
+	return Size{Cells: size.Cells + other.Cells, Bits: size.Bits + other.Bits}
+}
+
+// CellSize counts unique cells of the tree, including the root
+func CellSize(root *cell.Cell) Size {
+	var size Size
+	seen := map[string]bool{}
+	var walk func(c *cell.Cell)
+	walk = func(c *cell.Cell) {
+		hash := string(c.Hash())
+		if seen[hash] {
+			return
+		}
+		seen[hash] = true
+		size.Cells++
+		size.Bits += uint64(c.BitsLen())
+		for _, ref := range c.Refs() {
+			walk(ref)
+		}
+	}
+	walk(root)
+	return size
+}
+
+// MessageSize counts cells of the message the way forward fees are charged: without the root cell
+func MessageSize(msg *cell.Cell) Size {
+	size := CellSize(msg)
+	size.Cells--
+	size.Bits -= uint64(msg.BitsLen())
+	return size
+}
+
+// Fees are computed fees in nanograms, the fields match tonlib's fees
+type Fees struct {
+	InFwdFee   uint64
+	StorageFee uint64
+	GasFee     uint64
+	FwdFee     uint64
+}
+
+// Total returns sum of all fees
+func (fees Fees) Total() uint64 {
+	return fees.InFwdFee + fees.StorageFee + fees.GasFee + fees.FwdFee
+}
+
+// Transaction describes what the fees are charged for
+type Transaction struct {
+	// InMsg is the size of the inbound external message without its root cell, zero for internal messages
+	InMsg   Size
+	GasUsed uint64
+	// OutMsgs are sizes of the outbound messages without their root cells
+	OutMsgs []Size
+	// Account is the size of the account state, storage is charged for it from LastPaid till Now
+	Account  Size
+	LastPaid uint32
+	Now      uint32
+}
+
+// Calculator computes fees of a workchain with fixed prices
+type Calculator struct {
+	Gas     config.GasPrices
+	Forward config.MsgForwardPrices
+	// Storage prices sorted by UtimeSince, as in config param 18
+	Storage     []config.StoragePrices
+	Masterchain bool
+}
+
+// GasFee returns fee for the gas used: flat price for the first FlatGasLimit gas and GasPrice per 2^16 units for the rest
+func (calc *Calculator) GasFee(gasUsed uint64) uint64 {
+	prices := calc.Gas
+	if gasUsed <= prices.FlatGasLimit {
+		return prices.FlatGasPrice
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(prices.GasPrice), new(big.Int).SetUint64(gasUsed-prices.FlatGasLimit))
+	return prices.FlatGasPrice + shiftCeil(fee)
+}
+
+// ForwardFee returns fee for forwarding of a message of the size without its root cell
+func (calc *Calculator) ForwardFee(size Size) uint64 {
+	prices := calc.Forward
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(prices.BitPrice), new(big.Int).SetUint64(size.Bits))
+	fee.Add(fee, new(big.Int).Mul(new(big.Int).SetUint64(prices.CellPrice), new(big.Int).SetUint64(size.Cells)))
+	return prices.LumpPrice + shiftCeil(fee)
+}
+
+// ActionFee returns the part of the forward fee that is taken by validators at once, the rest is paid when the message is delivered
+func (calc *Calculator) ActionFee(fwdFee uint64) uint64 {
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(fwdFee), big.NewInt(int64(calc.Forward.FirstFrac)))
+	return fee.Rsh(fee, 16).Uint64()
+}
+
+// StorageFee returns fee for keeping the account of the size between the times, each price applies from its UtimeSince
+func (calc *Calculator) StorageFee(size Size, from, till uint32) (uint64, error) {
+	if till <= from || len(calc.Storage) == 0 {
+		return 0, nil
+	}
+	if from < calc.Storage[0].UtimeSince {
+		from = calc.Storage[0].UtimeSince
+	}
+	total := new(big.Int)
+	for i, prices := range calc.Storage {
+		if i > 0 && prices.UtimeSince < calc.Storage[i-1].UtimeSince {
+			return 0, fmt.Errorf("storage prices are not sorted")
+		}
+		start, end := prices.UtimeSince, till
+		if i+1 < len(calc.Storage) && calc.Storage[i+1].UtimeSince < end {
+			end = calc.Storage[i+1].UtimeSince
+		}
+		if start < from {
+			start = from
+		}
+		if end <= start {
+			continue
+		}
+		bitPrice, cellPrice := prices.BitPricePs, prices.CellPricePs
+		if calc.Masterchain {
+			bitPrice, cellPrice = prices.McBitPricePs, prices.McCellPricePs
+		}
+		rate := new(big.Int).Mul(new(big.Int).SetUint64(bitPrice), new(big.Int).SetUint64(size.Bits))
+		rate.Add(rate, new(big.Int).Mul(new(big.Int).SetUint64(cellPrice), new(big.Int).SetUint64(size.Cells)))
+		total.Add(total, rate.Mul(rate, big.NewInt(int64(end-start))))
+	}
+	return shiftCeil(total), nil
+}
+
+// Fees computes all fees of the transaction
+func (calc *Calculator) Fees(tx Transaction) (*Fees, error) {
+	var fees Fees
+	if tx.InMsg != (Size{}) {
+		fees.InFwdFee = calc.ForwardFee(tx.InMsg)
+	}
+	storage, err := calc.StorageFee(tx.Account, tx.LastPaid, tx.Now)
+	if err != nil {
+		return nil, err
+	}
+	fees.StorageFee = storage
+	fees.GasFee = calc.GasFee(tx.GasUsed)
+	for _, msg := range tx.OutMsgs {
+		fees.FwdFee += calc.ForwardFee(msg)
+	}
+	return &fees, nil
+}
+
+// WalletTransaction describes an external message to a wallet with the body and optional init state:
+// the message keeps them in references and every reference of the body is an outbound message.
+// Storage is not included since the account state is unknown
+func WalletTransaction(body, initState *cell.Cell) Transaction {
+	tx := Transaction{GasUsed: DefaultWalletGasUsed}
+	if body != nil {
+		tx.InMsg = CellSize(body)
+		for _, msg := range body.Refs() {
+			tx.OutMsgs = append(tx.OutMsgs, MessageSize(msg))
+		}
+	}
+	if initState != nil {
+		tx.InMsg = tx.InMsg.Add(CellSize(initState))
+	}
+	return tx
+}
+
+// shiftCeil returns value / 2^16 rounded up
+func shiftCeil(value *big.Int) uint64 {
+	result := new(big.Int).Add(value, big.NewInt(0xffff))
+	return result.Rsh(result, 16).Uint64()
+}
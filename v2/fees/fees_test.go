@@ -0,0 +1,81 @@
+package fees
+
+import (
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/config"
+)
+
+// basechain prices of the main network
+var calculator = &Calculator{
+	Gas: config.GasPrices{FlatGasLimit: 100, FlatGasPrice: 40000, GasPrice: 26214400, GasLimit: 1000000},
+	Forward: config.MsgForwardPrices{
+		LumpPrice: 400000, BitPrice: 26214400, CellPrice: 2621440000, IhrPriceFactor: 98304, FirstFrac: 21845, NextFrac: 21845,
+	},
+	Storage: []config.StoragePrices{
+		{UtimeSince: 0, BitPricePs: 1, CellPricePs: 500, McBitPricePs: 1000, McCellPricePs: 500000},
+	},
+}
+
+func TestCalculatorFees(t *testing.T) {
+	if fee := calculator.GasFee(50); fee != 40000 {
+		t.Fatalf("unexpected flat gas fee: %d", fee)
+	}
+	if fee := calculator.GasFee(3308); fee != 1323200 {
+		t.Fatalf("unexpected gas fee: %d", fee)
+	}
+	if fee := calculator.ForwardFee(Size{Cells: 1, Bits: 100}); fee != 480000 {
+		t.Fatalf("unexpected forward fee: %d", fee)
+	}
+	if fee := calculator.ActionFee(480000); fee != 159997 {
+		t.Fatalf("unexpected action fee: %d", fee)
+	}
+
+	fees, err := calculator.Fees(Transaction{
+		InMsg:    Size{Cells: 1, Bits: 100},
+		GasUsed:  3308,
+		OutMsgs:  []Size{{}, {Cells: 1, Bits: 100}},
+		Account:  Size{Cells: 3, Bits: 1000},
+		LastPaid: 1000,
+		Now:      1000 + 65536,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Fees{InFwdFee: 480000, StorageFee: 2500, GasFee: 1323200, FwdFee: 400000 + 480000}
+	if *fees != expected || fees.Total() != 2685700 {
+		t.Fatalf("unexpected fees: %#v", fees)
+	}
+
+	mc := *calculator
+	mc.Masterchain = true
+	mc.Storage = append(mc.Storage, config.StoragePrices{UtimeSince: 2000, McBitPricePs: 2000, McCellPricePs: 1000000})
+	storage, err := mc.StorageFee(Size{Cells: 1, Bits: 0}, 1000, 3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1000 seconds at each price
+	if want := uint64((500000*1000 + 1000000*1000 + 0xffff) >> 16); storage != want {
+		t.Fatalf("unexpected masterchain storage fee: %d, expected %d", storage, want)
+	}
+}
+
+func TestWalletTransaction(t *testing.T) {
+	msg := cell.NewBuilder()
+	if err := msg.StoreUInt(0xdeadbeef, 32); err != nil {
+		t.Fatal(err)
+	}
+	msgCell := msg.EndCell()
+	body := cell.NewBuilder()
+	if err := body.StoreUInt(1, 64); err != nil {
+		t.Fatal(err)
+	}
+	if err := body.StoreRef(msgCell); err != nil {
+		t.Fatal(err)
+	}
+	tx := WalletTransaction(body.EndCell(), nil)
+	if tx.InMsg != (Size{Cells: 2, Bits: 96}) || len(tx.OutMsgs) != 1 || tx.OutMsgs[0] != (Size{}) || tx.GasUsed != DefaultWalletGasUsed {
+		t.Fatalf("unexpected transaction: %#v", tx)
+	}
+}
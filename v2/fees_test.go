@@ -0,0 +1,124 @@
+package v2
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/config"
+	"github.com/mercuryoio/tonlib-go/v2/fees"
+)
+
+func TestOfflineQueryFees(t *testing.T) {
+	calculator := &fees.Calculator{
+		Gas:     config.GasPrices{GasPrice: 65536},
+		Forward: config.MsgForwardPrices{LumpPrice: 1000, BitPrice: 65536, CellPrice: 65536},
+	}
+	body := cell.NewBuilder()
+	if err := body.StoreRef(cell.NewBuilder().EndCell()); err != nil {
+		t.Fatal(err)
+	}
+	if err := body.StoreUInt(0, 32); err != nil {
+		t.Fatal(err)
+	}
+	info := NewQueryInfo(body.EndCell().ToBOC(), nil, 1, nil, 0)
+	queryFees, err := offlineQueryFees(info, calculator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// body and its reference: 2 cells and 32 bits, one empty outbound message
+	source := queryFees.SourceFees
+	if source.InFwdFee != 1034 || source.GasFee != fees.DefaultWalletGasUsed || source.FwdFee != 1000 || len(queryFees.DestinationFees) != 0 {
+		t.Fatalf("unexpected fees: %#v", source)
+	}
+	if _, err := offlineQueryFees(NewQueryInfo(Bytes("invalid"), nil, 1, nil, 0), calculator); err == nil {
+		t.Fatal("expected error for invalid body")
+	}
+}
+
+// configData builds data of the config contract holding the param values
+func configData(t *testing.T, params map[int32]*cell.Cell) *cell.Cell {
+	var entries []cell.DictEntry
+	for n, value := range params {
+		entry := cell.NewBuilder()
+		if err := entry.StoreRef(value); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, cell.DictEntry{Key: big.NewInt(int64(n)), Value: entry.EndCell().BeginParse()})
+	}
+	dict, err := cell.BuildDict(entries, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := cell.NewBuilder()
+	if err := data.StoreRef(dict); err != nil {
+		t.Fatal(err)
+	}
+	return data.EndCell()
+}
+
+// fields builds a cell of the 8 bit tag and the values, sizes holds their sizes in bits
+func fields(t *testing.T, tag uint64, sizes []int, values ...uint64) *cell.Cell {
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(tag, 8); err != nil {
+		t.Fatal(err)
+	}
+	for i, value := range values {
+		if err := b.StoreUInt(value, sizes[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b.EndCell()
+}
+
+func TestFeeCalculator(t *testing.T) {
+	gasSizes, forwardSizes := []int{64, 64, 64, 64, 64, 64}, []int{64, 64, 64, 32, 16, 16}
+	storage := fields(t, 0xcc, []int{32, 64, 64, 64, 64}, 0, 1, 500, 1000, 500000)
+	storagePrices, err := cell.BuildDict([]cell.DictEntry{{Key: big.NewInt(0), Value: storage.BeginParse()}}, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	basechainGas := fields(t, 0xdd, gasSizes, 65536000, 1000000, 10000, 10000000, 100000000, 1000000000)
+	data := configData(t, map[int32]*cell.Cell{
+		config.ParamStoragePrices:      storagePrices,
+		config.ParamMasterchainGas:     fields(t, 0xdd, gasSizes, 655360000, 1000000, 10000, 10000000, 100000000, 1000000000),
+		config.ParamBasechainGas:       basechainGas,
+		config.ParamMasterchainForward: fields(t, 0xea, forwardSizes, 10000000, 655360000, 65536000000, 98304, 21845, 21845),
+		config.ParamBasechainForward:   fields(t, 0xea, forwardSizes, 1000000, 65536000, 6553600000, 98304, 21845, 21845),
+	})
+	client, fake := newFakeClient(func(req map[string]interface{}) string {
+		if req["@type"] != "raw.getAccountState" {
+			return `{"@type":"error","code":400,"message":"unexpected request"}`
+		}
+		return fmt.Sprintf(`{"@type":"raw.fullAccountState","balance":"0","code":"","data":"%s","frozen_hash":"","sync_utime":0}`,
+			base64.StdEncoding.EncodeToString(data.ToBOC()))
+	})
+
+	calculator, err := client.FeeCalculator(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.requests) != 1 || !strings.Contains(fake.requests[0], config.Address) {
+		t.Fatalf("unexpected requests: %v", fake.requests)
+	}
+	if calculator.Masterchain || calculator.GasFee(1000) != 1000000 || calculator.Forward.LumpPrice != 1000000 ||
+		len(calculator.Storage) != 1 || calculator.Storage[0].CellPricePs != 500 {
+		t.Fatalf("unexpected basechain calculator: %#v", calculator)
+	}
+	if calculator, err = client.FeeCalculator(context.Background(), -1); err != nil {
+		t.Fatal(err)
+	}
+	if !calculator.Masterchain || calculator.GasFee(1000) != 10000000 || calculator.Forward.LumpPrice != 10000000 {
+		t.Fatalf("unexpected masterchain calculator: %#v", calculator)
+	}
+
+	// forward prices are missing in the config
+	data = configData(t, map[int32]*cell.Cell{config.ParamStoragePrices: storagePrices, config.ParamBasechainGas: basechainGas})
+	if _, err := client.FeeCalculator(context.Background(), 0); err == nil || !strings.Contains(err.Error(), "param 25") {
+		t.Fatalf("expected error for missing forward prices, got %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package indexer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// FileStore appends blocks to a JSON lines file, one masterchain block with its transactions per line.
+// A line is written and synced at once, so a partial last line left by a crash is dropped on open
+type FileStore struct {
+	mu   sync.Mutex
+	file *os.File
+	// ids and offsets of the saved blocks by line
+	ids     []BlockID
+	offsets []int64
+	size    int64
+}
+
+// OpenFileStore opens or creates the file
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	store := &FileStore{file: file}
+	if err := store.load(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return store, nil
+}
+
+// load reads ids of the saved blocks and drops a partial last line
+func (store *FileStore) load() error {
+	reader := bufio.NewReader(store.file)
+	var offset int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(line) > 0 {
+				// the last block was not written completely
+				return store.truncate(offset)
+			}
+			store.size = offset
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var block struct {
+			ID BlockID `json:"id"`
+		}
+		if err := json.Unmarshal(line, &block); err != nil {
+			return fmt.Errorf("line %d: %v", len(store.ids)+1, err)
+		}
+		store.ids = append(store.ids, block.ID)
+		store.offsets = append(store.offsets, offset)
+		offset += int64(len(line))
+	}
+}
+
+// Last returns id of the last saved masterchain block
+func (store *FileStore) Last(ctx context.Context) (*BlockID, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return store.last(), nil
+}
+
+func (store *FileStore) last() *BlockID {
+	if len(store.ids) == 0 {
+		return nil
+	}
+	id := store.ids[len(store.ids)-1]
+	return &id
+}
+
+// Save appends the block line and syncs the file
+func (store *FileStore) Save(ctx context.Context, block *Block) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if err := checkNext(store.last(), block); err != nil {
+		return err
+	}
+	line, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if _, err := store.file.WriteAt(line, store.size); err != nil {
+		return err
+	}
+	if err := store.file.Sync(); err != nil {
+		return err
+	}
+	store.ids = append(store.ids, block.ID)
+	store.offsets = append(store.offsets, store.size)
+	store.size += int64(len(line))
+	return nil
+}
+
+// Rollback truncates the file before the first block with the seqno or greater
+func (store *FileStore) Rollback(ctx context.Context, seqno int32) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	n := rollbackIndex(len(store.ids), func(i int) int32 { return store.ids[i].Seqno }, seqno)
+	if n == len(store.ids) {
+		return nil
+	}
+	if err := store.truncate(store.offsets[n]); err != nil {
+		return err
+	}
+	store.ids = store.ids[:n]
+	store.offsets = store.offsets[:n]
+	return nil
+}
+
+func (store *FileStore) truncate(size int64) error {
+	if err := store.file.Truncate(size); err != nil {
+		return err
+	}
+	if err := store.file.Sync(); err != nil {
+		return err
+	}
+	store.size = size
+	return nil
+}
+
+// ReadBlocks reads all saved blocks from the file of the store
+func ReadBlocks(path string) ([]Block, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var blocks []Block
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var block Block
+		if err := json.Unmarshal(line, &block); err != nil {
+			return nil, fmt.Errorf("%s: line %d: %v", path, len(blocks)+1, err)
+		}
+		blocks = append(blocks, block)
+	}
+}
+
+// Close closes the file
+func (store *FileStore) Close() error {
+	return store.file.Close()
+}
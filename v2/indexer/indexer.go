@@ -0,0 +1,284 @@
+// Package indexer walks masterchain blocks forward from a checkpoint, expands the shard blocks
+// each of them references, fetches and decodes their transactions and writes them to a Store.
+// Every masterchain block is saved at once, so indexing resumes from the last saved block after a crash.
+// A block is indexed when it is Confirmations blocks behind the last one and it has to be the child
+// of the last saved block, otherwise the saved blocks are rolled back until the chain is continuous again.
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultMaxRollback  = 16
+)
+
+// Chain is the source of blocks, e.g. a liteserver client or a block explorer API. The bundled tonlib can't
+// read blocks, so the caller supplies it
+type Chain interface {
+	// LastMasterchainBlock returns the last masterchain block
+	LastMasterchainBlock(ctx context.Context) (*BlockID, error)
+	// LookupMasterchainBlock returns the masterchain block with the seqno
+	LookupMasterchainBlock(ctx context.Context, seqno int32) (*BlockID, error)
+	// PrevBlocks returns the parents of the block, there are two of them after a shard merge
+	PrevBlocks(ctx context.Context, id BlockID) ([]BlockID, error)
+	// Shards returns the shard blocks referenced by the masterchain block
+	Shards(ctx context.Context, masterchainBlock BlockID) ([]BlockID, error)
+	// Transactions returns all transactions of the block
+	Transactions(ctx context.Context, id BlockID) ([]BlockTransaction, error)
+}
+
+// Config of the indexer
+type Config struct {
+	// Workchains are indexed workchains, masterchain is address.MasterchainID, all workchains are indexed when it is empty
+	Workchains []int32
+	// StartSeqno is the masterchain block indexing starts from when the store is empty, it is greater than 1
+	StartSeqno int32
+	// Confirmations is the amount of masterchain blocks an indexed block is behind the last one
+	Confirmations int32
+	// PollInterval is the delay between checks for new blocks in Run, 5s by default
+	PollInterval time.Duration
+	// MaxRollback is the amount of saved blocks rolled back in a row before indexing fails, 16 by default
+	MaxRollback int
+}
+
+// Indexer writes blocks of the chain to the store
+type Indexer struct {
+	chain  Chain
+	store  Store
+	config Config
+
+	// parent is the last saved masterchain block or the block before StartSeqno,
+	// parentShards are the shard blocks it references, they are loaded lazily
+	parent       *BlockID
+	parentShards []BlockID
+	rollbacks    int
+}
+
+// New creates an indexer
+func New(chain Chain, store Store, config Config) (*Indexer, error) {
+	if config.StartSeqno < 2 {
+		return nil, fmt.Errorf("start seqno %d is not greater than 1", config.StartSeqno)
+	}
+	if config.Confirmations < 0 {
+		return nil, fmt.Errorf("confirmations %d are negative", config.Confirmations)
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.MaxRollback == 0 {
+		config.MaxRollback = defaultMaxRollback
+	}
+	return &Indexer{chain: chain, store: store, config: config}, nil
+}
+
+// Run indexes new blocks until ctx is done
+func (indexer *Indexer) Run(ctx context.Context) error {
+	for {
+		if _, err := indexer.Sync(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(indexer.config.PollInterval):
+		}
+	}
+}
+
+// Sync indexes the confirmed masterchain blocks after the last saved one and returns the amount of saved blocks
+func (indexer *Indexer) Sync(ctx context.Context) (int, error) {
+	last, err := indexer.chain.LastMasterchainBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	target := last.Seqno - indexer.config.Confirmations
+	saved := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return saved, err
+		}
+		if err := indexer.loadParent(ctx); err != nil {
+			return saved, err
+		}
+		seqno := indexer.parent.Seqno + 1
+		if seqno > target {
+			return saved, nil
+		}
+		ok, err := indexer.index(ctx, seqno)
+		if err != nil {
+			return saved, fmt.Errorf("masterchain block %d: %v", seqno, err)
+		}
+		if ok {
+			saved++
+		}
+	}
+}
+
+// loadParent resumes from the last saved block
+func (indexer *Indexer) loadParent(ctx context.Context) error {
+	if indexer.parent != nil {
+		return nil
+	}
+	last, err := indexer.store.Last(ctx)
+	if err != nil {
+		return err
+	}
+	if last == nil {
+		if last, err = indexer.chain.LookupMasterchainBlock(ctx, indexer.config.StartSeqno-1); err != nil {
+			return err
+		}
+	}
+	indexer.parent = last
+	indexer.parentShards = nil
+	return nil
+}
+
+// index saves the masterchain block, it returns false when the saved parent is not the parent of the block
+// and it was rolled back instead
+func (indexer *Indexer) index(ctx context.Context, seqno int32) (bool, error) {
+	id, err := indexer.chain.LookupMasterchainBlock(ctx, seqno)
+	if err != nil {
+		return false, err
+	}
+	prev, err := indexer.chain.PrevBlocks(ctx, *id)
+	if err != nil {
+		return false, err
+	}
+	if len(prev) != 1 || prev[0] != *indexer.parent {
+		return false, indexer.rollback(ctx)
+	}
+
+	if indexer.parentShards == nil {
+		if indexer.parentShards, err = indexer.chain.Shards(ctx, *indexer.parent); err != nil {
+			return false, err
+		}
+	}
+	shards, err := indexer.chain.Shards(ctx, *id)
+	if err != nil {
+		return false, err
+	}
+	shardBlocks, err := indexer.newShardBlocks(ctx, shards, indexer.parentShards)
+	if err != nil {
+		return false, err
+	}
+
+	block := &Block{ID: *id}
+	if indexer.indexed(address.MasterchainID) {
+		if err := indexer.addTransactions(ctx, block, *id); err != nil {
+			return false, err
+		}
+	}
+	for _, shardBlock := range shardBlocks {
+		block.Shards = append(block.Shards, shardBlock)
+		if indexer.indexed(shardBlock.Workchain) {
+			if err := indexer.addTransactions(ctx, block, shardBlock); err != nil {
+				return false, err
+			}
+		}
+	}
+	if err := indexer.store.Save(ctx, block); err != nil {
+		return false, err
+	}
+	indexer.parent = &block.ID
+	indexer.parentShards = shards
+	indexer.rollbacks = 0
+	return true, nil
+}
+
+// rollback removes the saved parent which is not on the chain of the liteserver
+func (indexer *Indexer) rollback(ctx context.Context) error {
+	last, err := indexer.store.Last(ctx)
+	if err != nil {
+		return err
+	}
+	if last == nil || *last != *indexer.parent {
+		return fmt.Errorf("block %s before the start seqno is not the parent", indexer.parent)
+	}
+	indexer.rollbacks++
+	if indexer.rollbacks > indexer.config.MaxRollback {
+		return fmt.Errorf("more than %d blocks are rolled back", indexer.config.MaxRollback)
+	}
+	if err := indexer.store.Rollback(ctx, last.Seqno); err != nil {
+		return err
+	}
+	indexer.parent = nil
+	return nil
+}
+
+// newShardBlocks walks back from the shard blocks referenced by the masterchain block
+// to the ones referenced by its parent and returns the blocks in between
+func (indexer *Indexer) newShardBlocks(ctx context.Context, shards, parentShards []BlockID) ([]BlockID, error) {
+	seen := map[BlockID]bool{}
+	// a shard block with seqno not greater than the ones of the parent shard blocks is not connected to them
+	minSeqno := map[int32]int32{}
+	for _, shard := range parentShards {
+		seen[shard] = true
+		if seqno, ok := minSeqno[shard.Workchain]; !ok || shard.Seqno < seqno {
+			minSeqno[shard.Workchain] = shard.Seqno
+		}
+	}
+	var blocks []BlockID
+	stack := append([]BlockID(nil), shards...)
+	for len(stack) > 0 {
+		shard := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[shard] {
+			continue
+		}
+		seen[shard] = true
+		if seqno, ok := minSeqno[shard.Workchain]; !ok || shard.Seqno <= seqno {
+			return nil, fmt.Errorf("shard block %s is not connected to the shard blocks of the parent", shard)
+		}
+		blocks = append(blocks, shard)
+		prev, err := indexer.chain.PrevBlocks(ctx, shard)
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, prev...)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].Workchain != blocks[j].Workchain {
+			return blocks[i].Workchain < blocks[j].Workchain
+		}
+		if blocks[i].Shard != blocks[j].Shard {
+			return uint64(blocks[i].Shard) < uint64(blocks[j].Shard)
+		}
+		return blocks[i].Seqno < blocks[j].Seqno
+	})
+	return blocks, nil
+}
+
+// addTransactions fetches and decodes transactions of the block
+func (indexer *Indexer) addTransactions(ctx context.Context, block *Block, id BlockID) error {
+	txs, err := indexer.chain.Transactions(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		transaction, err := decodeTransaction(id, tx)
+		if err != nil {
+			return err
+		}
+		block.Transactions = append(block.Transactions, *transaction)
+	}
+	return nil
+}
+
+func (indexer *Indexer) indexed(workchain int32) bool {
+	if len(indexer.config.Workchains) == 0 {
+		return true
+	}
+	for _, indexed := range indexer.config.Workchains {
+		if indexed == workchain {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package indexer
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	tonlib "github.com/mercuryoio/tonlib-go/v2"
+	"github.com/mercuryoio/tonlib-go/v2/address"
+)
+
+const shardAll = -1 << 63
+
+// fakeChain is a masterchain with one basechain shard, blocks are created with block
+type fakeChain struct {
+	last         int32
+	masterchain  map[int32]BlockID
+	prev         map[BlockID][]BlockID
+	shards       map[BlockID][]BlockID
+	transactions map[BlockID][]BlockTransaction
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{
+		masterchain:  map[int32]BlockID{},
+		prev:         map[BlockID][]BlockID{},
+		shards:       map[BlockID][]BlockID{},
+		transactions: map[BlockID][]BlockTransaction{},
+	}
+}
+
+// block creates a block with one transaction, fork makes a different block with the same seqno
+func (chain *fakeChain) block(workchain int32, seqno int32, fork string, prev ...BlockID) BlockID {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d:%d:%s", workchain, seqno, fork)))
+	id := NewBlockID(*tonlib.NewTonBlockIdExt(hash[:], hash[:], seqno, shardAll, workchain))
+	chain.prev[id] = prev
+	account := sha256.Sum256([]byte(fmt.Sprintf("account %d", seqno)))
+	accountAddress, _ := address.New(workchain, account[:])
+	chain.transactions[id] = []BlockTransaction{{
+		Account: accountAddress,
+		Transaction: &tonlib.RawTransaction{
+			Fee:           10,
+			TransactionId: tonlib.NewInternalTransactionId(hash[:], tonlib.JSONInt64(seqno)*1000),
+			InMsg: tonlib.NewRawMessage(nil, 0, tonlib.NewAccountAddress("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a"), 0, 0,
+				tonlib.NewMsgDataText(tonlib.Bytes("deposit")), nil, 100),
+			Utime: int64(seqno),
+		},
+	}}
+	return id
+}
+
+// masterchainBlock adds the masterchain block referencing the shard block
+func (chain *fakeChain) masterchainBlock(seqno int32, fork string, shard BlockID) {
+	var prev []BlockID
+	if parent, ok := chain.masterchain[seqno-1]; ok {
+		prev = append(prev, parent)
+	}
+	id := chain.block(-1, seqno, fork, prev...)
+	chain.masterchain[seqno] = id
+	chain.shards[id] = []BlockID{shard}
+	if seqno > chain.last {
+		chain.last = seqno
+	}
+}
+
+func (chain *fakeChain) LastMasterchainBlock(ctx context.Context) (*BlockID, error) {
+	last := chain.masterchain[chain.last]
+	return &last, nil
+}
+
+func (chain *fakeChain) LookupMasterchainBlock(ctx context.Context, seqno int32) (*BlockID, error) {
+	id, ok := chain.masterchain[seqno]
+	if !ok {
+		return nil, fmt.Errorf("block %d is not found", seqno)
+	}
+	return &id, nil
+}
+
+func (chain *fakeChain) PrevBlocks(ctx context.Context, id BlockID) ([]BlockID, error) {
+	prev, ok := chain.prev[id]
+	if !ok {
+		return nil, fmt.Errorf("block %s is not found", id)
+	}
+	return prev, nil
+}
+
+func (chain *fakeChain) Shards(ctx context.Context, id BlockID) ([]BlockID, error) {
+	return chain.shards[id], nil
+}
+
+func (chain *fakeChain) Transactions(ctx context.Context, id BlockID) ([]BlockTransaction, error) {
+	return chain.transactions[id], nil
+}
+
+// newTestChain creates masterchain blocks 1-5, shard blocks 10-14 are referenced by them as 10, 12, 12, 13, 14
+func newTestChain() *fakeChain {
+	chain := newFakeChain()
+	shard := chain.block(0, 10, "")
+	chain.masterchainBlock(1, "", shard)
+	shard = chain.block(0, 12, "", chain.block(0, 11, "", shard))
+	chain.masterchainBlock(2, "", shard)
+	chain.masterchainBlock(3, "", shard)
+	shard = chain.block(0, 13, "", shard)
+	chain.masterchainBlock(4, "", shard)
+	chain.masterchainBlock(5, "", chain.block(0, 14, "", shard))
+	return chain
+}
+
+func TestIndexerSync(t *testing.T) {
+	chain := newTestChain()
+	store := NewMemoryStore()
+	indexer, err := New(chain, store, Config{StartSeqno: 2, Confirmations: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, err := indexer.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := store.Blocks()
+	if saved != 3 || len(blocks) != 3 || blocks[2].ID.Seqno != 4 {
+		t.Fatalf("unexpected blocks: %d, %#v", saved, blocks)
+	}
+	if len(blocks[0].Shards) != 2 || blocks[0].Shards[0].Seqno != 11 || blocks[0].Shards[1].Seqno != 12 ||
+		len(blocks[1].Shards) != 0 || len(blocks[2].Shards) != 1 {
+		t.Fatalf("unexpected shard blocks: %#v", blocks)
+	}
+	// masterchain transaction and transactions of shard blocks 11 and 12
+	txs := blocks[0].Transactions
+	if len(txs) != 3 || txs[0].Block.Workchain != -1 || txs[2].Block.Seqno != 12 || txs[2].Lt != 12000 || txs[2].Fee != 10 {
+		t.Fatalf("unexpected transactions: %#v", txs)
+	}
+	if msg := txs[2].InMsg; msg == nil || msg.Comment != "deposit" || msg.Value != 100 || msg.Source != "" ||
+		msg.Destination != "0:df6198437cd27411e3e84f989b7c8f681bd6d6214ea0f3bda85f987dacbe4f1d" {
+		t.Fatalf("unexpected in msg: %#v", txs[2].InMsg)
+	}
+	if got := store.Transactions(txs[2].Account); len(got) != 1 || got[0].Hash != txs[2].Hash {
+		t.Fatalf("unexpected account transactions: %#v", got)
+	}
+
+	// a new indexer resumes from the store
+	chain.masterchainBlock(6, "", chain.block(0, 15, "", chain.prev[chain.masterchain[5]][0]))
+	indexer, _ = New(chain, store, Config{StartSeqno: 2, Confirmations: 1, Workchains: []int32{0}})
+	if saved, err := indexer.Sync(context.Background()); err != nil || saved != 1 {
+		t.Fatalf("unexpected sync: %d, %v", saved, err)
+	}
+	if block := store.Blocks()[3]; block.ID.Seqno != 5 || len(block.Transactions) != 1 || block.Transactions[0].Block.Workchain != 0 {
+		t.Fatalf("unexpected block: %#v", block)
+	}
+}
+
+func TestIndexerRollback(t *testing.T) {
+	chain := newTestChain()
+	store := NewMemoryStore()
+	indexer, _ := New(chain, store, Config{StartSeqno: 2})
+	if _, err := indexer.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the liteserver switches to another block 5
+	shard := chain.masterchain[4]
+	chain.masterchainBlock(5, "fork", chain.shards[shard][0])
+	chain.masterchainBlock(6, "fork", chain.shards[shard][0])
+	saved, err := indexer.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := store.Blocks()
+	if saved != 2 || len(blocks) != 5 || blocks[3].ID != chain.masterchain[5] || len(blocks[3].Shards) != 0 {
+		t.Fatalf("unexpected blocks after rollback: %d, %#v", saved, blocks)
+	}
+
+	// the chain is not continuous down to the start
+	chain = newTestChain()
+	indexer, _ = New(chain, NewMemoryStore(), Config{StartSeqno: 2, MaxRollback: 1})
+	chain.masterchain[2] = chain.block(-1, 2, "orphan")
+	if _, err := indexer.Sync(context.Background()); err == nil {
+		t.Fatal("expected error for block not connected to the start")
+	}
+}
+
+func TestIndexerConfirmations(t *testing.T) {
+	chain := newTestChain()
+	store := NewMemoryStore()
+	indexer, _ := New(chain, store, Config{StartSeqno: 2, Confirmations: 2})
+	if saved, err := indexer.Sync(context.Background()); err != nil || saved != 2 {
+		t.Fatalf("unexpected sync: %d, %v", saved, err)
+	}
+
+	// blocks 4 and 5 are replaced within the confirmations, the fork is indexed without rollbacks
+	shard := chain.shards[chain.masterchain[3]][0]
+	chain.masterchainBlock(4, "fork", shard)
+	chain.masterchainBlock(5, "fork", shard)
+	if saved, err := indexer.Sync(context.Background()); err != nil || saved != 0 {
+		t.Fatalf("unexpected sync of the fork: %d, %v", saved, err)
+	}
+	chain.masterchainBlock(6, "fork", shard)
+	if saved, err := indexer.Sync(context.Background()); err != nil || saved != 1 {
+		t.Fatalf("unexpected sync after the fork: %d, %v", saved, err)
+	}
+	blocks := store.Blocks()
+	if len(blocks) != 3 || blocks[2].ID != chain.masterchain[4] || indexer.rollbacks != 0 {
+		t.Fatalf("unexpected blocks: %#v", blocks)
+	}
+}
+
+func TestIndexerResume(t *testing.T) {
+	chain := newTestChain()
+	path := filepath.Join(t.TempDir(), "blocks.jsonl")
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexer, _ := New(chain, store, Config{StartSeqno: 2, Confirmations: 1})
+	if saved, err := indexer.Sync(context.Background()); err != nil || saved != 3 {
+		t.Fatalf("unexpected sync: %d, %v", saved, err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the checkpoint is the last saved block, blocks before it are not read again
+	delete(chain.masterchain, 1)
+	delete(chain.masterchain, 2)
+	chain.masterchainBlock(6, "", chain.shards[chain.masterchain[5]][0])
+	if store, err = OpenFileStore(path); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	indexer, _ = New(chain, store, Config{StartSeqno: 2, Confirmations: 1})
+	if saved, err := indexer.Sync(context.Background()); err != nil || saved != 1 {
+		t.Fatalf("unexpected sync after reopening: %d, %v", saved, err)
+	}
+	blocks, err := ReadBlocks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 4 || blocks[3].ID != chain.masterchain[5] || len(blocks[3].Shards) != 1 || blocks[3].Shards[0].Seqno != 14 {
+		t.Fatalf("unexpected blocks: %#v", blocks)
+	}
+}
+
+func TestNewIndexerConfig(t *testing.T) {
+	if _, err := New(newFakeChain(), NewMemoryStore(), Config{StartSeqno: 1}); err == nil {
+		t.Fatal("expected error for start seqno")
+	}
+	if _, err := New(newFakeChain(), NewMemoryStore(), Config{StartSeqno: 2, Confirmations: -1}); err == nil {
+		t.Fatal("expected error for confirmations")
+	}
+}
@@ -0,0 +1,168 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	tonlib "github.com/mercuryoio/tonlib-go/v2"
+	"github.com/mercuryoio/tonlib-go/v2/address"
+)
+
+// BlockID identifies a block, hashes are hex
+type BlockID struct {
+	Workchain int32  `json:"workchain"`
+	Shard     int64  `json:"shard"`
+	Seqno     int32  `json:"seqno"`
+	RootHash  string `json:"root_hash"`
+	FileHash  string `json:"file_hash"`
+}
+
+// NewBlockID converts tonlib block id
+func NewBlockID(id tonlib.TonBlockIdExt) BlockID {
+	return BlockID{
+		Workchain: id.Workchain,
+		Shard:     int64(id.Shard),
+		Seqno:     id.Seqno,
+		RootHash:  id.RootHash.Hex(),
+		FileHash:  id.FileHash.Hex(),
+	}
+}
+
+// Tonlib converts the id back to tonlib block id, e.g. to read state at the block with Client.AtBlock
+func (id BlockID) Tonlib() (*tonlib.TonBlockIdExt, error) {
+	rootHash, err := tonlib.BytesFromHex(id.RootHash)
+	if err != nil {
+		return nil, fmt.Errorf("block %s: root hash: %v", id, err)
+	}
+	fileHash, err := tonlib.BytesFromHex(id.FileHash)
+	if err != nil {
+		return nil, fmt.Errorf("block %s: file hash: %v", id, err)
+	}
+	return tonlib.NewTonBlockIdExt(fileHash, rootHash, id.Seqno, tonlib.JSONInt64(id.Shard), id.Workchain), nil
+}
+
+// String returns the id in workchain:shard:seqno form, shard is hex
+func (id BlockID) String() string {
+	return fmt.Sprintf("%d:%016x:%d", id.Workchain, uint64(id.Shard), id.Seqno)
+}
+
+// Block is an indexed masterchain block with the shard blocks first referenced by it
+// and transactions of the indexed workchains
+type Block struct {
+	ID           BlockID       `json:"id"`
+	Shards       []BlockID     `json:"shards,omitempty"`
+	Transactions []Transaction `json:"transactions,omitempty"`
+}
+
+// Transaction is a decoded transaction, addresses are in raw workchain:hex form
+type Transaction struct {
+	Block      BlockID   `json:"block"`
+	Account    string    `json:"account"`
+	Lt         int64     `json:"lt"`
+	Hash       string    `json:"hash"`
+	Utime      int64     `json:"utime"`
+	Fee        int64     `json:"fee"`
+	StorageFee int64     `json:"storage_fee"`
+	OtherFee   int64     `json:"other_fee"`
+	InMsg      *Message  `json:"in_msg,omitempty"`
+	OutMsgs    []Message `json:"out_msgs,omitempty"`
+}
+
+// Message is a decoded in or out message of a transaction. Source of external inbound messages
+// and destination of external outbound ones are empty
+type Message struct {
+	Source      string `json:"source,omitempty"`
+	Destination string `json:"destination,omitempty"`
+	Value       int64  `json:"value"`
+	FwdFee      int64  `json:"fwd_fee"`
+	IhrFee      int64  `json:"ihr_fee"`
+	CreatedLt   int64  `json:"created_lt"`
+	BodyHash    string `json:"body_hash"`
+	// Body is BOC of the raw body or the encrypted comment
+	Body []byte `json:"body,omitempty"`
+	// Comment is the text of plain text messages
+	Comment   string `json:"comment,omitempty"`
+	Encrypted bool   `json:"encrypted,omitempty"`
+}
+
+// BlockTransaction is a transaction of a block read from the chain
+type BlockTransaction struct {
+	Account     *address.Address
+	Transaction *tonlib.RawTransaction
+}
+
+// decodeTransaction converts the transaction of the account of the block
+func decodeTransaction(block BlockID, tx BlockTransaction) (*Transaction, error) {
+	raw := tx.Transaction
+	if tx.Account == nil || raw == nil || raw.TransactionId == nil {
+		return nil, fmt.Errorf("transaction of block %s without account or id", block)
+	}
+	transaction := &Transaction{
+		Block:      block,
+		Account:    tx.Account.Raw(),
+		Lt:         int64(raw.TransactionId.Lt),
+		Hash:       raw.TransactionId.Hash.Hex(),
+		Utime:      raw.Utime,
+		Fee:        int64(raw.Fee),
+		StorageFee: int64(raw.StorageFee),
+		OtherFee:   int64(raw.OtherFee),
+	}
+	if raw.InMsg != nil {
+		var err error
+		if transaction.InMsg, err = decodeMessage(raw.InMsg); err != nil {
+			return nil, fmt.Errorf("transaction %d of %s: in msg: %v", transaction.Lt, transaction.Account, err)
+		}
+	}
+	for i := range raw.OutMsgs {
+		msg, err := decodeMessage(&raw.OutMsgs[i])
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d of %s: out msg %d: %v", transaction.Lt, transaction.Account, i, err)
+		}
+		transaction.OutMsgs = append(transaction.OutMsgs, *msg)
+	}
+	return transaction, nil
+}
+
+func decodeMessage(msg *tonlib.RawMessage) (*Message, error) {
+	source, err := rawAddress(msg.Source)
+	if err != nil {
+		return nil, fmt.Errorf("source: %v", err)
+	}
+	destination, err := rawAddress(msg.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("destination: %v", err)
+	}
+	message := &Message{
+		Source:      source,
+		Destination: destination,
+		Value:       int64(msg.Value),
+		FwdFee:      int64(msg.FwdFee),
+		IhrFee:      int64(msg.IhrFee),
+		CreatedLt:   int64(msg.CreatedLt),
+		BodyHash:    hex.EncodeToString(msg.BodyHash),
+	}
+	switch data := msg.MsgData.(type) {
+	case *tonlib.MsgDataRaw:
+		message.Body = data.Body
+	case *tonlib.MsgDataText:
+		message.Comment = string(data.Text)
+	case *tonlib.MsgDataDecryptedText:
+		message.Comment = string(data.Text)
+	case *tonlib.MsgDataEncryptedText:
+		message.Body = data.Text
+		message.Encrypted = true
+	}
+	return message, nil
+}
+
+// rawAddress converts tonlib address to raw form, empty address is kept
+func rawAddress(addr *tonlib.AccountAddress) (string, error) {
+	if addr == nil || addr.AccountAddress == "" {
+		return "", nil
+	}
+	parsed, err := address.Parse(addr.AccountAddress)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Raw(), nil
+}
@@ -0,0 +1,101 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Store keeps indexed masterchain blocks in seqno order. Save and Rollback have to be atomic:
+// the indexer resumes from the last saved block after a crash
+type Store interface {
+	// Last returns id of the last saved masterchain block, nil when the store is empty
+	Last(ctx context.Context) (*BlockID, error)
+	// Save appends the masterchain block with its shard blocks and transactions
+	Save(ctx context.Context, block *Block) error
+	// Rollback removes the saved masterchain blocks from the seqno on
+	Rollback(ctx context.Context, seqno int32) error
+}
+
+// MemoryStore keeps blocks in memory, e.g. for tests or short-lived scans
+type MemoryStore struct {
+	mu     sync.Mutex
+	blocks []Block
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Last returns id of the last saved masterchain block
+func (store *MemoryStore) Last(ctx context.Context) (*BlockID, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if len(store.blocks) == 0 {
+		return nil, nil
+	}
+	id := store.blocks[len(store.blocks)-1].ID
+	return &id, nil
+}
+
+// Save appends the block
+func (store *MemoryStore) Save(ctx context.Context, block *Block) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	var last *BlockID
+	if len(store.blocks) > 0 {
+		last = &store.blocks[len(store.blocks)-1].ID
+	}
+	if err := checkNext(last, block); err != nil {
+		return err
+	}
+	store.blocks = append(store.blocks, *block)
+	return nil
+}
+
+// Rollback removes the blocks from the seqno on
+func (store *MemoryStore) Rollback(ctx context.Context, seqno int32) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.blocks = store.blocks[:rollbackIndex(len(store.blocks), func(i int) int32 { return store.blocks[i].ID.Seqno }, seqno)]
+	return nil
+}
+
+// Blocks returns the saved blocks
+func (store *MemoryStore) Blocks() []Block {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return append([]Block(nil), store.blocks...)
+}
+
+// Transactions returns the saved transactions of the account in raw form
+func (store *MemoryStore) Transactions(account string) []Transaction {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	var txs []Transaction
+	for _, block := range store.blocks {
+		for _, tx := range block.Transactions {
+			if tx.Account == account {
+				txs = append(txs, tx)
+			}
+		}
+	}
+	return txs
+}
+
+// checkNext checks blocks are saved in seqno order
+func checkNext(last *BlockID, block *Block) error {
+	if last != nil && block.ID.Seqno <= last.Seqno {
+		return fmt.Errorf("block %s is saved after block %s", block.ID, last)
+	}
+	return nil
+}
+
+// rollbackIndex returns the amount of blocks left after rollback to the seqno
+func rollbackIndex(n int, seqnoAt func(i int) int32, seqno int32) int {
+	for n > 0 && seqnoAt(n-1) >= seqno {
+		n--
+	}
+	return n
+}
@@ -0,0 +1,94 @@
+package indexer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testBlock(seqno int32) *Block {
+	id := BlockID{Workchain: -1, Shard: -1 << 63, Seqno: seqno, RootHash: "0102", FileHash: "0304"}
+	return &Block{ID: id, Transactions: []Transaction{{Block: id, Account: "0:00", Lt: int64(seqno) * 1000,
+		InMsg: &Message{Value: 100, Comment: "deposit"}}}}
+}
+
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	if last, err := store.Last(ctx); err != nil || last != nil {
+		t.Fatalf("expected empty store, got %v, %v", last, err)
+	}
+	for seqno := int32(1); seqno <= 3; seqno++ {
+		if err := store.Save(ctx, testBlock(seqno)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Save(ctx, testBlock(2)); err == nil {
+		t.Fatal("expected error for block saved out of order")
+	}
+	if err := store.Rollback(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	if last, err := store.Last(ctx); err != nil || last.Seqno != 1 {
+		t.Fatalf("unexpected last block after rollback: %v, %v", last, err)
+	}
+	if err := store.Save(ctx, testBlock(2)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	testStore(t, store)
+	if blocks := store.Blocks(); len(blocks) != 2 || blocks[1].ID.Seqno != 2 {
+		t.Fatalf("unexpected blocks: %#v", blocks)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocks.jsonl")
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, store)
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a crash leaves a partial line
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteString(`{"id":{"workchain":-1,"seqno":3`); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if last, err := store.Last(context.Background()); err != nil || last.Seqno != 2 {
+		t.Fatalf("unexpected last block after reopen: %v, %v", last, err)
+	}
+	if err := store.Save(context.Background(), testBlock(3)); err != nil {
+		t.Fatal(err)
+	}
+	blocks, err := ReadBlocks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 3 || blocks[2].ID.Seqno != 3 || blocks[2].Transactions[0].InMsg.Comment != "deposit" {
+		t.Fatalf("unexpected blocks: %#v", blocks)
+	}
+
+	if err := os.WriteFile(path, []byte("{}\nnot json\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenFileStore(path); err == nil {
+		t.Fatal("expected error for broken line")
+	}
+}
@@ -0,0 +1,90 @@
+// Package lru implements a small LRU cache with per entry expiration.
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// Cache keeps up to size entries, evicting the least recently used one. It is safe for concurrent use
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	items   map[string]*list.Element
+	order   *list.List
+	nowFunc func() time.Time
+}
+
+// New creates cache for size entries
+func New(size int) *Cache {
+	if size <= 0 {
+		size = 1
+	}
+	return &Cache{
+		size:    size,
+		items:   map[string]*list.Element{},
+		order:   list.New(),
+		nowFunc: time.Now,
+	}
+}
+
+// Get returns value if it is present and not expired
+func (c *Cache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	e := elem.Value.(*entry)
+	if !c.nowFunc().Before(e.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return e.value, true
+}
+
+// Add stores value for ttl
+func (c *Cache) Add(key string, value interface{}, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := c.nowFunc().Add(ttl)
+	if elem, ok := c.items[key]; ok {
+		e := elem.Value.(*entry)
+		e.value, e.expiresAt = value, expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.order.PushFront(&entry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry).key)
+	}
+}
+
+// Remove drops the key
+func (c *Cache) Remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the amount of stored entries, including expired ones not evicted yet
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,42 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	c := New(2)
+	c.nowFunc = func() time.Time { return now }
+
+	c.Add("a", 1, time.Minute)
+	c.Add("b", 2, time.Minute)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("unexpected value for a: %v %v", v, ok)
+	}
+	// b is the least recently used now
+	c.Add("c", 3, time.Minute)
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("b must be evicted")
+	}
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Fatalf("unexpected value for c: %v %v", v, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("a must be expired")
+	}
+	if c.Len() != 1 {
+		t.Fatalf("unexpected length: %d", c.Len())
+	}
+	c.Add("c", 4, time.Minute)
+	if v, _ := c.Get("c"); v != 4 {
+		t.Fatalf("unexpected value for c: %v", v)
+	}
+	c.Remove("c")
+	if c.Len() != 0 {
+		t.Fatalf("unexpected length: %d", c.Len())
+	}
+}
@@ -0,0 +1,67 @@
+// Package redact masks secrets in tonlib JSON traffic before it is written to logs.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Placeholder replaces every secret value
+const Placeholder = "[REDACTED]"
+
+// Fields are the tonlib_api fields holding keys, passwords or key material
+var Fields = map[string]bool{
+	"secret":             true,
+	"local_password":     true,
+	"new_local_password": true,
+	"mnemonic_password":  true,
+	"random_extra_seed":  true,
+	"key_password":       true,
+	"word_list":          true,
+	"pem":                true,
+}
+
+// JSON returns a copy of the document with values of secret fields replaced by Placeholder
+// at any depth. Data that is not valid JSON is not echoed, only its length is reported
+func JSON(data []byte) []byte {
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return []byte(fmt.Sprintf("<%d bytes of non-json data>", len(data)))
+	}
+	masked, err := json.Marshal(mask(doc))
+	if err != nil {
+		return []byte(fmt.Sprintf("<%d bytes of unprintable data>", len(data)))
+	}
+	return masked
+}
+
+// Value masks secret fields in an already decoded document, like a TONResponse
+func Value(v interface{}) interface{} {
+	return mask(v)
+}
+
+func mask(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		masked := make(map[string]interface{}, len(value))
+		for key, field := range value {
+			if Fields[key] && field != nil {
+				masked[key] = Placeholder
+				continue
+			}
+			masked[key] = mask(field)
+		}
+		return masked
+	case []interface{}:
+		masked := make([]interface{}, len(value))
+		for i, item := range value {
+			masked[i] = mask(item)
+		}
+		return masked
+	default:
+		return v
+	}
+}
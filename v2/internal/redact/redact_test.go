@@ -0,0 +1,48 @@
+package redact
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	req := `{"@type":"exportKey","input_key":{"@type":"inputKeyRegular","local_password":"bG9j",` +
+		`"key":{"public_key":"PuauM0qj","secret":"AWO+xDBn"}},"id":12345678901234567890,` +
+		`"keys":[{"mnemonic_password":"bWVt"},{"secret":null}]}`
+	masked := string(JSON([]byte(req)))
+	for _, secret := range []string{"bG9j", "AWO+xDBn", "bWVt"} {
+		if strings.Contains(masked, secret) {
+			t.Fatalf("secret %s leaked: %s", secret, masked)
+		}
+	}
+	for _, kept := range []string{"PuauM0qj", "12345678901234567890", `"secret":null`, `"@type":"inputKeyRegular"`} {
+		if !strings.Contains(masked, kept) {
+			t.Fatalf("%s is missing: %s", kept, masked)
+		}
+	}
+	if strings.Count(masked, Placeholder) != 3 {
+		t.Fatalf("expected 3 masked fields: %s", masked)
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	masked := string(JSON([]byte(`{"secret":"AWO+xDBn"`)))
+	if strings.Contains(masked, "AWO+xDBn") {
+		t.Fatalf("secret leaked from invalid json: %s", masked)
+	}
+}
+
+func TestValue(t *testing.T) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"@type":"exportedKey","word_list":["lizard","combine"]}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+	masked := Value(doc).(map[string]interface{})
+	if masked["word_list"] != Placeholder {
+		t.Fatalf("word list is not masked: %v", masked)
+	}
+	if _, ok := doc["word_list"].([]interface{}); !ok {
+		t.Fatal("original document has been modified")
+	}
+}
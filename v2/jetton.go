@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/jetton"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+// GetJettonData returns jetton minter data
+func (client *Client) GetJettonData(minter string) (*jetton.Data, error) {
+	stack, err := client.runGetMethodValues(minter, jetton.GetJettonDataMethod)
+	if err != nil {
+		return nil, err
+	}
+	return jetton.ParseData(stack)
+}
+
+// GetJettonWalletAddress returns address of the owner's jetton wallet
+func (client *Client) GetJettonWalletAddress(minter string, owner *address.Address) (*address.Address, error) {
+	ownerEntry, err := tvm.AddressEntry(owner)
+	if err != nil {
+		return nil, err
+	}
+	stack, err := client.runGetMethodValues(minter, jetton.GetWalletAddressMethod, ownerEntry)
+	if err != nil {
+		return nil, err
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%s returned %d values, expected 1", jetton.GetWalletAddressMethod, len(stack))
+	}
+	return tvm.Address(stack[0])
+}
+
+// GetJettonWalletData returns balance, owner and minter of a jetton wallet
+func (client *Client) GetJettonWalletData(jettonWallet string) (*jetton.WalletData, error) {
+	stack, err := client.runGetMethodValues(jettonWallet, jetton.GetWalletDataMethod)
+	if err != nil {
+		return nil, err
+	}
+	return jetton.ParseWalletData(stack)
+}
+
+// GetJettonBalance returns jetton balance of a jetton wallet
+func (client *Client) GetJettonBalance(jettonWallet string) (*big.Int, error) {
+	data, err := client.GetJettonWalletData(jettonWallet)
+	if err != nil {
+		return nil, err
+	}
+	return data.Balance, nil
+}
+
+// NewJettonTransferMessage builds the message the owner's wallet sends to its jetton wallet, to be used
+// in ActionMsg. The forward amount and fee are attached, nil fee means jetton.DefaultTransferFee
+func NewJettonTransferMessage(jettonWallet *address.Address, transfer jetton.Transfer, fee *big.Int) (*MsgMessage, error) {
+	body, err := transfer.Body()
+	if err != nil {
+		return nil, err
+	}
+	amount := transfer.AttachedAmount(fee)
+	if !amount.IsInt64() {
+		return nil, fmt.Errorf("attached amount is too big: %s", amount)
+	}
+	return NewMsgMessage(JSONInt64(amount.Int64()), rawMsgData(body), NewAccountAddress(jettonWallet.String()), ""), nil
+}
+
+func rawMsgData(body *cell.Cell) *MsgDataRaw {
+	return NewMsgDataRaw(body.ToBOC(), nil)
+}
@@ -0,0 +1,161 @@
+// Package jetton implements TEP-74 fungible tokens: decoding of get method results
+// of jetton minters and wallets and building of transfer message bodies.
+package jetton
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+const (
+	OpTransfer             = 0x0f8a7ea5
+	OpTransferNotification = 0x7362d09c
+	OpInternalTransfer     = 0x178d4519
+	OpExcesses             = 0xd53276db
+	OpBurn                 = 0x595f07bc
+
+	GetJettonDataMethod    = "get_jetton_data"
+	GetWalletAddressMethod = "get_wallet_address"
+	GetWalletDataMethod    = "get_wallet_data"
+
+	// DefaultTransferFee is attached to the transfer on top of the forward amount
+	// to pay for the jetton wallets' processing, nanograms
+	DefaultTransferFee = 50000000
+)
+
+// Data is the result of get_jetton_data of a jetton minter
+type Data struct {
+	TotalSupply  *big.Int
+	Mintable     bool
+	AdminAddress *address.Address
+	Content      *cell.Cell
+	WalletCode   *cell.Cell
+}
+
+// ParseData decodes get_jetton_data stack
+func ParseData(stack []interface{}) (*Data, error) {
+	if len(stack) != 5 {
+		return nil, fmt.Errorf("%s returned %d values, expected 5", GetJettonDataMethod, len(stack))
+	}
+	var data Data
+	var err error
+	if data.TotalSupply, err = tvm.Number(stack[0]); err != nil {
+		return nil, fmt.Errorf("invalid total supply: %v", err)
+	}
+	if data.Mintable, err = tvm.Bool(stack[1]); err != nil {
+		return nil, fmt.Errorf("invalid mintable flag: %v", err)
+	}
+	if data.AdminAddress, err = tvm.Address(stack[2]); err != nil {
+		return nil, fmt.Errorf("invalid admin address: %v", err)
+	}
+	if data.Content, err = tvm.Cell(stack[3]); err != nil {
+		return nil, fmt.Errorf("invalid content: %v", err)
+	}
+	if data.WalletCode, err = tvm.Cell(stack[4]); err != nil {
+		return nil, fmt.Errorf("invalid wallet code: %v", err)
+	}
+	return &data, nil
+}
+
+// WalletData is the result of get_wallet_data of a jetton wallet
+type WalletData struct {
+	Balance    *big.Int
+	Owner      *address.Address
+	Master     *address.Address
+	WalletCode *cell.Cell
+}
+
+// ParseWalletData decodes get_wallet_data stack
+func ParseWalletData(stack []interface{}) (*WalletData, error) {
+	if len(stack) != 4 {
+		return nil, fmt.Errorf("%s returned %d values, expected 4", GetWalletDataMethod, len(stack))
+	}
+	var data WalletData
+	var err error
+	if data.Balance, err = tvm.Number(stack[0]); err != nil {
+		return nil, fmt.Errorf("invalid balance: %v", err)
+	}
+	if data.Owner, err = tvm.Address(stack[1]); err != nil {
+		return nil, fmt.Errorf("invalid owner: %v", err)
+	}
+	if data.Master, err = tvm.Address(stack[2]); err != nil {
+		return nil, fmt.Errorf("invalid master: %v", err)
+	}
+	if data.WalletCode, err = tvm.Cell(stack[3]); err != nil {
+		return nil, fmt.Errorf("invalid wallet code: %v", err)
+	}
+	return &data, nil
+}
+
+// Transfer is the transfer request sent by the owner to its jetton wallet
+type Transfer struct {
+	QueryID uint64
+	// Amount of jettons in their minimal units
+	Amount      *big.Int
+	Destination *address.Address
+	// ResponseDestination receives the excess of attached TON, usually the sender
+	ResponseDestination *address.Address
+	CustomPayload       *cell.Cell
+	// ForwardTonAmount is sent to the destination owner with the transfer notification, nanograms
+	ForwardTonAmount *big.Int
+	// ForwardPayload is delivered with the notification, e.g. wallet.BuildComment
+	ForwardPayload *cell.Cell
+}
+
+// Body builds transfer#0f8a7ea5 message body
+func (t Transfer) Body() (*cell.Cell, error) {
+	if t.Amount == nil || t.Amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid jetton amount")
+	}
+	if t.Destination == nil {
+		return nil, fmt.Errorf("empty destination")
+	}
+	forward := t.ForwardTonAmount
+	if forward == nil {
+		forward = new(big.Int)
+	}
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(OpTransfer, 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(t.QueryID, 64); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBigCoins(t.Amount); err != nil {
+		return nil, err
+	}
+	if err := b.StoreAddress(t.Destination); err != nil {
+		return nil, err
+	}
+	if err := b.StoreAddress(t.ResponseDestination); err != nil {
+		return nil, err
+	}
+	if err := b.StoreMaybeRef(t.CustomPayload); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBigCoins(forward); err != nil {
+		return nil, err
+	}
+	// forward_payload:(Either Cell ^Cell), kept in a reference when present
+	if err := b.StoreMaybeRef(t.ForwardPayload); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// AttachedAmount returns TON amount the owner has to send to its jetton wallet with the transfer:
+// the forward amount plus the fee for processing
+func (t Transfer) AttachedAmount(fee *big.Int) *big.Int {
+	amount := new(big.Int)
+	if t.ForwardTonAmount != nil {
+		amount.Set(t.ForwardTonAmount)
+	}
+	if fee == nil {
+		fee = big.NewInt(DefaultTransferFee)
+	}
+	return amount.Add(amount, fee)
+}
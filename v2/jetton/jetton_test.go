@@ -0,0 +1,91 @@
+package jetton
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+	"github.com/mercuryoio/tonlib-go/v2/wallet"
+)
+
+func TestTransferBody(t *testing.T) {
+	dest := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	comment, err := wallet.BuildComment("thanks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	transfer := Transfer{
+		QueryID:             42,
+		Amount:              big.NewInt(1500000000),
+		Destination:         dest,
+		ResponseDestination: dest,
+		ForwardTonAmount:    big.NewInt(10000000),
+		ForwardPayload:      comment,
+	}
+	body, err := transfer.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := body.BeginParse()
+	op, _ := s.LoadUInt(32)
+	queryID, _ := s.LoadUInt(64)
+	amount, _ := s.LoadCoins()
+	destination, _ := s.LoadAddress()
+	response, _ := s.LoadAddress()
+	custom, _ := s.LoadMaybeRef()
+	forward, _ := s.LoadCoins()
+	payload, err := s.LoadMaybeRef()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op != OpTransfer || queryID != 42 || amount.Int64() != 1500000000 || !destination.Equal(dest) ||
+		!response.Equal(dest) || custom != nil || forward.Int64() != 10000000 {
+		t.Fatalf("unexpected body: %v", body)
+	}
+	if string(payload.Hash()) != string(comment.Hash()) || s.BitsLeft() != 0 {
+		t.Fatal("unexpected forward payload")
+	}
+	if attached := transfer.AttachedAmount(nil); attached.Int64() != 60000000 {
+		t.Fatalf("unexpected attached amount: %s", attached)
+	}
+	if _, err := (Transfer{Amount: big.NewInt(1)}).Body(); err == nil {
+		t.Fatal("expected error for empty destination")
+	}
+}
+
+func TestParseData(t *testing.T) {
+	admin := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	adminEntry, err := tvm.AddressEntry(admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := cell.NewBuilder().EndCell()
+	stack := []interface{}{
+		tvm.NumberEntry(big.NewInt(1000)),
+		tvm.NumberEntry(big.NewInt(-1)),
+		adminEntry,
+		tvm.CellEntry(content),
+		tvm.CellEntry(content),
+	}
+	data, err := ParseData(stack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.TotalSupply.Int64() != 1000 || !data.Mintable || !data.AdminAddress.Equal(admin) {
+		t.Fatalf("unexpected data: %#v", data)
+	}
+	if _, err := ParseData(stack[:4]); err == nil {
+		t.Fatal("expected error for short stack")
+	}
+
+	walletData, err := ParseWalletData([]interface{}{tvm.NumberEntry(big.NewInt(5)), adminEntry, adminEntry, tvm.CellEntry(content)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if walletData.Balance.Int64() != 5 || !walletData.Owner.Equal(admin) || !walletData.Master.Equal(admin) {
+		t.Fatalf("unexpected wallet data: %#v", walletData)
+	}
+}
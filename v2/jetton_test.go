@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/jetton"
+)
+
+func TestNewJettonTransferMessage(t *testing.T) {
+	jettonWallet := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	transfer := jetton.Transfer{
+		Amount:           big.NewInt(100),
+		Destination:      jettonWallet,
+		ForwardTonAmount: big.NewInt(1000),
+	}
+	msg, err := NewJettonTransferMessage(jettonWallet, transfer, big.NewInt(5000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Amount != 6000 || msg.Destination.AccountAddress != jettonWallet.String() {
+		t.Fatalf("unexpected message: %#v", msg)
+	}
+	raw, ok := msg.Data.(*MsgDataRaw)
+	if !ok {
+		t.Fatalf("unexpected message data: %#v", msg.Data)
+	}
+	body, err := cell.FromBOC(raw.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := transfer.Body()
+	if string(body.Hash()) != string(expected.Hash()) {
+		t.Fatal("unexpected message body")
+	}
+}
@@ -0,0 +1,279 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"hash"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	pemTypePrivateKey          = "PRIVATE KEY"
+	pemTypeEncryptedPrivateKey = "ENCRYPTED PRIVATE KEY"
+	pemIterations              = 2048
+)
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// EncodePem serializes private key to PKCS#8 PEM encrypted with password the way
+// tonlib (OpenSSL) does for ExportPemKey: PBES2 with PBKDF2-HMAC-SHA256 and AES-256-CBC.
+// Empty password produces an unencrypted PEM
+func EncodePem(privateKey ed25519.PrivateKey, password []byte) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(der)
+	if len(password) == 0 {
+		return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der}), nil
+	}
+
+	salt := make([]byte, 8)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	key := pbkdf2.Key(password, salt, pemIterations, 32, sha256.New)
+	defer wipe(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	padding := aes.BlockSize - len(der)%aes.BlockSize
+	plain := append(append([]byte{}, der...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	defer wipe(plain)
+	encrypted := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plain)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: pemIterations,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ivParam, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParam}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	info, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypeEncryptedPrivateKey, Bytes: info}), nil
+}
+
+// DecodePem parses PKCS#8 PEM produced by EncodePem, tonlib's ExportPemKey or OpenSSL
+func DecodePem(data []byte, password []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode pem block")
+	}
+	der := block.Bytes
+	switch block.Type {
+	case pemTypePrivateKey:
+	case pemTypeEncryptedPrivateKey:
+		var err error
+		der, err = decryptPKCS8(block.Bytes, password)
+		if err != nil {
+			return nil, err
+		}
+		defer wipe(der)
+	default:
+		return nil, fmt.Errorf("unsupported pem block type: %s", block.Type)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type: %T", key)
+	}
+	return privateKey, nil
+}
+
+func decryptPKCS8(data []byte, password []byte) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(data, &info); err != nil {
+		return nil, err
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported pem encryption: %v", info.Algorithm.Algorithm)
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, err
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported pem key derivation: %v", params.KeyDerivationFunc.Algorithm)
+	}
+	var kdf pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, err
+	}
+	var prf func() hash.Hash
+	switch {
+	case len(kdf.PRF.Algorithm) == 0 || kdf.PRF.Algorithm.Equal(oidHMACWithSHA1):
+		prf = sha1.New
+	case kdf.PRF.Algorithm.Equal(oidHMACWithSHA256):
+		prf = sha256.New
+	default:
+		return nil, fmt.Errorf("unsupported pem prf: %v", kdf.PRF.Algorithm)
+	}
+	var keyLen int
+	switch {
+	case params.EncryptionScheme.Algorithm.Equal(oidAES128CBC):
+		keyLen = 16
+	case params.EncryptionScheme.Algorithm.Equal(oidAES192CBC):
+		keyLen = 24
+	case params.EncryptionScheme.Algorithm.Equal(oidAES256CBC):
+		keyLen = 32
+	default:
+		return nil, fmt.Errorf("unsupported pem cipher: %v", params.EncryptionScheme.Algorithm)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize || len(info.EncryptedData)%aes.BlockSize != 0 || len(info.EncryptedData) == 0 {
+		return nil, fmt.Errorf("invalid pem encrypted data")
+	}
+
+	key := pbkdf2.Key(password, kdf.Salt, kdf.IterationCount, keyLen, prf)
+	defer wipe(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, info.EncryptedData)
+	padding := int(plain[len(plain)-1])
+	if padding == 0 || padding > aes.BlockSize || padding > len(plain) {
+		wipe(plain)
+		return nil, ErrWrongPassword
+	}
+	for _, b := range plain[len(plain)-padding:] {
+		if int(b) != padding {
+			wipe(plain)
+			return nil, ErrWrongPassword
+		}
+	}
+	return plain[:len(plain)-padding], nil
+}
+
+// EncryptSimple encrypts data with tonlib's SimpleEncryption, used by ExportEncryptedKey:
+// random prefix, sha256 of the plain text and AES-256-CBC keyed by HMAC-SHA512(secret, hash)
+func EncryptSimple(data, secret []byte) ([]byte, error) {
+	prefixSize := 32 + (16-len(data)%16)%16
+	plain := make([]byte, prefixSize+len(data))
+	defer wipe(plain)
+	if _, err := rand.Read(plain[:prefixSize]); err != nil {
+		return nil, err
+	}
+	plain[0] = byte(prefixSize)
+	copy(plain[prefixSize:], data)
+
+	dataHash := sha256.Sum256(plain)
+	block, iv, err := simpleCipher(secret, dataHash[:])
+	if err != nil {
+		return nil, err
+	}
+	result := make([]byte, 32+len(plain))
+	copy(result, dataHash[:])
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(result[32:], plain)
+	return result, nil
+}
+
+// DecryptSimple decrypts data produced by EncryptSimple or tonlib's ExportEncryptedKey
+func DecryptSimple(data, secret []byte) ([]byte, error) {
+	if len(data) < 32+aes.BlockSize || len(data)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("invalid encrypted data length: %d", len(data))
+	}
+	dataHash := data[:32]
+	block, iv, err := simpleCipher(secret, dataHash)
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(data)-32)
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, data[32:])
+	plainHash := sha256.Sum256(plain)
+	if !hmac.Equal(plainHash[:], dataHash) {
+		wipe(plain)
+		return nil, ErrWrongPassword
+	}
+	prefixSize := int(plain[0])
+	if prefixSize < 32 || prefixSize > len(plain) {
+		wipe(plain)
+		return nil, fmt.Errorf("invalid prefix size: %d", prefixSize)
+	}
+	result := append([]byte{}, plain[prefixSize:]...)
+	wipe(plain)
+	return result, nil
+}
+
+func simpleCipher(secret, dataHash []byte) (cipher.Block, []byte, error) {
+	mac := hmac.New(sha512.New, secret)
+	mac.Write(dataHash)
+	state := mac.Sum(nil)
+	defer wipe(state)
+	block, err := aes.NewCipher(state[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	iv := append([]byte{}, state[32:48]...)
+	return block, iv, nil
+}
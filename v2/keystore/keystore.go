@@ -0,0 +1,475 @@
+// Package keystore keeps ed25519 keys in a directory, encrypted at rest with a
+// key derived from the local password (scrypt) and AES-256-GCM. It mirrors tonlib's
+// key management calls (CreateNewKey, ChangeLocalPassword, Export*/Import*) while
+// keeping files in a documented format that can be inspected and backed up.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mercuryoio/tonlib-go/v2/mnemonic"
+	"github.com/mercuryoio/tonlib-go/v2/signer"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	fileVersion   = 1
+	fileExtension = ".json"
+	kdfScrypt     = "scrypt"
+	cipherAESGCM  = "aes-256-gcm"
+)
+
+var (
+	ErrWrongPassword = errors.New("wrong password")
+	ErrKeyNotFound   = errors.New("key not found")
+	ErrKeyExists     = errors.New("key already exists")
+	ErrNoMnemonic    = errors.New("key has been imported without mnemonic")
+)
+
+// ScryptParams are the cost parameters of the password key derivation
+type ScryptParams struct {
+	N int `json:"n"`
+	R int `json:"r"`
+	P int `json:"p"`
+}
+
+var (
+	// DefaultScryptParams are used for new keys
+	DefaultScryptParams = ScryptParams{N: 1 << 15, R: 8, P: 1}
+	// LightScryptParams are much faster and meant for tests only
+	LightScryptParams = ScryptParams{N: 1 << 10, R: 8, P: 1}
+)
+
+// KeyInfo is the public metadata of a stored key
+type KeyInfo struct {
+	PublicKey   string    `json:"public_key"`
+	Name        string    `json:"name"`
+	HasMnemonic bool      `json:"has_mnemonic"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// keyFile is the on-disk representation of a key
+type keyFile struct {
+	Version    int          `json:"version"`
+	Info       KeyInfo      `json:"info"`
+	KDF        string       `json:"kdf"`
+	KDFParams  ScryptParams `json:"kdf_params"`
+	Salt       []byte       `json:"salt"`
+	Cipher     string       `json:"cipher"`
+	Nonce      []byte       `json:"nonce"`
+	Ciphertext []byte       `json:"ciphertext"`
+}
+
+// secret is the encrypted payload of a key file
+type secret struct {
+	Seed             []byte   `json:"seed"`
+	Mnemonic         []string `json:"mnemonic,omitempty"`
+	MnemonicPassword string   `json:"mnemonic_password,omitempty"`
+}
+
+func (s *secret) wipe() {
+	wipe(s.Seed)
+	for i := range s.Mnemonic {
+		s.Mnemonic[i] = ""
+	}
+	s.MnemonicPassword = ""
+}
+
+// Keystore stores keys in a directory, one file per key
+type Keystore struct {
+	mu     sync.Mutex
+	dir    string
+	params ScryptParams
+}
+
+// Open opens (and creates if needed) keystore directory
+func Open(dir string) (*Keystore, error) {
+	return OpenWithParams(dir, DefaultScryptParams)
+}
+
+// OpenWithParams opens keystore that encrypts new keys with the given scrypt cost
+func OpenWithParams(dir string, params ScryptParams) (*Keystore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &Keystore{dir: dir, params: params}, nil
+}
+
+// Create generates a new key from a fresh mnemonic, like CreateNewKey
+func (ks *Keystore) Create(name string, mnemonicPassword string, password []byte) (*KeyInfo, error) {
+	words, err := mnemonic.Generate(mnemonic.DefaultWordsCount, mnemonicPassword)
+	if err != nil {
+		return nil, err
+	}
+	return ks.ImportMnemonic(name, words, mnemonicPassword, password)
+}
+
+// ImportPrivateKey stores an existing private key
+func (ks *Keystore) ImportPrivateKey(name string, privateKey ed25519.PrivateKey, password []byte) (*KeyInfo, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: %d", len(privateKey))
+	}
+	s := &secret{Seed: append([]byte{}, privateKey.Seed()...)}
+	defer s.wipe()
+	return ks.store(name, s, password)
+}
+
+// ImportMnemonic derives key from the words as ImportKey does and keeps the words for ExportMnemonic
+func (ks *Keystore) ImportMnemonic(name string, words []string, mnemonicPassword string, password []byte) (*KeyInfo, error) {
+	words = mnemonic.Normalize(words)
+	if err := mnemonic.Validate(words, mnemonicPassword); err != nil {
+		return nil, err
+	}
+	seed := mnemonic.ToSeed(words, mnemonicPassword)
+	defer wipe(seed)
+	s := &secret{
+		Seed:             append([]byte{}, seed[:ed25519.SeedSize]...),
+		Mnemonic:         words,
+		MnemonicPassword: mnemonicPassword,
+	}
+	defer s.wipe()
+	return ks.store(name, s, password)
+}
+
+// ImportPem imports key exported by ExportPemKey
+func (ks *Keystore) ImportPem(name string, data []byte, keyPassword, password []byte) (*KeyInfo, error) {
+	privateKey, err := DecodePem(data, keyPassword)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(privateKey)
+	return ks.ImportPrivateKey(name, privateKey, password)
+}
+
+// ImportEncrypted imports key exported by ExportEncryptedKey
+func (ks *Keystore) ImportEncrypted(name string, data, keyPassword, password []byte) (*KeyInfo, error) {
+	seed, err := DecryptSimple(data, keyPassword)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(seed)
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid decrypted key length: %d", len(seed))
+	}
+	return ks.ImportPrivateKey(name, ed25519.NewKeyFromSeed(seed), password)
+}
+
+// List returns metadata of all stored keys sorted by creation time
+func (ks *Keystore) List() ([]KeyInfo, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	files, err := ioutil.ReadDir(ks.dir)
+	if err != nil {
+		return nil, err
+	}
+	var infos []KeyInfo
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), fileExtension) {
+			continue
+		}
+		kf, err := readKeyFile(filepath.Join(ks.dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, kf.Info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].CreatedAt.Before(infos[j].CreatedAt)
+	})
+	return infos, nil
+}
+
+// Get returns metadata of the key
+func (ks *Keystore) Get(publicKey string) (*KeyInfo, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	path, err := ks.path(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	kf, err := readKeyFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return &kf.Info, nil
+}
+
+// Delete removes the key file
+func (ks *Keystore) Delete(publicKey string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	path, err := ks.path(publicKey)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return ErrKeyNotFound
+	}
+	return err
+}
+
+// Signer decrypts the key and returns an in-memory signer for it
+func (ks *Keystore) Signer(publicKey string, password []byte) (*signer.MemorySigner, error) {
+	s, _, err := ks.load(publicKey, password)
+	if err != nil {
+		return nil, err
+	}
+	defer s.wipe()
+	return signer.NewMemorySignerFromSeed(s.Seed)
+}
+
+// ChangePassword re-encrypts the key with a new password, like ChangeLocalPassword.
+// The lock is held until the key is written, so a concurrent Delete is not undone
+func (ks *Keystore) ChangePassword(publicKey string, oldPassword, newPassword []byte) (*KeyInfo, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	s, kf, err := ks.loadLocked(publicKey, oldPassword)
+	if err != nil {
+		return nil, err
+	}
+	defer s.wipe()
+	info := kf.Info
+	info.UpdatedAt = time.Now().UTC()
+	if err := ks.write(info, s, newPassword, true); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// ExportMnemonic returns the words the key has been imported from, like ExportKey
+func (ks *Keystore) ExportMnemonic(publicKey string, password []byte) ([]string, error) {
+	s, _, err := ks.load(publicKey, password)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(s.Seed)
+	if len(s.Mnemonic) == 0 {
+		return nil, ErrNoMnemonic
+	}
+	return s.Mnemonic, nil
+}
+
+// ExportPem exports key in the ExportPemKey format
+func (ks *Keystore) ExportPem(publicKey string, password, keyPassword []byte) ([]byte, error) {
+	s, _, err := ks.load(publicKey, password)
+	if err != nil {
+		return nil, err
+	}
+	defer s.wipe()
+	privateKey := ed25519.NewKeyFromSeed(s.Seed)
+	defer wipe(privateKey)
+	return EncodePem(privateKey, keyPassword)
+}
+
+// ExportEncrypted exports key in the ExportEncryptedKey format
+func (ks *Keystore) ExportEncrypted(publicKey string, password, keyPassword []byte) ([]byte, error) {
+	s, _, err := ks.load(publicKey, password)
+	if err != nil {
+		return nil, err
+	}
+	defer s.wipe()
+	return EncryptSimple(s.Seed, keyPassword)
+}
+
+// ExportUnencrypted exports raw 32 bytes seed like ExportUnencryptedKey
+func (ks *Keystore) ExportUnencrypted(publicKey string, password []byte) ([]byte, error) {
+	s, _, err := ks.load(publicKey, password)
+	if err != nil {
+		return nil, err
+	}
+	seed := append([]byte{}, s.Seed...)
+	s.wipe()
+	return seed, nil
+}
+
+func (ks *Keystore) store(name string, s *secret, password []byte) (*KeyInfo, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	publicKey := ed25519.NewKeyFromSeed(s.Seed).Public().(ed25519.PublicKey)
+	now := time.Now().UTC()
+	info := KeyInfo{
+		PublicKey:   signer.EncodePublicKey(publicKey),
+		Name:        name,
+		HasMnemonic: len(s.Mnemonic) > 0,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := ks.write(info, s, password, false); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (ks *Keystore) load(publicKey string, password []byte) (*secret, *keyFile, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	return ks.loadLocked(publicKey, password)
+}
+
+// loadLocked reads and decrypts key file, caller must hold the lock
+func (ks *Keystore) loadLocked(publicKey string, password []byte) (*secret, *keyFile, error) {
+	path, err := ks.path(publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	kf, err := readKeyFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if kf.KDF != kdfScrypt || kf.Cipher != cipherAESGCM {
+		return nil, nil, fmt.Errorf("unsupported key file encryption: %s, %s", kf.KDF, kf.Cipher)
+	}
+	aead, err := newAEAD(password, kf.Salt, kf.KDFParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	plain, err := aead.Open(nil, kf.Nonce, kf.Ciphertext, []byte(kf.Info.PublicKey))
+	if err != nil {
+		return nil, nil, ErrWrongPassword
+	}
+	defer wipe(plain)
+
+	var s secret
+	if err := json.Unmarshal(plain, &s); err != nil {
+		return nil, nil, err
+	}
+	if len(s.Seed) != ed25519.SeedSize {
+		s.wipe()
+		return nil, nil, fmt.Errorf("invalid seed length in key file")
+	}
+	return &s, kf, nil
+}
+
+// write encrypts and atomically writes key file, caller must hold the lock.
+// A new key must not exist yet and an overwritten one must still exist
+func (ks *Keystore) write(info KeyInfo, s *secret, password []byte, overwrite bool) error {
+	publicKey, err := signer.DecodePublicKey(info.PublicKey)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(ks.dir, hex.EncodeToString(publicKey)+fileExtension)
+	_, err = os.Stat(path)
+	if err == nil && !overwrite {
+		return ErrKeyExists
+	}
+	if os.IsNotExist(err) && overwrite {
+		return ErrKeyNotFound
+	}
+
+	plain, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	defer wipe(plain)
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	aead, err := newAEAD(password, salt, ks.params)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	kf := keyFile{
+		Version:    fileVersion,
+		Info:       info,
+		KDF:        kdfScrypt,
+		KDFParams:  ks.params,
+		Salt:       salt,
+		Cipher:     cipherAESGCM,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plain, []byte(info.PublicKey)),
+	}
+	data, err := json.MarshalIndent(kf, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(ks.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (ks *Keystore) path(publicKey string) (string, error) {
+	key, err := signer.DecodePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(ks.dir, hex.EncodeToString(key)+fileExtension)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return "", ErrKeyNotFound
+	}
+	return path, nil
+}
+
+func readKeyFile(path string) (*keyFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var kf keyFile
+	if err := json.Unmarshal(data, &kf); err != nil {
+		return nil, fmt.Errorf("failed to parse key file %s: %v", path, err)
+	}
+	if kf.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported key file version %d: %s", kf.Version, path)
+	}
+	return &kf, nil
+}
+
+func newAEAD(password, salt []byte, params ScryptParams) (cipher.AEAD, error) {
+	key, err := scrypt.Key(password, salt, params.N, params.R, params.P, 32)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func wipe(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+}
@@ -0,0 +1,186 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func openTestKeystore(t *testing.T) (*Keystore, func()) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks, err := OpenWithParams(dir, LightScryptParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ks, func() { os.RemoveAll(dir) }
+}
+
+func TestKeystoreCreateAndRotate(t *testing.T) {
+	ks, cleanup := openTestKeystore(t)
+	defer cleanup()
+
+	info, err := ks.Create("main", "", []byte("old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Signer(info.PublicKey, []byte("wrong")); err != ErrWrongPassword {
+		t.Fatalf("expected wrong password error, got %v", err)
+	}
+	before, err := ks.Signer(info.PublicKey, []byte("old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ks.ChangePassword(info.PublicKey, []byte("old"), []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Signer(info.PublicKey, []byte("old")); err != ErrWrongPassword {
+		t.Fatalf("old password still works: %v", err)
+	}
+	after, err := ks.Signer(info.PublicKey, []byte("new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before.PublicKey(), after.PublicKey()) {
+		t.Fatal("key changed after password rotation")
+	}
+
+	keys, err := ks.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].Name != "main" || !keys[0].HasMnemonic {
+		t.Fatalf("unexpected keys list: %#v", keys)
+	}
+
+	if err := ks.Delete(info.PublicKey); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Get(info.PublicKey); err != ErrKeyNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestKeystoreChangePasswordDelete(t *testing.T) {
+	ks, cleanup := openTestKeystore(t)
+	defer cleanup()
+
+	var info *KeyInfo
+	for i := 0; i < 5; i++ {
+		var err error
+		info, err = ks.Create("main", "", []byte("old"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		changed := make(chan error)
+		go func() {
+			_, err := ks.ChangePassword(info.PublicKey, []byte("old"), []byte("new"))
+			changed <- err
+		}()
+		if err := ks.Delete(info.PublicKey); err != nil {
+			t.Fatal(err)
+		}
+		if err := <-changed; err != nil && err != ErrKeyNotFound {
+			t.Fatal(err)
+		}
+		// the deleted key doesn't come back whichever call has been first
+		if _, err := ks.Get(info.PublicKey); err != ErrKeyNotFound {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	}
+	if _, err := ks.ChangePassword(info.PublicKey, []byte("old"), []byte("new")); err != ErrKeyNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestKeystoreExportImport(t *testing.T) {
+	ks, cleanup := openTestKeystore(t)
+	defer cleanup()
+
+	password := []byte("local")
+	info, err := ks.Create("source", "", password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pemData, err := ks.ExportPem(info.PublicKey, password, []byte("pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(pemData, []byte("ENCRYPTED PRIVATE KEY")) {
+		t.Fatalf("unexpected pem: %s", pemData)
+	}
+	if _, err := DecodePem(pemData, []byte("wrong")); err == nil {
+		t.Fatal("expected error for wrong pem password")
+	}
+	encrypted, err := ks.ExportEncrypted(info.PublicKey, password, []byte("enc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, cleanupOther := openTestKeystore(t)
+	defer cleanupOther()
+	fromPem, err := other.ImportPem("pem", pemData, []byte("pem"), password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromPem.PublicKey != info.PublicKey {
+		t.Fatalf("pem import produced another key: %s", fromPem.PublicKey)
+	}
+	if err := other.Delete(fromPem.PublicKey); err != nil {
+		t.Fatal(err)
+	}
+	fromEncrypted, err := other.ImportEncrypted("enc", encrypted, []byte("enc"), password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromEncrypted.PublicKey != info.PublicKey {
+		t.Fatalf("encrypted import produced another key: %s", fromEncrypted.PublicKey)
+	}
+	if _, err := other.ImportEncrypted("enc", encrypted, []byte("enc"), password); err != ErrKeyExists {
+		t.Fatalf("expected key exists error, got %v", err)
+	}
+	if _, err := other.ExportMnemonic(fromEncrypted.PublicKey, password); err != ErrNoMnemonic {
+		t.Fatalf("expected no mnemonic error, got %v", err)
+	}
+}
+
+func TestKeystoreImportMnemonic(t *testing.T) {
+	ks, cleanup := openTestKeystore(t)
+	defer cleanup()
+
+	words := strings.Fields("Lizard combine exclude other spatial find bullet pledge friend assume bulb obtain " +
+		"sibling crater phrase employ spy planet lottery scare digital box guilt kingdom")
+	info, err := ks.ImportMnemonic("phrase", words, "", []byte("local"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.HasMnemonic {
+		t.Fatal("mnemonic flag is not set")
+	}
+	exported, err := ks.ExportMnemonic(info.PublicKey, []byte("local"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != len(words) || exported[0] != "lizard" {
+		t.Fatalf("unexpected exported words: %v", exported)
+	}
+	seed, err := ks.ExportUnencrypted(info.PublicKey, []byte("local"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(seed) != "f74c8cd86bfe901f80beb2ceb682790b45921a39ae58b94f86466842e29b0ab4" {
+		t.Fatalf("unexpected seed: %x", seed)
+	}
+
+	words[0] = "abandon"
+	if _, err := ks.ImportMnemonic("broken", words, "", []byte("local")); err == nil {
+		t.Fatal("expected invalid mnemonic error")
+	}
+}
@@ -0,0 +1,175 @@
+// Package metadata decodes and builds TEP-64 token metadata cells used by jettons and NFTs:
+// off-chain content referencing a URI and on-chain content stored in a dictionary of attributes.
+package metadata
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+)
+
+// content layouts, the first byte of the content cell
+const (
+	LayoutOnChain  = 0x00
+	LayoutOffChain = 0x01
+)
+
+// data layouts of an on-chain attribute value
+const (
+	dataSnake  = 0x00
+	dataChunks = 0x01
+)
+
+// StandardAttributes are attribute names defined by TEP-64, they are decoded by name
+var StandardAttributes = []string{
+	"uri", "name", "description", "image", "image_data", "symbol", "decimals", "amount_style", "render_type",
+}
+
+// Content is decoded token metadata
+type Content struct {
+	Layout byte
+	// URI of the off-chain metadata JSON, for on-chain content the value of the "uri" attribute if any
+	URI string
+	// Attributes of on-chain content keyed by name for StandardAttributes and by hex sha256 of the name for the rest
+	Attributes map[string][]byte
+}
+
+// Attribute returns on-chain attribute value by its name
+func (c *Content) Attribute(name string) (string, bool) {
+	if value, ok := c.Attributes[name]; ok {
+		return string(value), true
+	}
+	value, ok := c.Attributes[attributeHash(name)]
+	return string(value), ok
+}
+
+// Parse decodes content cell in any of TEP-64 layouts
+func Parse(content *cell.Cell) (*Content, error) {
+	s := content.BeginParse()
+	layout, err := s.LoadUInt(8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content layout: %v", err)
+	}
+	switch layout {
+	case LayoutOffChain:
+		uri, err := s.LoadSnakeBytes()
+		if err != nil {
+			return nil, fmt.Errorf("invalid content uri: %v", err)
+		}
+		return &Content{Layout: LayoutOffChain, URI: string(uri)}, nil
+	case LayoutOnChain:
+		entries, err := s.LoadDict(256)
+		if err != nil {
+			return nil, fmt.Errorf("invalid content attributes: %v", err)
+		}
+		names := make(map[string]string, len(StandardAttributes))
+		for _, name := range StandardAttributes {
+			names[attributeHash(name)] = name
+		}
+		result := &Content{Layout: LayoutOnChain, Attributes: make(map[string][]byte, len(entries))}
+		for _, entry := range entries {
+			keyBytes := entry.Key.Bytes()
+			key := hex.EncodeToString(append(make([]byte, 32-len(keyBytes)), keyBytes...))
+			value, err := parseAttribute(entry.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid attribute %s: %v", key, err)
+			}
+			if name, ok := names[key]; ok {
+				key = name
+			}
+			result.Attributes[key] = value
+		}
+		result.URI = string(result.Attributes["uri"])
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unknown content layout: %#x", layout)
+	}
+}
+
+// parseAttribute reads ^ContentData: snake#00 data:SnakeData or chunks#01 data:(HashmapE 32 ^(SnakeData ~0))
+func parseAttribute(value *cell.Slice) ([]byte, error) {
+	ref, err := value.LoadRef()
+	if err != nil {
+		return nil, err
+	}
+	s := ref.BeginParse()
+	layout, err := s.LoadUInt(8)
+	if err != nil {
+		return nil, err
+	}
+	switch layout {
+	case dataSnake:
+		return s.LoadSnakeBytes()
+	case dataChunks:
+		chunks, err := s.LoadDict(32)
+		if err != nil {
+			return nil, err
+		}
+		var data []byte
+		for i, chunk := range chunks {
+			if chunk.Key.Int64() != int64(i) {
+				return nil, fmt.Errorf("missing chunk %d", i)
+			}
+			chunkCell, err := chunk.Value.LoadRef()
+			if err != nil {
+				return nil, err
+			}
+			chunkData, err := chunkCell.BeginParse().LoadSnakeBytes()
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, chunkData...)
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unknown data layout: %#x", layout)
+	}
+}
+
+// OffChain builds off-chain content referencing the metadata JSON by uri
+func OffChain(uri string) (*cell.Cell, error) {
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(LayoutOffChain, 8); err != nil {
+		return nil, err
+	}
+	if err := b.StoreSnakeBytes([]byte(uri)); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// OnChain builds on-chain content with the attributes stored in snake format
+func OnChain(attributes map[string]string) (*cell.Cell, error) {
+	entries := make([]cell.DictEntry, 0, len(attributes))
+	for name, text := range attributes {
+		data := cell.NewBuilder()
+		if err := data.StoreUInt(dataSnake, 8); err != nil {
+			return nil, err
+		}
+		if err := data.StoreSnakeBytes([]byte(text)); err != nil {
+			return nil, fmt.Errorf("attribute %s: %v", name, err)
+		}
+		value := cell.NewBuilder()
+		if err := value.StoreRef(data.EndCell()); err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256([]byte(name))
+		entries = append(entries, cell.DictEntry{Key: new(big.Int).SetBytes(hash[:]), Value: value.EndCell().BeginParse()})
+	}
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(LayoutOnChain, 8); err != nil {
+		return nil, err
+	}
+	if err := b.StoreDict(entries, 256); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+func attributeHash(name string) string {
+	hash := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(hash[:])
+}
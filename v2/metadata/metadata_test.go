@@ -0,0 +1,82 @@
+package metadata
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+)
+
+func TestOffChain(t *testing.T) {
+	uri := "https://example.com/nft/" + strings.Repeat("a", 200) + ".json"
+	content, err := OffChain(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Layout != LayoutOffChain || parsed.URI != uri {
+		t.Fatalf("unexpected content: %#v", parsed)
+	}
+}
+
+func TestOnChain(t *testing.T) {
+	description := strings.Repeat("long description ", 30)
+	content, err := OnChain(map[string]string{"name": "Test", "description": description, "custom": "value"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := cell.FromBOC(content.ToBOC())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(parsed.Attributes["name"]) != "Test" || string(parsed.Attributes["description"]) != description {
+		t.Fatalf("unexpected attributes: %v", parsed.Attributes)
+	}
+	if value, ok := parsed.Attribute("custom"); !ok || value != "value" {
+		t.Fatalf("unexpected custom attribute: %q", value)
+	}
+	if _, ok := parsed.Attribute("image"); ok || parsed.URI != "" {
+		t.Fatal("unexpected image attribute")
+	}
+}
+
+func TestChunkedAttribute(t *testing.T) {
+	var chunks []cell.DictEntry
+	for i, text := range []string{"chunked ", "image ", "data"} {
+		chunk := cell.NewBuilder()
+		if err := chunk.StoreBytes([]byte(text)); err != nil {
+			t.Fatal(err)
+		}
+		value := cell.NewBuilder()
+		if err := value.StoreRef(chunk.EndCell()); err != nil {
+			t.Fatal(err)
+		}
+		chunks = append(chunks, cell.DictEntry{Key: big.NewInt(int64(i)), Value: value.EndCell().BeginParse()})
+	}
+	data := cell.NewBuilder()
+	if err := data.StoreUInt(dataChunks, 8); err != nil {
+		t.Fatal(err)
+	}
+	if err := data.StoreDict(chunks, 32); err != nil {
+		t.Fatal(err)
+	}
+	value := cell.NewBuilder()
+	if err := value.StoreRef(data.EndCell()); err != nil {
+		t.Fatal(err)
+	}
+	attribute, err := parseAttribute(value.EndCell().BeginParse())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(attribute) != "chunked image data" {
+		t.Fatalf("unexpected chunked data: %q", attribute)
+	}
+}
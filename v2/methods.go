@@ -1,113 +1,193 @@
 package v2
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
 
+// InitRequest is init function
+type InitRequest struct {
+	Options Options `json:"options"`
+}
+
+// Type returns the tl name of the function
+func (initRequest *InitRequest) Type() string {
+	return "init"
+}
+
+// MarshalJSON marshals the request with its @type
+func (initRequest *InitRequest) MarshalJSON() ([]byte, error) {
+	type fields InitRequest
+	return marshalRequest(initRequest.Type(), (*fields)(initRequest))
+}
+
+// DecodeResponse decodes OptionsInfo returned by tonlib
+func (initRequest *InitRequest) DecodeResponse(raw []byte) (*OptionsInfo, error) {
+	return decodeResponse[OptionsInfo](raw)
+}
+
+// Validate checks InitRequest before it is sent to tonlib
+func (initRequest *InitRequest) Validate() error {
+	if initRequest == nil {
+		return nil
+	}
+	if err := validate(&initRequest.Options); err != nil {
+		return fmt.Errorf("init: options: %w", err)
+	}
+	return nil
+}
+
 // Init
 // @param options
 func (client *Client) Init(options Options) (*OptionsInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type    string  `json:"@type"`
-			Options Options `json:"options"`
-		}{
-			Type:    "init",
-			Options: options,
-		},
-	)
+	return Execute[*OptionsInfo](context.Background(), client, &InitRequest{
+		Options: options,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// CloseRequest is close function
+type CloseRequest struct {
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (closeRequest *CloseRequest) Type() string {
+	return "close"
+}
 
-	var optionsInfo OptionsInfo
-	err = json.Unmarshal(result.Raw, &optionsInfo)
-	return &optionsInfo, err
+// MarshalJSON marshals the request with its @type
+func (closeRequest *CloseRequest) MarshalJSON() ([]byte, error) {
+	type fields CloseRequest
+	return marshalRequest(closeRequest.Type(), (*fields)(closeRequest))
+}
 
+// DecodeResponse decodes Ok returned by tonlib
+func (closeRequest *CloseRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
+
+// Validate checks CloseRequest before it is sent to tonlib
+func (closeRequest *CloseRequest) Validate() error {
+	if closeRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // Close
 func (client *Client) Close() (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-		}{
-			Type: "close",
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &CloseRequest{})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// OptionsSetConfigRequest is options.setConfig function
+type OptionsSetConfigRequest struct {
+	Config Config `json:"config"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (optionsSetConfigRequest *OptionsSetConfigRequest) Type() string {
+	return "options.setConfig"
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// MarshalJSON marshals the request with its @type
+func (optionsSetConfigRequest *OptionsSetConfigRequest) MarshalJSON() ([]byte, error) {
+	type fields OptionsSetConfigRequest
+	return marshalRequest(optionsSetConfigRequest.Type(), (*fields)(optionsSetConfigRequest))
+}
 
+// DecodeResponse decodes OptionsConfigInfo returned by tonlib
+func (optionsSetConfigRequest *OptionsSetConfigRequest) DecodeResponse(raw []byte) (*OptionsConfigInfo, error) {
+	return decodeResponse[OptionsConfigInfo](raw)
+}
+
+// Validate checks OptionsSetConfigRequest before it is sent to tonlib
+func (optionsSetConfigRequest *OptionsSetConfigRequest) Validate() error {
+	if optionsSetConfigRequest == nil {
+		return nil
+	}
+	if err := validate(&optionsSetConfigRequest.Config); err != nil {
+		return fmt.Errorf("options.setConfig: config: %w", err)
+	}
+	return nil
 }
 
 // OptionsSetConfig
 // @param config
 func (client *Client) OptionsSetConfig(config Config) (*OptionsConfigInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type   string `json:"@type"`
-			Config Config `json:"config"`
-		}{
-			Type:   "options.setConfig",
-			Config: config,
-		},
-	)
+	return Execute[*OptionsConfigInfo](context.Background(), client, &OptionsSetConfigRequest{
+		Config: config,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// OptionsValidateConfigRequest is options.validateConfig function
+type OptionsValidateConfigRequest struct {
+	Config Config `json:"config"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (optionsValidateConfigRequest *OptionsValidateConfigRequest) Type() string {
+	return "options.validateConfig"
+}
+
+// MarshalJSON marshals the request with its @type
+func (optionsValidateConfigRequest *OptionsValidateConfigRequest) MarshalJSON() ([]byte, error) {
+	type fields OptionsValidateConfigRequest
+	return marshalRequest(optionsValidateConfigRequest.Type(), (*fields)(optionsValidateConfigRequest))
+}
 
-	var optionsConfigInfo OptionsConfigInfo
-	err = json.Unmarshal(result.Raw, &optionsConfigInfo)
-	return &optionsConfigInfo, err
+// DecodeResponse decodes OptionsConfigInfo returned by tonlib
+func (optionsValidateConfigRequest *OptionsValidateConfigRequest) DecodeResponse(raw []byte) (*OptionsConfigInfo, error) {
+	return decodeResponse[OptionsConfigInfo](raw)
+}
 
+// Validate checks OptionsValidateConfigRequest before it is sent to tonlib
+func (optionsValidateConfigRequest *OptionsValidateConfigRequest) Validate() error {
+	if optionsValidateConfigRequest == nil {
+		return nil
+	}
+	if err := validate(&optionsValidateConfigRequest.Config); err != nil {
+		return fmt.Errorf("options.validateConfig: config: %w", err)
+	}
+	return nil
 }
 
 // OptionsValidateConfig
 // @param config
 func (client *Client) OptionsValidateConfig(config Config) (*OptionsConfigInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type   string `json:"@type"`
-			Config Config `json:"config"`
-		}{
-			Type:   "options.validateConfig",
-			Config: config,
-		},
-	)
+	return Execute[*OptionsConfigInfo](context.Background(), client, &OptionsValidateConfigRequest{
+		Config: config,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// CreateNewKeyRequest is createNewKey function
+type CreateNewKeyRequest struct {
+	LocalPassword    SecureBytes `json:"local_password"`
+	MnemonicPassword SecureBytes `json:"mnemonic_password"`
+	RandomExtraSeed  SecureBytes `json:"random_extra_seed"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (createNewKeyRequest *CreateNewKeyRequest) Type() string {
+	return "createNewKey"
+}
 
-	var optionsConfigInfo OptionsConfigInfo
-	err = json.Unmarshal(result.Raw, &optionsConfigInfo)
-	return &optionsConfigInfo, err
+// MarshalJSON marshals the request with its @type
+func (createNewKeyRequest *CreateNewKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields CreateNewKeyRequest
+	return marshalRequest(createNewKeyRequest.Type(), (*fields)(createNewKeyRequest))
+}
 
+// DecodeResponse decodes Key returned by tonlib
+func (createNewKeyRequest *CreateNewKeyRequest) DecodeResponse(raw []byte) (*Key, error) {
+	return decodeResponse[Key](raw)
+}
+
+// Validate checks CreateNewKeyRequest before it is sent to tonlib
+func (createNewKeyRequest *CreateNewKeyRequest) Validate() error {
+	if createNewKeyRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // CreateNewKey
@@ -115,197 +195,284 @@ func (client *Client) OptionsValidateConfig(config Config) (*OptionsConfigInfo,
 // @param mnemonicPassword
 // @param randomExtraSeed
 func (client *Client) CreateNewKey(localPassword SecureBytes, mnemonicPassword SecureBytes, randomExtraSeed SecureBytes) (*Key, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type             string      `json:"@type"`
-			LocalPassword    SecureBytes `json:"local_password"`
-			MnemonicPassword SecureBytes `json:"mnemonic_password"`
-			RandomExtraSeed  SecureBytes `json:"random_extra_seed"`
-		}{
-			Type:             "createNewKey",
-			LocalPassword:    localPassword,
-			MnemonicPassword: mnemonicPassword,
-			RandomExtraSeed:  randomExtraSeed,
-		},
-	)
+	return Execute[*Key](context.Background(), client, &CreateNewKeyRequest{
+		LocalPassword:    localPassword,
+		MnemonicPassword: mnemonicPassword,
+		RandomExtraSeed:  randomExtraSeed,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// DeleteKeyRequest is deleteKey function
+type DeleteKeyRequest struct {
+	Key Key `json:"key"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (deleteKeyRequest *DeleteKeyRequest) Type() string {
+	return "deleteKey"
+}
 
-	var key Key
-	err = json.Unmarshal(result.Raw, &key)
-	return &key, err
+// MarshalJSON marshals the request with its @type
+func (deleteKeyRequest *DeleteKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields DeleteKeyRequest
+	return marshalRequest(deleteKeyRequest.Type(), (*fields)(deleteKeyRequest))
+}
+
+// DecodeResponse decodes Ok returned by tonlib
+func (deleteKeyRequest *DeleteKeyRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks DeleteKeyRequest before it is sent to tonlib
+func (deleteKeyRequest *DeleteKeyRequest) Validate() error {
+	if deleteKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&deleteKeyRequest.Key); err != nil {
+		return fmt.Errorf("deleteKey: key: %w", err)
+	}
+	return nil
 }
 
 // DeleteKey
 // @param key
 func (client *Client) DeleteKey(key Key) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Key  Key    `json:"key"`
-		}{
-			Type: "deleteKey",
-			Key:  key,
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &DeleteKeyRequest{
+		Key: key,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// DeleteAllKeysRequest is deleteAllKeys function
+type DeleteAllKeysRequest struct {
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (deleteAllKeysRequest *DeleteAllKeysRequest) Type() string {
+	return "deleteAllKeys"
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// MarshalJSON marshals the request with its @type
+func (deleteAllKeysRequest *DeleteAllKeysRequest) MarshalJSON() ([]byte, error) {
+	type fields DeleteAllKeysRequest
+	return marshalRequest(deleteAllKeysRequest.Type(), (*fields)(deleteAllKeysRequest))
+}
+
+// DecodeResponse decodes Ok returned by tonlib
+func (deleteAllKeysRequest *DeleteAllKeysRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks DeleteAllKeysRequest before it is sent to tonlib
+func (deleteAllKeysRequest *DeleteAllKeysRequest) Validate() error {
+	if deleteAllKeysRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // DeleteAllKeys
 func (client *Client) DeleteAllKeys() (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-		}{
-			Type: "deleteAllKeys",
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &DeleteAllKeysRequest{})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ExportKeyRequest is exportKey function
+type ExportKeyRequest struct {
+	InputKey InputKey `json:"input_key"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (exportKeyRequest *ExportKeyRequest) Type() string {
+	return "exportKey"
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// MarshalJSON marshals the request with its @type
+func (exportKeyRequest *ExportKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ExportKeyRequest
+	return marshalRequest(exportKeyRequest.Type(), (*fields)(exportKeyRequest))
+}
 
+// DecodeResponse decodes ExportedKey returned by tonlib
+func (exportKeyRequest *ExportKeyRequest) DecodeResponse(raw []byte) (*ExportedKey, error) {
+	return decodeResponse[ExportedKey](raw)
+}
+
+// Validate checks ExportKeyRequest before it is sent to tonlib
+func (exportKeyRequest *ExportKeyRequest) Validate() error {
+	if exportKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&exportKeyRequest.InputKey); err != nil {
+		return fmt.Errorf("exportKey: input_key: %w", err)
+	}
+	return nil
 }
 
 // ExportKey
 // @param inputKey
 func (client *Client) ExportKey(inputKey InputKey) (*ExportedKey, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type     string   `json:"@type"`
-			InputKey InputKey `json:"input_key"`
-		}{
-			Type:     "exportKey",
-			InputKey: inputKey,
-		},
-	)
+	return Execute[*ExportedKey](context.Background(), client, &ExportKeyRequest{
+		InputKey: inputKey,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ExportPemKeyRequest is exportPemKey function
+type ExportPemKeyRequest struct {
+	InputKey    InputKey    `json:"input_key"`
+	KeyPassword SecureBytes `json:"key_password"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (exportPemKeyRequest *ExportPemKeyRequest) Type() string {
+	return "exportPemKey"
+}
+
+// MarshalJSON marshals the request with its @type
+func (exportPemKeyRequest *ExportPemKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ExportPemKeyRequest
+	return marshalRequest(exportPemKeyRequest.Type(), (*fields)(exportPemKeyRequest))
+}
 
-	var exportedKey ExportedKey
-	err = json.Unmarshal(result.Raw, &exportedKey)
-	return &exportedKey, err
+// DecodeResponse decodes ExportedPemKey returned by tonlib
+func (exportPemKeyRequest *ExportPemKeyRequest) DecodeResponse(raw []byte) (*ExportedPemKey, error) {
+	return decodeResponse[ExportedPemKey](raw)
+}
 
+// Validate checks ExportPemKeyRequest before it is sent to tonlib
+func (exportPemKeyRequest *ExportPemKeyRequest) Validate() error {
+	if exportPemKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&exportPemKeyRequest.InputKey); err != nil {
+		return fmt.Errorf("exportPemKey: input_key: %w", err)
+	}
+	return nil
 }
 
 // ExportPemKey
 // @param inputKey
 // @param keyPassword
 func (client *Client) ExportPemKey(inputKey InputKey, keyPassword SecureBytes) (*ExportedPemKey, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type        string      `json:"@type"`
-			InputKey    InputKey    `json:"input_key"`
-			KeyPassword SecureBytes `json:"key_password"`
-		}{
-			Type:        "exportPemKey",
-			InputKey:    inputKey,
-			KeyPassword: keyPassword,
-		},
-	)
+	return Execute[*ExportedPemKey](context.Background(), client, &ExportPemKeyRequest{
+		InputKey:    inputKey,
+		KeyPassword: keyPassword,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ExportEncryptedKeyRequest is exportEncryptedKey function
+type ExportEncryptedKeyRequest struct {
+	InputKey    InputKey    `json:"input_key"`
+	KeyPassword SecureBytes `json:"key_password"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (exportEncryptedKeyRequest *ExportEncryptedKeyRequest) Type() string {
+	return "exportEncryptedKey"
+}
 
-	var exportedPemKey ExportedPemKey
-	err = json.Unmarshal(result.Raw, &exportedPemKey)
-	return &exportedPemKey, err
+// MarshalJSON marshals the request with its @type
+func (exportEncryptedKeyRequest *ExportEncryptedKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ExportEncryptedKeyRequest
+	return marshalRequest(exportEncryptedKeyRequest.Type(), (*fields)(exportEncryptedKeyRequest))
+}
+
+// DecodeResponse decodes ExportedEncryptedKey returned by tonlib
+func (exportEncryptedKeyRequest *ExportEncryptedKeyRequest) DecodeResponse(raw []byte) (*ExportedEncryptedKey, error) {
+	return decodeResponse[ExportedEncryptedKey](raw)
+}
 
+// Validate checks ExportEncryptedKeyRequest before it is sent to tonlib
+func (exportEncryptedKeyRequest *ExportEncryptedKeyRequest) Validate() error {
+	if exportEncryptedKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&exportEncryptedKeyRequest.InputKey); err != nil {
+		return fmt.Errorf("exportEncryptedKey: input_key: %w", err)
+	}
+	return nil
 }
 
 // ExportEncryptedKey
 // @param inputKey
 // @param keyPassword
 func (client *Client) ExportEncryptedKey(inputKey InputKey, keyPassword SecureBytes) (*ExportedEncryptedKey, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type        string      `json:"@type"`
-			InputKey    InputKey    `json:"input_key"`
-			KeyPassword SecureBytes `json:"key_password"`
-		}{
-			Type:        "exportEncryptedKey",
-			InputKey:    inputKey,
-			KeyPassword: keyPassword,
-		},
-	)
+	return Execute[*ExportedEncryptedKey](context.Background(), client, &ExportEncryptedKeyRequest{
+		InputKey:    inputKey,
+		KeyPassword: keyPassword,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ExportUnencryptedKeyRequest is exportUnencryptedKey function
+type ExportUnencryptedKeyRequest struct {
+	InputKey InputKey `json:"input_key"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (exportUnencryptedKeyRequest *ExportUnencryptedKeyRequest) Type() string {
+	return "exportUnencryptedKey"
+}
 
-	var exportedEncryptedKey ExportedEncryptedKey
-	err = json.Unmarshal(result.Raw, &exportedEncryptedKey)
-	return &exportedEncryptedKey, err
+// MarshalJSON marshals the request with its @type
+func (exportUnencryptedKeyRequest *ExportUnencryptedKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ExportUnencryptedKeyRequest
+	return marshalRequest(exportUnencryptedKeyRequest.Type(), (*fields)(exportUnencryptedKeyRequest))
+}
+
+// DecodeResponse decodes ExportedUnencryptedKey returned by tonlib
+func (exportUnencryptedKeyRequest *ExportUnencryptedKeyRequest) DecodeResponse(raw []byte) (*ExportedUnencryptedKey, error) {
+	return decodeResponse[ExportedUnencryptedKey](raw)
+}
 
+// Validate checks ExportUnencryptedKeyRequest before it is sent to tonlib
+func (exportUnencryptedKeyRequest *ExportUnencryptedKeyRequest) Validate() error {
+	if exportUnencryptedKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&exportUnencryptedKeyRequest.InputKey); err != nil {
+		return fmt.Errorf("exportUnencryptedKey: input_key: %w", err)
+	}
+	return nil
 }
 
 // ExportUnencryptedKey
 // @param inputKey
 func (client *Client) ExportUnencryptedKey(inputKey InputKey) (*ExportedUnencryptedKey, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type     string   `json:"@type"`
-			InputKey InputKey `json:"input_key"`
-		}{
-			Type:     "exportUnencryptedKey",
-			InputKey: inputKey,
-		},
-	)
+	return Execute[*ExportedUnencryptedKey](context.Background(), client, &ExportUnencryptedKeyRequest{
+		InputKey: inputKey,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ImportKeyRequest is importKey function
+type ImportKeyRequest struct {
+	ExportedKey      ExportedKey `json:"exported_key"`
+	LocalPassword    SecureBytes `json:"local_password"`
+	MnemonicPassword SecureBytes `json:"mnemonic_password"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (importKeyRequest *ImportKeyRequest) Type() string {
+	return "importKey"
+}
 
-	var exportedUnencryptedKey ExportedUnencryptedKey
-	err = json.Unmarshal(result.Raw, &exportedUnencryptedKey)
-	return &exportedUnencryptedKey, err
+// MarshalJSON marshals the request with its @type
+func (importKeyRequest *ImportKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ImportKeyRequest
+	return marshalRequest(importKeyRequest.Type(), (*fields)(importKeyRequest))
+}
+
+// DecodeResponse decodes Key returned by tonlib
+func (importKeyRequest *ImportKeyRequest) DecodeResponse(raw []byte) (*Key, error) {
+	return decodeResponse[Key](raw)
+}
 
+// Validate checks ImportKeyRequest before it is sent to tonlib
+func (importKeyRequest *ImportKeyRequest) Validate() error {
+	if importKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&importKeyRequest.ExportedKey); err != nil {
+		return fmt.Errorf("importKey: exported_key: %w", err)
+	}
+	return nil
 }
 
 // ImportKey
@@ -313,32 +480,45 @@ func (client *Client) ExportUnencryptedKey(inputKey InputKey) (*ExportedUnencryp
 // @param localPassword
 // @param mnemonicPassword
 func (client *Client) ImportKey(exportedKey ExportedKey, localPassword SecureBytes, mnemonicPassword SecureBytes) (*Key, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type             string      `json:"@type"`
-			ExportedKey      ExportedKey `json:"exported_key"`
-			LocalPassword    SecureBytes `json:"local_password"`
-			MnemonicPassword SecureBytes `json:"mnemonic_password"`
-		}{
-			Type:             "importKey",
-			ExportedKey:      exportedKey,
-			LocalPassword:    localPassword,
-			MnemonicPassword: mnemonicPassword,
-		},
-	)
+	return Execute[*Key](context.Background(), client, &ImportKeyRequest{
+		ExportedKey:      exportedKey,
+		LocalPassword:    localPassword,
+		MnemonicPassword: mnemonicPassword,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ImportPemKeyRequest is importPemKey function
+type ImportPemKeyRequest struct {
+	ExportedKey   ExportedPemKey `json:"exported_key"`
+	KeyPassword   SecureBytes    `json:"key_password"`
+	LocalPassword SecureBytes    `json:"local_password"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (importPemKeyRequest *ImportPemKeyRequest) Type() string {
+	return "importPemKey"
+}
 
-	var key Key
-	err = json.Unmarshal(result.Raw, &key)
-	return &key, err
+// MarshalJSON marshals the request with its @type
+func (importPemKeyRequest *ImportPemKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ImportPemKeyRequest
+	return marshalRequest(importPemKeyRequest.Type(), (*fields)(importPemKeyRequest))
+}
+
+// DecodeResponse decodes Key returned by tonlib
+func (importPemKeyRequest *ImportPemKeyRequest) DecodeResponse(raw []byte) (*Key, error) {
+	return decodeResponse[Key](raw)
+}
 
+// Validate checks ImportPemKeyRequest before it is sent to tonlib
+func (importPemKeyRequest *ImportPemKeyRequest) Validate() error {
+	if importPemKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&importPemKeyRequest.ExportedKey); err != nil {
+		return fmt.Errorf("importPemKey: exported_key: %w", err)
+	}
+	return nil
 }
 
 // ImportPemKey
@@ -346,32 +526,45 @@ func (client *Client) ImportKey(exportedKey ExportedKey, localPassword SecureByt
 // @param keyPassword
 // @param localPassword
 func (client *Client) ImportPemKey(exportedKey ExportedPemKey, keyPassword SecureBytes, localPassword SecureBytes) (*Key, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type          string         `json:"@type"`
-			ExportedKey   ExportedPemKey `json:"exported_key"`
-			KeyPassword   SecureBytes    `json:"key_password"`
-			LocalPassword SecureBytes    `json:"local_password"`
-		}{
-			Type:          "importPemKey",
-			ExportedKey:   exportedKey,
-			KeyPassword:   keyPassword,
-			LocalPassword: localPassword,
-		},
-	)
+	return Execute[*Key](context.Background(), client, &ImportPemKeyRequest{
+		ExportedKey:   exportedKey,
+		KeyPassword:   keyPassword,
+		LocalPassword: localPassword,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ImportEncryptedKeyRequest is importEncryptedKey function
+type ImportEncryptedKeyRequest struct {
+	ExportedEncryptedKey ExportedEncryptedKey `json:"exported_encrypted_key"`
+	KeyPassword          SecureBytes          `json:"key_password"`
+	LocalPassword        SecureBytes          `json:"local_password"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (importEncryptedKeyRequest *ImportEncryptedKeyRequest) Type() string {
+	return "importEncryptedKey"
+}
 
-	var keyDummy Key
-	err = json.Unmarshal(result.Raw, &keyDummy)
-	return &keyDummy, err
+// MarshalJSON marshals the request with its @type
+func (importEncryptedKeyRequest *ImportEncryptedKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ImportEncryptedKeyRequest
+	return marshalRequest(importEncryptedKeyRequest.Type(), (*fields)(importEncryptedKeyRequest))
+}
 
+// DecodeResponse decodes Key returned by tonlib
+func (importEncryptedKeyRequest *ImportEncryptedKeyRequest) DecodeResponse(raw []byte) (*Key, error) {
+	return decodeResponse[Key](raw)
+}
+
+// Validate checks ImportEncryptedKeyRequest before it is sent to tonlib
+func (importEncryptedKeyRequest *ImportEncryptedKeyRequest) Validate() error {
+	if importEncryptedKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&importEncryptedKeyRequest.ExportedEncryptedKey); err != nil {
+		return fmt.Errorf("importEncryptedKey: exported_encrypted_key: %w", err)
+	}
+	return nil
 }
 
 // ImportEncryptedKey
@@ -379,152 +572,208 @@ func (client *Client) ImportPemKey(exportedKey ExportedPemKey, keyPassword Secur
 // @param keyPassword
 // @param localPassword
 func (client *Client) ImportEncryptedKey(exportedEncryptedKey ExportedEncryptedKey, keyPassword SecureBytes, localPassword SecureBytes) (*Key, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type                 string               `json:"@type"`
-			ExportedEncryptedKey ExportedEncryptedKey `json:"exported_encrypted_key"`
-			KeyPassword          SecureBytes          `json:"key_password"`
-			LocalPassword        SecureBytes          `json:"local_password"`
-		}{
-			Type:                 "importEncryptedKey",
-			ExportedEncryptedKey: exportedEncryptedKey,
-			KeyPassword:          keyPassword,
-			LocalPassword:        localPassword,
-		},
-	)
+	return Execute[*Key](context.Background(), client, &ImportEncryptedKeyRequest{
+		ExportedEncryptedKey: exportedEncryptedKey,
+		KeyPassword:          keyPassword,
+		LocalPassword:        localPassword,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ImportUnencryptedKeyRequest is importUnencryptedKey function
+type ImportUnencryptedKeyRequest struct {
+	ExportedUnencryptedKey ExportedUnencryptedKey `json:"exported_unencrypted_key"`
+	LocalPassword          SecureBytes            `json:"local_password"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (importUnencryptedKeyRequest *ImportUnencryptedKeyRequest) Type() string {
+	return "importUnencryptedKey"
+}
 
-	var keyDummy Key
-	err = json.Unmarshal(result.Raw, &keyDummy)
-	return &keyDummy, err
+// MarshalJSON marshals the request with its @type
+func (importUnencryptedKeyRequest *ImportUnencryptedKeyRequest) MarshalJSON() ([]byte, error) {
+	type fields ImportUnencryptedKeyRequest
+	return marshalRequest(importUnencryptedKeyRequest.Type(), (*fields)(importUnencryptedKeyRequest))
+}
 
+// DecodeResponse decodes Key returned by tonlib
+func (importUnencryptedKeyRequest *ImportUnencryptedKeyRequest) DecodeResponse(raw []byte) (*Key, error) {
+	return decodeResponse[Key](raw)
+}
+
+// Validate checks ImportUnencryptedKeyRequest before it is sent to tonlib
+func (importUnencryptedKeyRequest *ImportUnencryptedKeyRequest) Validate() error {
+	if importUnencryptedKeyRequest == nil {
+		return nil
+	}
+	if err := validate(&importUnencryptedKeyRequest.ExportedUnencryptedKey); err != nil {
+		return fmt.Errorf("importUnencryptedKey: exported_unencrypted_key: %w", err)
+	}
+	return nil
 }
 
 // ImportUnencryptedKey
 // @param exportedUnencryptedKey
 // @param localPassword
 func (client *Client) ImportUnencryptedKey(exportedUnencryptedKey ExportedUnencryptedKey, localPassword SecureBytes) (*Key, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type                   string                 `json:"@type"`
-			ExportedUnencryptedKey ExportedUnencryptedKey `json:" exported_unencrypted_key"`
-			LocalPassword          SecureBytes            `json:"local_password"`
-		}{
-			Type:                   "importUnencryptedKey",
-			ExportedUnencryptedKey: exportedUnencryptedKey,
-			LocalPassword:          localPassword,
-		},
-	)
+	return Execute[*Key](context.Background(), client, &ImportUnencryptedKeyRequest{
+		ExportedUnencryptedKey: exportedUnencryptedKey,
+		LocalPassword:          localPassword,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// ChangeLocalPasswordRequest is changeLocalPassword function
+type ChangeLocalPasswordRequest struct {
+	InputKey         InputKey    `json:"input_key"`
+	NewLocalPassword SecureBytes `json:"new_local_password"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (changeLocalPasswordRequest *ChangeLocalPasswordRequest) Type() string {
+	return "changeLocalPassword"
+}
+
+// MarshalJSON marshals the request with its @type
+func (changeLocalPasswordRequest *ChangeLocalPasswordRequest) MarshalJSON() ([]byte, error) {
+	type fields ChangeLocalPasswordRequest
+	return marshalRequest(changeLocalPasswordRequest.Type(), (*fields)(changeLocalPasswordRequest))
+}
 
-	var key Key
-	err = json.Unmarshal(result.Raw, &key)
-	return &key, err
+// DecodeResponse decodes Key returned by tonlib
+func (changeLocalPasswordRequest *ChangeLocalPasswordRequest) DecodeResponse(raw []byte) (*Key, error) {
+	return decodeResponse[Key](raw)
+}
 
+// Validate checks ChangeLocalPasswordRequest before it is sent to tonlib
+func (changeLocalPasswordRequest *ChangeLocalPasswordRequest) Validate() error {
+	if changeLocalPasswordRequest == nil {
+		return nil
+	}
+	if err := validate(&changeLocalPasswordRequest.InputKey); err != nil {
+		return fmt.Errorf("changeLocalPassword: input_key: %w", err)
+	}
+	return nil
 }
 
 // ChangeLocalPassword
 // @param inputKey
 // @param newLocalPassword
 func (client *Client) ChangeLocalPassword(inputKey InputKey, newLocalPassword SecureBytes) (*Key, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type             string      `json:"@type"`
-			InputKey         InputKey    `json:"input_key"`
-			NewLocalPassword SecureBytes `json:"new_local_password"`
-		}{
-			Type:             "changeLocalPassword",
-			InputKey:         inputKey,
-			NewLocalPassword: newLocalPassword,
-		},
-	)
+	return Execute[*Key](context.Background(), client, &ChangeLocalPasswordRequest{
+		InputKey:         inputKey,
+		NewLocalPassword: newLocalPassword,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// EncryptRequest is encrypt function
+type EncryptRequest struct {
+	DecryptedData SecureBytes `json:"decrypted_data"`
+	Secret        SecureBytes `json:"secret"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (encryptRequest *EncryptRequest) Type() string {
+	return "encrypt"
+}
 
-	var key Key
-	err = json.Unmarshal(result.Raw, &key)
-	return &key, err
+// MarshalJSON marshals the request with its @type
+func (encryptRequest *EncryptRequest) MarshalJSON() ([]byte, error) {
+	type fields EncryptRequest
+	return marshalRequest(encryptRequest.Type(), (*fields)(encryptRequest))
+}
 
+// DecodeResponse decodes Data returned by tonlib
+func (encryptRequest *EncryptRequest) DecodeResponse(raw []byte) (*Data, error) {
+	return decodeResponse[Data](raw)
+}
+
+// Validate checks EncryptRequest before it is sent to tonlib
+func (encryptRequest *EncryptRequest) Validate() error {
+	if encryptRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // Encrypt
 // @param decryptedData
 // @param secret
 func (client *Client) Encrypt(decryptedData SecureBytes, secret SecureBytes) (*Data, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type          string      `json:"@type"`
-			DecryptedData SecureBytes `json:"decrypted_data"`
-			Secret        SecureBytes `json:"secret"`
-		}{
-			Type:          "encrypt",
-			DecryptedData: decryptedData,
-			Secret:        secret,
-		},
-	)
+	return Execute[*Data](context.Background(), client, &EncryptRequest{
+		DecryptedData: decryptedData,
+		Secret:        secret,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// DecryptRequest is decrypt function
+type DecryptRequest struct {
+	EncryptedData SecureBytes `json:"encrypted_data"`
+	Secret        SecureBytes `json:"secret"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (decryptRequest *DecryptRequest) Type() string {
+	return "decrypt"
+}
 
-	var data Data
-	err = json.Unmarshal(result.Raw, &data)
-	return &data, err
+// MarshalJSON marshals the request with its @type
+func (decryptRequest *DecryptRequest) MarshalJSON() ([]byte, error) {
+	type fields DecryptRequest
+	return marshalRequest(decryptRequest.Type(), (*fields)(decryptRequest))
+}
 
+// DecodeResponse decodes Data returned by tonlib
+func (decryptRequest *DecryptRequest) DecodeResponse(raw []byte) (*Data, error) {
+	return decodeResponse[Data](raw)
+}
+
+// Validate checks DecryptRequest before it is sent to tonlib
+func (decryptRequest *DecryptRequest) Validate() error {
+	if decryptRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // Decrypt
 // @param encryptedData
 // @param secret
 func (client *Client) Decrypt(encryptedData SecureBytes, secret SecureBytes) (*Data, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type          string      `json:"@type"`
-			EncryptedData SecureBytes `json:"encrypted_data"`
-			Secret        SecureBytes `json:"secret"`
-		}{
-			Type:          "decrypt",
-			EncryptedData: encryptedData,
-			Secret:        secret,
-		},
-	)
+	return Execute[*Data](context.Background(), client, &DecryptRequest{
+		EncryptedData: encryptedData,
+		Secret:        secret,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// KdfRequest is kdf function
+type KdfRequest struct {
+	Iterations int32       `json:"iterations"`
+	Password   SecureBytes `json:"password"`
+	Salt       SecureBytes `json:"salt"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (kdfRequest *KdfRequest) Type() string {
+	return "kdf"
+}
 
-	var data Data
-	err = json.Unmarshal(result.Raw, &data)
-	return &data, err
+// MarshalJSON marshals the request with its @type
+func (kdfRequest *KdfRequest) MarshalJSON() ([]byte, error) {
+	type fields KdfRequest
+	return marshalRequest(kdfRequest.Type(), (*fields)(kdfRequest))
+}
 
+// DecodeResponse decodes Data returned by tonlib
+func (kdfRequest *KdfRequest) DecodeResponse(raw []byte) (*Data, error) {
+	return decodeResponse[Data](raw)
+}
+
+// Validate checks KdfRequest before it is sent to tonlib
+func (kdfRequest *KdfRequest) Validate() error {
+	if kdfRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // Kdf
@@ -532,140 +781,208 @@ func (client *Client) Decrypt(encryptedData SecureBytes, secret SecureBytes) (*D
 // @param password
 // @param salt
 func (client *Client) Kdf(iterations int32, password SecureBytes, salt SecureBytes) (*Data, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type       string      `json:"@type"`
-			Iterations int32       `json:"iterations"`
-			Password   SecureBytes `json:"password"`
-			Salt       SecureBytes `json:"salt"`
-		}{
-			Type:       "kdf",
-			Iterations: iterations,
-			Password:   password,
-			Salt:       salt,
-		},
-	)
+	return Execute[*Data](context.Background(), client, &KdfRequest{
+		Iterations: iterations,
+		Password:   password,
+		Salt:       salt,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// UnpackAccountAddressRequest is unpackAccountAddress function
+type UnpackAccountAddressRequest struct {
+	AccountAddress string `json:"account_address"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (unpackAccountAddressRequest *UnpackAccountAddressRequest) Type() string {
+	return "unpackAccountAddress"
+}
 
-	var data Data
-	err = json.Unmarshal(result.Raw, &data)
-	return &data, err
+// MarshalJSON marshals the request with its @type
+func (unpackAccountAddressRequest *UnpackAccountAddressRequest) MarshalJSON() ([]byte, error) {
+	type fields UnpackAccountAddressRequest
+	return marshalRequest(unpackAccountAddressRequest.Type(), (*fields)(unpackAccountAddressRequest))
+}
+
+// DecodeResponse decodes UnpackedAccountAddress returned by tonlib
+func (unpackAccountAddressRequest *UnpackAccountAddressRequest) DecodeResponse(raw []byte) (*UnpackedAccountAddress, error) {
+	return decodeResponse[UnpackedAccountAddress](raw)
+}
 
+// Validate checks UnpackAccountAddressRequest before it is sent to tonlib
+func (unpackAccountAddressRequest *UnpackAccountAddressRequest) Validate() error {
+	if unpackAccountAddressRequest == nil {
+		return nil
+	}
+	if err := validateAddress(unpackAccountAddressRequest.AccountAddress); err != nil {
+		return fmt.Errorf("unpackAccountAddress: account_address is not valid: %v", err)
+	}
+	return nil
 }
 
 // UnpackAccountAddress
 // @param accountAddress
 func (client *Client) UnpackAccountAddress(accountAddress string) (*UnpackedAccountAddress, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type           string `json:"@type"`
-			AccountAddress string `json:"account_address"`
-		}{
-			Type:           "unpackAccountAddress",
-			AccountAddress: accountAddress,
-		},
-	)
+	return Execute[*UnpackedAccountAddress](context.Background(), client, &UnpackAccountAddressRequest{
+		AccountAddress: accountAddress,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// PackAccountAddressRequest is packAccountAddress function
+type PackAccountAddressRequest struct {
+	AccountAddress UnpackedAccountAddress `json:"account_address"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (packAccountAddressRequest *PackAccountAddressRequest) Type() string {
+	return "packAccountAddress"
+}
 
-	var unpackedAccountAddress UnpackedAccountAddress
-	err = json.Unmarshal(result.Raw, &unpackedAccountAddress)
-	return &unpackedAccountAddress, err
+// MarshalJSON marshals the request with its @type
+func (packAccountAddressRequest *PackAccountAddressRequest) MarshalJSON() ([]byte, error) {
+	type fields PackAccountAddressRequest
+	return marshalRequest(packAccountAddressRequest.Type(), (*fields)(packAccountAddressRequest))
+}
+
+// DecodeResponse decodes AccountAddress returned by tonlib
+func (packAccountAddressRequest *PackAccountAddressRequest) DecodeResponse(raw []byte) (*AccountAddress, error) {
+	return decodeResponse[AccountAddress](raw)
+}
 
+// Validate checks PackAccountAddressRequest before it is sent to tonlib
+func (packAccountAddressRequest *PackAccountAddressRequest) Validate() error {
+	if packAccountAddressRequest == nil {
+		return nil
+	}
+	if err := validate(&packAccountAddressRequest.AccountAddress); err != nil {
+		return fmt.Errorf("packAccountAddress: account_address: %w", err)
+	}
+	return nil
 }
 
 // PackAccountAddress
 // @param accountAddress
 func (client *Client) PackAccountAddress(accountAddress UnpackedAccountAddress) (*AccountAddress, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type           string                 `json:"@type"`
-			AccountAddress UnpackedAccountAddress `json:"account_address"`
-		}{
-			Type:           "packAccountAddress",
-			AccountAddress: accountAddress,
-		},
-	)
+	return Execute[*AccountAddress](context.Background(), client, &PackAccountAddressRequest{
+		AccountAddress: accountAddress,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GetBip39HintsRequest is getBip39Hints function
+type GetBip39HintsRequest struct {
+	Prefix string `json:"prefix"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (getBip39HintsRequest *GetBip39HintsRequest) Type() string {
+	return "getBip39Hints"
+}
 
-	var accountAddressDummy AccountAddress
-	err = json.Unmarshal(result.Raw, &accountAddressDummy)
-	return &accountAddressDummy, err
+// MarshalJSON marshals the request with its @type
+func (getBip39HintsRequest *GetBip39HintsRequest) MarshalJSON() ([]byte, error) {
+	type fields GetBip39HintsRequest
+	return marshalRequest(getBip39HintsRequest.Type(), (*fields)(getBip39HintsRequest))
+}
 
+// DecodeResponse decodes Bip39Hints returned by tonlib
+func (getBip39HintsRequest *GetBip39HintsRequest) DecodeResponse(raw []byte) (*Bip39Hints, error) {
+	return decodeResponse[Bip39Hints](raw)
+}
+
+// Validate checks GetBip39HintsRequest before it is sent to tonlib
+func (getBip39HintsRequest *GetBip39HintsRequest) Validate() error {
+	if getBip39HintsRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // GetBip39Hints
 // @param prefix
 func (client *Client) GetBip39Hints(prefix string) (*Bip39Hints, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type   string `json:"@type"`
-			Prefix string `json:"prefix"`
-		}{
-			Type:   "getBip39Hints",
-			Prefix: prefix,
-		},
-	)
+	return Execute[*Bip39Hints](context.Background(), client, &GetBip39HintsRequest{
+		Prefix: prefix,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// RawGetAccountStateRequest is raw.getAccountState function
+type RawGetAccountStateRequest struct {
+	AccountAddress AccountAddress `json:"account_address"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (rawGetAccountStateRequest *RawGetAccountStateRequest) Type() string {
+	return "raw.getAccountState"
+}
+
+// MarshalJSON marshals the request with its @type
+func (rawGetAccountStateRequest *RawGetAccountStateRequest) MarshalJSON() ([]byte, error) {
+	type fields RawGetAccountStateRequest
+	return marshalRequest(rawGetAccountStateRequest.Type(), (*fields)(rawGetAccountStateRequest))
+}
 
-	var bip39Hints Bip39Hints
-	err = json.Unmarshal(result.Raw, &bip39Hints)
-	return &bip39Hints, err
+// DecodeResponse decodes RawFullAccountState returned by tonlib
+func (rawGetAccountStateRequest *RawGetAccountStateRequest) DecodeResponse(raw []byte) (*RawFullAccountState, error) {
+	return decodeResponse[RawFullAccountState](raw)
+}
 
+// Validate checks RawGetAccountStateRequest before it is sent to tonlib
+func (rawGetAccountStateRequest *RawGetAccountStateRequest) Validate() error {
+	if rawGetAccountStateRequest == nil {
+		return nil
+	}
+	if err := validate(&rawGetAccountStateRequest.AccountAddress); err != nil {
+		return fmt.Errorf("raw.getAccountState: account_address: %w", err)
+	}
+	return nil
 }
 
 // RawGetAccountState
 // @param accountAddress
 func (client *Client) RawGetAccountState(accountAddress AccountAddress) (*RawFullAccountState, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type           string         `json:"@type"`
-			AccountAddress AccountAddress `json:"account_address"`
-		}{
-			Type:           "raw.getAccountState",
-			AccountAddress: accountAddress,
-		},
-	)
+	return Execute[*RawFullAccountState](context.Background(), client, &RawGetAccountStateRequest{
+		AccountAddress: accountAddress,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// RawGetTransactionsRequest is raw.getTransactions function
+type RawGetTransactionsRequest struct {
+	AccountAddress    AccountAddress        `json:"account_address"`
+	FromTransactionId InternalTransactionId `json:"from_transaction_id"`
+	PrivateKey        InputKey              `json:"private_key"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (rawGetTransactionsRequest *RawGetTransactionsRequest) Type() string {
+	return "raw.getTransactions"
+}
+
+// MarshalJSON marshals the request with its @type
+func (rawGetTransactionsRequest *RawGetTransactionsRequest) MarshalJSON() ([]byte, error) {
+	type fields RawGetTransactionsRequest
+	return marshalRequest(rawGetTransactionsRequest.Type(), (*fields)(rawGetTransactionsRequest))
+}
 
-	var rawFullAccountState RawFullAccountState
-	err = json.Unmarshal(result.Raw, &rawFullAccountState)
-	return &rawFullAccountState, err
+// DecodeResponse decodes RawTransactions returned by tonlib
+func (rawGetTransactionsRequest *RawGetTransactionsRequest) DecodeResponse(raw []byte) (*RawTransactions, error) {
+	return decodeResponse[RawTransactions](raw)
+}
 
+// Validate checks RawGetTransactionsRequest before it is sent to tonlib
+func (rawGetTransactionsRequest *RawGetTransactionsRequest) Validate() error {
+	if rawGetTransactionsRequest == nil {
+		return nil
+	}
+	if err := validate(&rawGetTransactionsRequest.AccountAddress); err != nil {
+		return fmt.Errorf("raw.getTransactions: account_address: %w", err)
+	}
+	if err := validate(&rawGetTransactionsRequest.FromTransactionId); err != nil {
+		return fmt.Errorf("raw.getTransactions: from_transaction_id: %w", err)
+	}
+	if err := validate(&rawGetTransactionsRequest.PrivateKey); err != nil {
+		return fmt.Errorf("raw.getTransactions: private_key: %w", err)
+	}
+	return nil
 }
 
 // RawGetTransactions
@@ -673,92 +990,129 @@ func (client *Client) RawGetAccountState(accountAddress AccountAddress) (*RawFul
 // @param fromTransactionId
 // @param privateKey
 func (client *Client) RawGetTransactions(accountAddress AccountAddress, fromTransactionId InternalTransactionId, privateKey InputKey) (*RawTransactions, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type              string                `json:"@type"`
-			AccountAddress    AccountAddress        `json:"account_address"`
-			FromTransactionId InternalTransactionId `json:"from_transaction_id"`
-			PrivateKey        InputKey              `json:"private_key"`
-		}{
-			Type:              "raw.getTransactions",
-			AccountAddress:    accountAddress,
-			FromTransactionId: fromTransactionId,
-			PrivateKey:        privateKey,
-		},
-	)
+	return Execute[*RawTransactions](context.Background(), client, &RawGetTransactionsRequest{
+		AccountAddress:    accountAddress,
+		FromTransactionId: fromTransactionId,
+		PrivateKey:        privateKey,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// RawSendMessageRequest is raw.sendMessage function
+type RawSendMessageRequest struct {
+	Body Bytes `json:"body"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (rawSendMessageRequest *RawSendMessageRequest) Type() string {
+	return "raw.sendMessage"
+}
 
-	var rawTransactions RawTransactions
-	err = json.Unmarshal(result.Raw, &rawTransactions)
-	return &rawTransactions, err
+// MarshalJSON marshals the request with its @type
+func (rawSendMessageRequest *RawSendMessageRequest) MarshalJSON() ([]byte, error) {
+	type fields RawSendMessageRequest
+	return marshalRequest(rawSendMessageRequest.Type(), (*fields)(rawSendMessageRequest))
+}
 
+// DecodeResponse decodes Ok returned by tonlib
+func (rawSendMessageRequest *RawSendMessageRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
+
+// Validate checks RawSendMessageRequest before it is sent to tonlib
+func (rawSendMessageRequest *RawSendMessageRequest) Validate() error {
+	if rawSendMessageRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // RawSendMessage
 // @param body
-func (client *Client) RawSendMessage(body []byte) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Body []byte `json:"body"`
-		}{
-			Type: "raw.sendMessage",
-			Body: body,
-		},
-	)
+func (client *Client) RawSendMessage(body Bytes) (*Ok, error) {
+	return Execute[*Ok](context.Background(), client, &RawSendMessageRequest{
+		Body: body,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// RawCreateAndSendMessageRequest is raw.createAndSendMessage function
+type RawCreateAndSendMessageRequest struct {
+	Data                Bytes          `json:"data"`
+	Destination         AccountAddress `json:"destination"`
+	InitialAccountState Bytes          `json:"initial_account_state"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (rawCreateAndSendMessageRequest *RawCreateAndSendMessageRequest) Type() string {
+	return "raw.createAndSendMessage"
+}
 
-	var Ok Ok
-	err = json.Unmarshal(result.Raw, &Ok)
-	return &Ok, err
+// MarshalJSON marshals the request with its @type
+func (rawCreateAndSendMessageRequest *RawCreateAndSendMessageRequest) MarshalJSON() ([]byte, error) {
+	type fields RawCreateAndSendMessageRequest
+	return marshalRequest(rawCreateAndSendMessageRequest.Type(), (*fields)(rawCreateAndSendMessageRequest))
+}
 
+// DecodeResponse decodes Ok returned by tonlib
+func (rawCreateAndSendMessageRequest *RawCreateAndSendMessageRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
+
+// Validate checks RawCreateAndSendMessageRequest before it is sent to tonlib
+func (rawCreateAndSendMessageRequest *RawCreateAndSendMessageRequest) Validate() error {
+	if rawCreateAndSendMessageRequest == nil {
+		return nil
+	}
+	if err := validate(&rawCreateAndSendMessageRequest.Destination); err != nil {
+		return fmt.Errorf("raw.createAndSendMessage: destination: %w", err)
+	}
+	return nil
 }
 
 // RawCreateAndSendMessage
 // @param data
 // @param destination
 // @param initialAccountState
-func (client *Client) RawCreateAndSendMessage(data []byte, destination AccountAddress, initialAccountState []byte) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type                string         `json:"@type"`
-			Data                []byte         `json:"data"`
-			Destination         AccountAddress `json:"destination"`
-			InitialAccountState []byte         `json:"initial_account_state"`
-		}{
-			Type:                "raw.createAndSendMessage",
-			Data:                data,
-			Destination:         destination,
-			InitialAccountState: initialAccountState,
-		},
-	)
+func (client *Client) RawCreateAndSendMessage(data Bytes, destination AccountAddress, initialAccountState Bytes) (*Ok, error) {
+	return Execute[*Ok](context.Background(), client, &RawCreateAndSendMessageRequest{
+		Data:                data,
+		Destination:         destination,
+		InitialAccountState: initialAccountState,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// RawCreateQueryRequest is raw.createQuery function
+type RawCreateQueryRequest struct {
+	Body        Bytes          `json:"body"`
+	Destination AccountAddress `json:"destination"`
+	InitCode    Bytes          `json:"init_code"`
+	InitData    Bytes          `json:"init_data"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (rawCreateQueryRequest *RawCreateQueryRequest) Type() string {
+	return "raw.createQuery"
+}
+
+// MarshalJSON marshals the request with its @type
+func (rawCreateQueryRequest *RawCreateQueryRequest) MarshalJSON() ([]byte, error) {
+	type fields RawCreateQueryRequest
+	return marshalRequest(rawCreateQueryRequest.Type(), (*fields)(rawCreateQueryRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes QueryInfo returned by tonlib
+func (rawCreateQueryRequest *RawCreateQueryRequest) DecodeResponse(raw []byte) (*QueryInfo, error) {
+	return decodeResponse[QueryInfo](raw)
+}
 
+// Validate checks RawCreateQueryRequest before it is sent to tonlib
+func (rawCreateQueryRequest *RawCreateQueryRequest) Validate() error {
+	if rawCreateQueryRequest == nil {
+		return nil
+	}
+	if err := validate(&rawCreateQueryRequest.Destination); err != nil {
+		return fmt.Errorf("raw.createQuery: destination: %w", err)
+	}
+	return nil
 }
 
 // RawCreateQuery
@@ -766,35 +1120,47 @@ func (client *Client) RawCreateAndSendMessage(data []byte, destination AccountAd
 // @param destination
 // @param initCode
 // @param initData
-func (client *Client) RawCreateQuery(body []byte, destination AccountAddress, initCode []byte, initData []byte) (*QueryInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type        string         `json:"@type"`
-			Body        []byte         `json:"body"`
-			Destination AccountAddress `json:"destination"`
-			InitCode    []byte         `json:"init_code"`
-			InitData    []byte         `json:"init_data"`
-		}{
-			Type:        "raw.createQuery",
-			Body:        body,
-			Destination: destination,
-			InitCode:    initCode,
-			InitData:    initData,
-		},
-	)
+func (client *Client) RawCreateQuery(body Bytes, destination AccountAddress, initCode Bytes, initData Bytes) (*QueryInfo, error) {
+	return Execute[*QueryInfo](context.Background(), client, &RawCreateQueryRequest{
+		Body:        body,
+		Destination: destination,
+		InitCode:    initCode,
+		InitData:    initData,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GetAccountAddressRequest is getAccountAddress function
+type GetAccountAddressRequest struct {
+	InitialAccountState InitialAccountState `json:"initial_account_state"`
+	Revision            int32               `json:"revision"`
+	WorkchainId         int32               `json:"workchain_id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (getAccountAddressRequest *GetAccountAddressRequest) Type() string {
+	return "getAccountAddress"
+}
+
+// MarshalJSON marshals the request with its @type
+func (getAccountAddressRequest *GetAccountAddressRequest) MarshalJSON() ([]byte, error) {
+	type fields GetAccountAddressRequest
+	return marshalRequest(getAccountAddressRequest.Type(), (*fields)(getAccountAddressRequest))
+}
 
-	var queryInfo QueryInfo
-	err = json.Unmarshal(result.Raw, &queryInfo)
-	return &queryInfo, err
+// DecodeResponse decodes AccountAddress returned by tonlib
+func (getAccountAddressRequest *GetAccountAddressRequest) DecodeResponse(raw []byte) (*AccountAddress, error) {
+	return decodeResponse[AccountAddress](raw)
+}
 
+// Validate checks GetAccountAddressRequest before it is sent to tonlib
+func (getAccountAddressRequest *GetAccountAddressRequest) Validate() error {
+	if getAccountAddressRequest == nil {
+		return nil
+	}
+	if err := validate(getAccountAddressRequest.InitialAccountState); err != nil {
+		return fmt.Errorf("getAccountAddress: initial_account_state: %w", err)
+	}
+	return nil
 }
 
 // GetAccountAddress
@@ -802,119 +1168,179 @@ func (client *Client) RawCreateQuery(body []byte, destination AccountAddress, in
 // @param revision
 // @param workchainId
 func (client *Client) GetAccountAddress(initialAccountState InitialAccountState, revision int32, workchainId int32) (*AccountAddress, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type                string              `json:"@type"`
-			InitialAccountState InitialAccountState `json:"initial_account_state"`
-			Revision            int32               `json:"revision"`
-			WorkchainId         int32               `json:"workchain_id"`
-		}{
-			Type:                "getAccountAddress",
-			InitialAccountState: initialAccountState,
-			Revision:            revision,
-			WorkchainId:         workchainId,
-		},
-	)
+	return Execute[*AccountAddress](context.Background(), client, &GetAccountAddressRequest{
+		InitialAccountState: initialAccountState,
+		Revision:            revision,
+		WorkchainId:         workchainId,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GuessAccountRevisionRequest is guessAccountRevision function
+type GuessAccountRevisionRequest struct {
+	InitialAccountState InitialAccountState `json:"initial_account_state"`
+	WorkchainId         int32               `json:"workchain_id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (guessAccountRevisionRequest *GuessAccountRevisionRequest) Type() string {
+	return "guessAccountRevision"
+}
+
+// MarshalJSON marshals the request with its @type
+func (guessAccountRevisionRequest *GuessAccountRevisionRequest) MarshalJSON() ([]byte, error) {
+	type fields GuessAccountRevisionRequest
+	return marshalRequest(guessAccountRevisionRequest.Type(), (*fields)(guessAccountRevisionRequest))
+}
 
-	var accountAddress AccountAddress
-	err = json.Unmarshal(result.Raw, &accountAddress)
-	return &accountAddress, err
+// DecodeResponse decodes AccountRevisionList returned by tonlib
+func (guessAccountRevisionRequest *GuessAccountRevisionRequest) DecodeResponse(raw []byte) (*AccountRevisionList, error) {
+	return decodeResponse[AccountRevisionList](raw)
+}
 
+// Validate checks GuessAccountRevisionRequest before it is sent to tonlib
+func (guessAccountRevisionRequest *GuessAccountRevisionRequest) Validate() error {
+	if guessAccountRevisionRequest == nil {
+		return nil
+	}
+	if err := validate(guessAccountRevisionRequest.InitialAccountState); err != nil {
+		return fmt.Errorf("guessAccountRevision: initial_account_state: %w", err)
+	}
+	return nil
 }
 
 // GuessAccountRevision
 // @param initialAccountState
 // @param workchainId
 func (client *Client) GuessAccountRevision(initialAccountState InitialAccountState, workchainId int32) (*AccountRevisionList, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type                string              `json:"@type"`
-			InitialAccountState InitialAccountState `json:"initial_account_state"`
-			WorkchainId         int32               `json:"workchain_id"`
-		}{
-			Type:                "guessAccountRevision",
-			InitialAccountState: initialAccountState,
-			WorkchainId:         workchainId,
-		},
-	)
+	return Execute[*AccountRevisionList](context.Background(), client, &GuessAccountRevisionRequest{
+		InitialAccountState: initialAccountState,
+		WorkchainId:         workchainId,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GuessAccountRequest is guessAccount function
+type GuessAccountRequest struct {
+	PublicKey            string `json:"public_key"`
+	RwalletInitPublicKey string `json:"rwallet_init_public_key"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (guessAccountRequest *GuessAccountRequest) Type() string {
+	return "guessAccount"
+}
 
-	var accountRevisionList AccountRevisionList
-	err = json.Unmarshal(result.Raw, &accountRevisionList)
-	return &accountRevisionList, err
+// MarshalJSON marshals the request with its @type
+func (guessAccountRequest *GuessAccountRequest) MarshalJSON() ([]byte, error) {
+	type fields GuessAccountRequest
+	return marshalRequest(guessAccountRequest.Type(), (*fields)(guessAccountRequest))
+}
 
+// DecodeResponse decodes AccountRevisionList returned by tonlib
+func (guessAccountRequest *GuessAccountRequest) DecodeResponse(raw []byte) (*AccountRevisionList, error) {
+	return decodeResponse[AccountRevisionList](raw)
+}
+
+// Validate checks GuessAccountRequest before it is sent to tonlib
+func (guessAccountRequest *GuessAccountRequest) Validate() error {
+	if guessAccountRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // GuessAccount
 // @param publicKey
 // @param rwalletInitPublicKey
 func (client *Client) GuessAccount(publicKey string, rwalletInitPublicKey string) (*AccountRevisionList, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type                 string `json:"@type"`
-			PublicKey            string `json:"public_key"`
-			RwalletInitPublicKey string `json:"rwallet_init_public_key"`
-		}{
-			Type:                 "guessAccount",
-			PublicKey:            publicKey,
-			RwalletInitPublicKey: rwalletInitPublicKey,
-		},
-	)
+	return Execute[*AccountRevisionList](context.Background(), client, &GuessAccountRequest{
+		PublicKey:            publicKey,
+		RwalletInitPublicKey: rwalletInitPublicKey,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GetAccountStateRequest is getAccountState function
+type GetAccountStateRequest struct {
+	AccountAddress AccountAddress `json:"account_address"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (getAccountStateRequest *GetAccountStateRequest) Type() string {
+	return "getAccountState"
+}
 
-	var accountRevisionList AccountRevisionList
-	err = json.Unmarshal(result.Raw, &accountRevisionList)
-	return &accountRevisionList, err
+// MarshalJSON marshals the request with its @type
+func (getAccountStateRequest *GetAccountStateRequest) MarshalJSON() ([]byte, error) {
+	type fields GetAccountStateRequest
+	return marshalRequest(getAccountStateRequest.Type(), (*fields)(getAccountStateRequest))
+}
 
+// DecodeResponse decodes FullAccountState returned by tonlib
+func (getAccountStateRequest *GetAccountStateRequest) DecodeResponse(raw []byte) (*FullAccountState, error) {
+	return decodeResponse[FullAccountState](raw)
+}
+
+// Validate checks GetAccountStateRequest before it is sent to tonlib
+func (getAccountStateRequest *GetAccountStateRequest) Validate() error {
+	if getAccountStateRequest == nil {
+		return nil
+	}
+	if err := validate(&getAccountStateRequest.AccountAddress); err != nil {
+		return fmt.Errorf("getAccountState: account_address: %w", err)
+	}
+	return nil
 }
 
 // GetAccountState
 // @param accountAddress
 func (client *Client) GetAccountState(accountAddress AccountAddress) (*FullAccountState, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type           string         `json:"@type"`
-			AccountAddress AccountAddress `json:"account_address"`
-		}{
-			Type:           "getAccountState",
-			AccountAddress: accountAddress,
-		},
-	)
+	return Execute[*FullAccountState](context.Background(), client, &GetAccountStateRequest{
+		AccountAddress: accountAddress,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// CreateQueryRequest is createQuery function
+type CreateQueryRequest struct {
+	Action              Action              `json:"action"`
+	Address             AccountAddress      `json:"address"`
+	InitialAccountState InitialAccountState `json:"initial_account_state"`
+	PrivateKey          InputKey            `json:"private_key"`
+	Timeout             int32               `json:"timeout"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (createQueryRequest *CreateQueryRequest) Type() string {
+	return "createQuery"
+}
 
-	var fullAccountState FullAccountState
-	err = json.Unmarshal(result.Raw, &fullAccountState)
-	return &fullAccountState, err
+// MarshalJSON marshals the request with its @type
+func (createQueryRequest *CreateQueryRequest) MarshalJSON() ([]byte, error) {
+	type fields CreateQueryRequest
+	return marshalRequest(createQueryRequest.Type(), (*fields)(createQueryRequest))
+}
 
+// DecodeResponse decodes QueryInfo returned by tonlib
+func (createQueryRequest *CreateQueryRequest) DecodeResponse(raw []byte) (*QueryInfo, error) {
+	return decodeResponse[QueryInfo](raw)
+}
+
+// Validate checks CreateQueryRequest before it is sent to tonlib
+func (createQueryRequest *CreateQueryRequest) Validate() error {
+	if createQueryRequest == nil {
+		return nil
+	}
+	if err := validate(createQueryRequest.Action); err != nil {
+		return fmt.Errorf("createQuery: action: %w", err)
+	}
+	if err := validate(&createQueryRequest.Address); err != nil {
+		return fmt.Errorf("createQuery: address: %w", err)
+	}
+	if err := validate(createQueryRequest.InitialAccountState); err != nil {
+		return fmt.Errorf("createQuery: initial_account_state: %w", err)
+	}
+	if err := validate(&createQueryRequest.PrivateKey); err != nil {
+		return fmt.Errorf("createQuery: private_key: %w", err)
+	}
+	return nil
 }
 
 // CreateQuery
@@ -924,285 +1350,403 @@ func (client *Client) GetAccountState(accountAddress AccountAddress) (*FullAccou
 // @param privateKey
 // @param timeout
 func (client *Client) CreateQuery(action Action, address AccountAddress, initialAccountState InitialAccountState, privateKey InputKey, timeout int32) (*QueryInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type                string              `json:"@type"`
-			Action              Action              `json:"action"`
-			Address             AccountAddress      `json:"address"`
-			InitialAccountState InitialAccountState `json:"initial_account_state"`
-			PrivateKey          InputKey            `json:"private_key"`
-			Timeout             int32               `json:"timeout"`
-		}{
-			Type:                "createQuery",
-			Action:              action,
-			Address:             address,
-			InitialAccountState: initialAccountState,
-			PrivateKey:          privateKey,
-			Timeout:             timeout,
-		},
-	)
+	return Execute[*QueryInfo](context.Background(), client, &CreateQueryRequest{
+		Action:              action,
+		Address:             address,
+		InitialAccountState: initialAccountState,
+		PrivateKey:          privateKey,
+		Timeout:             timeout,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// MsgDecryptRequest is msg.decrypt function
+type MsgDecryptRequest struct {
+	Data     MsgDataEncryptedArray `json:"data"`
+	InputKey InputKey              `json:"input_key"`
+}
+
+// Type returns the tl name of the function
+func (msgDecryptRequest *MsgDecryptRequest) Type() string {
+	return "msg.decrypt"
+}
+
+// MarshalJSON marshals the request with its @type
+func (msgDecryptRequest *MsgDecryptRequest) MarshalJSON() ([]byte, error) {
+	type fields MsgDecryptRequest
+	return marshalRequest(msgDecryptRequest.Type(), (*fields)(msgDecryptRequest))
+}
+
+// DecodeResponse decodes MsgDataDecryptedArray returned by tonlib
+func (msgDecryptRequest *MsgDecryptRequest) DecodeResponse(raw []byte) (*MsgDataDecryptedArray, error) {
+	return decodeResponse[MsgDataDecryptedArray](raw)
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+// Validate checks MsgDecryptRequest before it is sent to tonlib
+func (msgDecryptRequest *MsgDecryptRequest) Validate() error {
+	if msgDecryptRequest == nil {
+		return nil
+	}
+	if err := validate(&msgDecryptRequest.Data); err != nil {
+		return fmt.Errorf("msg.decrypt: data: %w", err)
+	}
+	if err := validate(&msgDecryptRequest.InputKey); err != nil {
+		return fmt.Errorf("msg.decrypt: input_key: %w", err)
 	}
-
-	var queryInfo QueryInfo
-	err = json.Unmarshal(result.Raw, &queryInfo)
-	return &queryInfo, err
-
+	return nil
 }
 
 // MsgDecrypt
 // @param data
 // @param inputKey
 func (client *Client) MsgDecrypt(data MsgDataEncryptedArray, inputKey InputKey) (*MsgDataDecryptedArray, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type     string                `json:"@type"`
-			Data     MsgDataEncryptedArray `json:"data"`
-			InputKey InputKey              `json:"input_key"`
-		}{
-			Type:     "msg.decrypt",
-			Data:     data,
-			InputKey: inputKey,
-		},
-	)
+	return Execute[*MsgDataDecryptedArray](context.Background(), client, &MsgDecryptRequest{
+		Data:     data,
+		InputKey: inputKey,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// MsgDecryptWithProofRequest is msg.decryptWithProof function
+type MsgDecryptWithProofRequest struct {
+	Data  MsgDataEncrypted `json:"data"`
+	Proof Bytes            `json:"proof"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (msgDecryptWithProofRequest *MsgDecryptWithProofRequest) Type() string {
+	return "msg.decryptWithProof"
+}
+
+// MarshalJSON marshals the request with its @type
+func (msgDecryptWithProofRequest *MsgDecryptWithProofRequest) MarshalJSON() ([]byte, error) {
+	type fields MsgDecryptWithProofRequest
+	return marshalRequest(msgDecryptWithProofRequest.Type(), (*fields)(msgDecryptWithProofRequest))
+}
 
-	var msgDataDecryptedArray MsgDataDecryptedArray
-	err = json.Unmarshal(result.Raw, &msgDataDecryptedArray)
-	return &msgDataDecryptedArray, err
+// DecodeResponse decodes MsgData returned by tonlib
+func (msgDecryptWithProofRequest *MsgDecryptWithProofRequest) DecodeResponse(raw []byte) (MsgData, error) {
+	return unmarshalMsgData((*json.RawMessage)(&raw))
+}
 
+// Validate checks MsgDecryptWithProofRequest before it is sent to tonlib
+func (msgDecryptWithProofRequest *MsgDecryptWithProofRequest) Validate() error {
+	if msgDecryptWithProofRequest == nil {
+		return nil
+	}
+	if err := validate(&msgDecryptWithProofRequest.Data); err != nil {
+		return fmt.Errorf("msg.decryptWithProof: data: %w", err)
+	}
+	return nil
 }
 
 // MsgDecryptWithProof
 // @param data
 // @param proof
-func (client *Client) MsgDecryptWithProof(data MsgDataEncrypted, proof []byte) (*MsgData, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type  string           `json:"@type"`
-			Data  MsgDataEncrypted `json:"data"`
-			Proof []byte           `json:"proof"`
-		}{
-			Type:  "msg.decryptWithProof",
-			Data:  data,
-			Proof: proof,
-		},
-	)
+func (client *Client) MsgDecryptWithProof(data MsgDataEncrypted, proof Bytes) (MsgData, error) {
+	return Execute[MsgData](context.Background(), client, &MsgDecryptWithProofRequest{
+		Data:  data,
+		Proof: proof,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// QuerySendRequest is query.send function
+type QuerySendRequest struct {
+	Id int64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (querySendRequest *QuerySendRequest) Type() string {
+	return "query.send"
+}
+
+// MarshalJSON marshals the request with its @type
+func (querySendRequest *QuerySendRequest) MarshalJSON() ([]byte, error) {
+	type fields QuerySendRequest
+	return marshalRequest(querySendRequest.Type(), (*fields)(querySendRequest))
+}
 
-	var msgData MsgData
-	err = json.Unmarshal(result.Raw, &msgData)
-	return &msgData, err
+// DecodeResponse decodes Ok returned by tonlib
+func (querySendRequest *QuerySendRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks QuerySendRequest before it is sent to tonlib
+func (querySendRequest *QuerySendRequest) Validate() error {
+	if querySendRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // QuerySend
 // @param id
 func (client *Client) QuerySend(id int64) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Id   int64  `json:"id"`
-		}{
-			Type: "query.send",
-			Id:   id,
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &QuerySendRequest{
+		Id: id,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// QueryForgetRequest is query.forget function
+type QueryForgetRequest struct {
+	Id int64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (queryForgetRequest *QueryForgetRequest) Type() string {
+	return "query.forget"
+}
+
+// MarshalJSON marshals the request with its @type
+func (queryForgetRequest *QueryForgetRequest) MarshalJSON() ([]byte, error) {
+	type fields QueryForgetRequest
+	return marshalRequest(queryForgetRequest.Type(), (*fields)(queryForgetRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes Ok returned by tonlib
+func (queryForgetRequest *QueryForgetRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks QueryForgetRequest before it is sent to tonlib
+func (queryForgetRequest *QueryForgetRequest) Validate() error {
+	if queryForgetRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // QueryForget
 // @param id
 func (client *Client) QueryForget(id int64) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Id   int64  `json:"id"`
-		}{
-			Type: "query.forget",
-			Id:   id,
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &QueryForgetRequest{
+		Id: id,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// QueryGetInfoRequest is query.getInfo function
+type QueryGetInfoRequest struct {
+	Id int64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (queryGetInfoRequest *QueryGetInfoRequest) Type() string {
+	return "query.getInfo"
+}
+
+// MarshalJSON marshals the request with its @type
+func (queryGetInfoRequest *QueryGetInfoRequest) MarshalJSON() ([]byte, error) {
+	type fields QueryGetInfoRequest
+	return marshalRequest(queryGetInfoRequest.Type(), (*fields)(queryGetInfoRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes QueryInfo returned by tonlib
+func (queryGetInfoRequest *QueryGetInfoRequest) DecodeResponse(raw []byte) (*QueryInfo, error) {
+	return decodeResponse[QueryInfo](raw)
+}
 
+// Validate checks QueryGetInfoRequest before it is sent to tonlib
+func (queryGetInfoRequest *QueryGetInfoRequest) Validate() error {
+	if queryGetInfoRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // QueryGetInfo
 // @param id
 func (client *Client) QueryGetInfo(id int64) (*QueryInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Id   int64  `json:"id"`
-		}{
-			Type: "query.getInfo",
-			Id:   id,
-		},
-	)
+	return Execute[*QueryInfo](context.Background(), client, &QueryGetInfoRequest{
+		Id: id,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// SmcLoadRequest is smc.load function
+type SmcLoadRequest struct {
+	AccountAddress AccountAddress `json:"account_address"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (smcLoadRequest *SmcLoadRequest) Type() string {
+	return "smc.load"
+}
 
-	var queryInfo QueryInfo
-	err = json.Unmarshal(result.Raw, &queryInfo)
-	return &queryInfo, err
+// MarshalJSON marshals the request with its @type
+func (smcLoadRequest *SmcLoadRequest) MarshalJSON() ([]byte, error) {
+	type fields SmcLoadRequest
+	return marshalRequest(smcLoadRequest.Type(), (*fields)(smcLoadRequest))
+}
+
+// DecodeResponse decodes SmcInfo returned by tonlib
+func (smcLoadRequest *SmcLoadRequest) DecodeResponse(raw []byte) (*SmcInfo, error) {
+	return decodeResponse[SmcInfo](raw)
+}
 
+// Validate checks SmcLoadRequest before it is sent to tonlib
+func (smcLoadRequest *SmcLoadRequest) Validate() error {
+	if smcLoadRequest == nil {
+		return nil
+	}
+	if err := validate(&smcLoadRequest.AccountAddress); err != nil {
+		return fmt.Errorf("smc.load: account_address: %w", err)
+	}
+	return nil
 }
 
 // SmcLoad
 // @param accountAddress
 func (client *Client) SmcLoad(accountAddress AccountAddress) (*SmcInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type           string         `json:"@type"`
-			AccountAddress AccountAddress `json:"account_address"`
-		}{
-			Type:           "smc.load",
-			AccountAddress: accountAddress,
-		},
-	)
+	return Execute[*SmcInfo](context.Background(), client, &SmcLoadRequest{
+		AccountAddress: accountAddress,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// SmcGetCodeRequest is smc.getCode function
+type SmcGetCodeRequest struct {
+	Id int64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (smcGetCodeRequest *SmcGetCodeRequest) Type() string {
+	return "smc.getCode"
+}
+
+// MarshalJSON marshals the request with its @type
+func (smcGetCodeRequest *SmcGetCodeRequest) MarshalJSON() ([]byte, error) {
+	type fields SmcGetCodeRequest
+	return marshalRequest(smcGetCodeRequest.Type(), (*fields)(smcGetCodeRequest))
+}
 
-	var smcInfo SmcInfo
-	err = json.Unmarshal(result.Raw, &smcInfo)
-	return &smcInfo, err
+// DecodeResponse decodes TvmCell returned by tonlib
+func (smcGetCodeRequest *SmcGetCodeRequest) DecodeResponse(raw []byte) (*TvmCell, error) {
+	return decodeResponse[TvmCell](raw)
+}
 
+// Validate checks SmcGetCodeRequest before it is sent to tonlib
+func (smcGetCodeRequest *SmcGetCodeRequest) Validate() error {
+	if smcGetCodeRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // SmcGetCode
 // @param id
 func (client *Client) SmcGetCode(id int64) (*TvmCell, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Id   int64  `json:"id"`
-		}{
-			Type: "smc.getCode",
-			Id:   id,
-		},
-	)
+	return Execute[*TvmCell](context.Background(), client, &SmcGetCodeRequest{
+		Id: id,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// SmcGetDataRequest is smc.getData function
+type SmcGetDataRequest struct {
+	Id int64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (smcGetDataRequest *SmcGetDataRequest) Type() string {
+	return "smc.getData"
+}
+
+// MarshalJSON marshals the request with its @type
+func (smcGetDataRequest *SmcGetDataRequest) MarshalJSON() ([]byte, error) {
+	type fields SmcGetDataRequest
+	return marshalRequest(smcGetDataRequest.Type(), (*fields)(smcGetDataRequest))
+}
 
-	var tvmCell TvmCell
-	err = json.Unmarshal(result.Raw, &tvmCell)
-	return &tvmCell, err
+// DecodeResponse decodes TvmCell returned by tonlib
+func (smcGetDataRequest *SmcGetDataRequest) DecodeResponse(raw []byte) (*TvmCell, error) {
+	return decodeResponse[TvmCell](raw)
+}
 
+// Validate checks SmcGetDataRequest before it is sent to tonlib
+func (smcGetDataRequest *SmcGetDataRequest) Validate() error {
+	if smcGetDataRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // SmcGetData
 // @param id
 func (client *Client) SmcGetData(id int64) (*TvmCell, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Id   int64  `json:"id"`
-		}{
-			Type: "smc.getData",
-			Id:   id,
-		},
-	)
+	return Execute[*TvmCell](context.Background(), client, &SmcGetDataRequest{
+		Id: id,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// SmcGetStateRequest is smc.getState function
+type SmcGetStateRequest struct {
+	Id int64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (smcGetStateRequest *SmcGetStateRequest) Type() string {
+	return "smc.getState"
+}
 
-	var tvmCell TvmCell
-	err = json.Unmarshal(result.Raw, &tvmCell)
-	return &tvmCell, err
+// MarshalJSON marshals the request with its @type
+func (smcGetStateRequest *SmcGetStateRequest) MarshalJSON() ([]byte, error) {
+	type fields SmcGetStateRequest
+	return marshalRequest(smcGetStateRequest.Type(), (*fields)(smcGetStateRequest))
+}
+
+// DecodeResponse decodes TvmCell returned by tonlib
+func (smcGetStateRequest *SmcGetStateRequest) DecodeResponse(raw []byte) (*TvmCell, error) {
+	return decodeResponse[TvmCell](raw)
+}
 
+// Validate checks SmcGetStateRequest before it is sent to tonlib
+func (smcGetStateRequest *SmcGetStateRequest) Validate() error {
+	if smcGetStateRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // SmcGetState
 // @param id
 func (client *Client) SmcGetState(id int64) (*TvmCell, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Id   int64  `json:"id"`
-		}{
-			Type: "smc.getState",
-			Id:   id,
-		},
-	)
+	return Execute[*TvmCell](context.Background(), client, &SmcGetStateRequest{
+		Id: id,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// SmcRunGetMethodRequest is smc.runGetMethod function
+type SmcRunGetMethodRequest struct {
+	Id     int64           `json:"id"`
+	Method SmcMethodId     `json:"method"`
+	Stack  []TvmStackEntry `json:"stack"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (smcRunGetMethodRequest *SmcRunGetMethodRequest) Type() string {
+	return "smc.runGetMethod"
+}
+
+// MarshalJSON marshals the request with its @type
+func (smcRunGetMethodRequest *SmcRunGetMethodRequest) MarshalJSON() ([]byte, error) {
+	type fields SmcRunGetMethodRequest
+	return marshalRequest(smcRunGetMethodRequest.Type(), (*fields)(smcRunGetMethodRequest))
+}
 
-	var tvmCell TvmCell
-	err = json.Unmarshal(result.Raw, &tvmCell)
-	return &tvmCell, err
+// DecodeResponse decodes SmcRunResult returned by tonlib
+func (smcRunGetMethodRequest *SmcRunGetMethodRequest) DecodeResponse(raw []byte) (*SmcRunResult, error) {
+	return decodeResponse[SmcRunResult](raw)
+}
 
+// Validate checks SmcRunGetMethodRequest before it is sent to tonlib
+func (smcRunGetMethodRequest *SmcRunGetMethodRequest) Validate() error {
+	if smcRunGetMethodRequest == nil {
+		return nil
+	}
+	if err := validate(smcRunGetMethodRequest.Method); err != nil {
+		return fmt.Errorf("smc.runGetMethod: method: %w", err)
+	}
+	for i := range smcRunGetMethodRequest.Stack {
+		if err := validate(smcRunGetMethodRequest.Stack[i]); err != nil {
+			return fmt.Errorf("smc.runGetMethod: stack[%d]: %w", i, err)
+		}
+	}
+	return nil
 }
 
 // SmcRunGetMethod
@@ -1210,32 +1754,46 @@ func (client *Client) SmcGetState(id int64) (*TvmCell, error) {
 // @param method
 // @param stack
 func (client *Client) SmcRunGetMethod(id int64, method SmcMethodId, stack []TvmStackEntry) (*SmcRunResult, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type   string          `json:"@type"`
-			Id     int64           `json:"id"`
-			Method SmcMethodId     `json:"method"`
-			Stack  []TvmStackEntry `json:"stack"`
-		}{
-			Type:   "smc.runGetMethod",
-			Id:     id,
-			Method: method,
-			Stack:  stack,
-		},
-	)
+	return Execute[*SmcRunResult](context.Background(), client, &SmcRunGetMethodRequest{
+		Id:     id,
+		Method: method,
+		Stack:  stack,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// DnsResolveRequest is dns.resolve function
+type DnsResolveRequest struct {
+	AccountAddress AccountAddress `json:"account_address"`
+	Category       int32          `json:"category"`
+	Name           string         `json:"name"`
+	Ttl            int32          `json:"ttl"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (dnsResolveRequest *DnsResolveRequest) Type() string {
+	return "dns.resolve"
+}
 
-	var smcRunResult SmcRunResult
-	err = json.Unmarshal(result.Raw, &smcRunResult)
-	return &smcRunResult, err
+// MarshalJSON marshals the request with its @type
+func (dnsResolveRequest *DnsResolveRequest) MarshalJSON() ([]byte, error) {
+	type fields DnsResolveRequest
+	return marshalRequest(dnsResolveRequest.Type(), (*fields)(dnsResolveRequest))
+}
+
+// DecodeResponse decodes DnsResolved returned by tonlib
+func (dnsResolveRequest *DnsResolveRequest) DecodeResponse(raw []byte) (*DnsResolved, error) {
+	return decodeResponse[DnsResolved](raw)
+}
 
+// Validate checks DnsResolveRequest before it is sent to tonlib
+func (dnsResolveRequest *DnsResolveRequest) Validate() error {
+	if dnsResolveRequest == nil {
+		return nil
+	}
+	if err := validate(&dnsResolveRequest.AccountAddress); err != nil {
+		return fmt.Errorf("dns.resolve: account_address: %w", err)
+	}
+	return nil
 }
 
 // DnsResolve
@@ -1244,516 +1802,622 @@ func (client *Client) SmcRunGetMethod(id int64, method SmcMethodId, stack []TvmS
 // @param name
 // @param ttl
 func (client *Client) DnsResolve(accountAddress AccountAddress, category int32, name string, ttl int32) (*DnsResolved, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type           string         `json:"@type"`
-			AccountAddress AccountAddress `json:"account_address"`
-			Category       int32          `json:"category"`
-			Name           string         `json:"name"`
-			Ttl            int32          `json:"ttl"`
-		}{
-			Type:           "dns.resolve",
-			AccountAddress: accountAddress,
-			Category:       category,
-			Name:           name,
-			Ttl:            ttl,
-		},
-	)
+	return Execute[*DnsResolved](context.Background(), client, &DnsResolveRequest{
+		AccountAddress: accountAddress,
+		Category:       category,
+		Name:           name,
+		Ttl:            ttl,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// PchanSignPromiseRequest is pchan.signPromise function
+type PchanSignPromiseRequest struct {
+	InputKey InputKey     `json:"input_key"`
+	Promise  PchanPromise `json:"promise"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (pchanSignPromiseRequest *PchanSignPromiseRequest) Type() string {
+	return "pchan.signPromise"
+}
+
+// MarshalJSON marshals the request with its @type
+func (pchanSignPromiseRequest *PchanSignPromiseRequest) MarshalJSON() ([]byte, error) {
+	type fields PchanSignPromiseRequest
+	return marshalRequest(pchanSignPromiseRequest.Type(), (*fields)(pchanSignPromiseRequest))
+}
 
-	var dnsResolved DnsResolved
-	err = json.Unmarshal(result.Raw, &dnsResolved)
-	return &dnsResolved, err
+// DecodeResponse decodes PchanPromise returned by tonlib
+func (pchanSignPromiseRequest *PchanSignPromiseRequest) DecodeResponse(raw []byte) (*PchanPromise, error) {
+	return decodeResponse[PchanPromise](raw)
+}
 
+// Validate checks PchanSignPromiseRequest before it is sent to tonlib
+func (pchanSignPromiseRequest *PchanSignPromiseRequest) Validate() error {
+	if pchanSignPromiseRequest == nil {
+		return nil
+	}
+	if err := validate(&pchanSignPromiseRequest.InputKey); err != nil {
+		return fmt.Errorf("pchan.signPromise: input_key: %w", err)
+	}
+	if err := validate(&pchanSignPromiseRequest.Promise); err != nil {
+		return fmt.Errorf("pchan.signPromise: promise: %w", err)
+	}
+	return nil
 }
 
 // PchanSignPromise
 // @param inputKey
 // @param promise
 func (client *Client) PchanSignPromise(inputKey InputKey, promise PchanPromise) (*PchanPromise, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type     string       `json:"@type"`
-			InputKey InputKey     `json:"input_key"`
-			Promise  PchanPromise `json:"promise"`
-		}{
-			Type:     "pchan.signPromise",
-			InputKey: inputKey,
-			Promise:  promise,
-		},
-	)
+	return Execute[*PchanPromise](context.Background(), client, &PchanSignPromiseRequest{
+		InputKey: inputKey,
+		Promise:  promise,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// PchanValidatePromiseRequest is pchan.validatePromise function
+type PchanValidatePromiseRequest struct {
+	Promise   PchanPromise `json:"promise"`
+	PublicKey Bytes        `json:"public_key"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (pchanValidatePromiseRequest *PchanValidatePromiseRequest) Type() string {
+	return "pchan.validatePromise"
+}
+
+// MarshalJSON marshals the request with its @type
+func (pchanValidatePromiseRequest *PchanValidatePromiseRequest) MarshalJSON() ([]byte, error) {
+	type fields PchanValidatePromiseRequest
+	return marshalRequest(pchanValidatePromiseRequest.Type(), (*fields)(pchanValidatePromiseRequest))
+}
 
-	var pchanPromise PchanPromise
-	err = json.Unmarshal(result.Raw, &pchanPromise)
-	return &pchanPromise, err
+// DecodeResponse decodes Ok returned by tonlib
+func (pchanValidatePromiseRequest *PchanValidatePromiseRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks PchanValidatePromiseRequest before it is sent to tonlib
+func (pchanValidatePromiseRequest *PchanValidatePromiseRequest) Validate() error {
+	if pchanValidatePromiseRequest == nil {
+		return nil
+	}
+	if err := validate(&pchanValidatePromiseRequest.Promise); err != nil {
+		return fmt.Errorf("pchan.validatePromise: promise: %w", err)
+	}
+	return nil
 }
 
 // PchanValidatePromise
 // @param promise
 // @param publicKey
-func (client *Client) PchanValidatePromise(promise PchanPromise, publicKey []byte) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type      string       `json:"@type"`
-			Promise   PchanPromise `json:"promise"`
-			PublicKey []byte       `json:"public_key"`
-		}{
-			Type:      "pchan.validatePromise",
-			Promise:   promise,
-			PublicKey: publicKey,
-		},
-	)
+func (client *Client) PchanValidatePromise(promise PchanPromise, publicKey Bytes) (*Ok, error) {
+	return Execute[*Ok](context.Background(), client, &PchanValidatePromiseRequest{
+		Promise:   promise,
+		PublicKey: publicKey,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// PchanPackPromiseRequest is pchan.packPromise function
+type PchanPackPromiseRequest struct {
+	Promise PchanPromise `json:"promise"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (pchanPackPromiseRequest *PchanPackPromiseRequest) Type() string {
+	return "pchan.packPromise"
+}
+
+// MarshalJSON marshals the request with its @type
+func (pchanPackPromiseRequest *PchanPackPromiseRequest) MarshalJSON() ([]byte, error) {
+	type fields PchanPackPromiseRequest
+	return marshalRequest(pchanPackPromiseRequest.Type(), (*fields)(pchanPackPromiseRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes Data returned by tonlib
+func (pchanPackPromiseRequest *PchanPackPromiseRequest) DecodeResponse(raw []byte) (*Data, error) {
+	return decodeResponse[Data](raw)
+}
 
+// Validate checks PchanPackPromiseRequest before it is sent to tonlib
+func (pchanPackPromiseRequest *PchanPackPromiseRequest) Validate() error {
+	if pchanPackPromiseRequest == nil {
+		return nil
+	}
+	if err := validate(&pchanPackPromiseRequest.Promise); err != nil {
+		return fmt.Errorf("pchan.packPromise: promise: %w", err)
+	}
+	return nil
 }
 
 // PchanPackPromise
 // @param promise
 func (client *Client) PchanPackPromise(promise PchanPromise) (*Data, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type    string       `json:"@type"`
-			Promise PchanPromise `json:"promise"`
-		}{
-			Type:    "pchan.packPromise",
-			Promise: promise,
-		},
-	)
+	return Execute[*Data](context.Background(), client, &PchanPackPromiseRequest{
+		Promise: promise,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// PchanUnpackPromiseRequest is pchan.unpackPromise function
+type PchanUnpackPromiseRequest struct {
+	Data SecureBytes `json:"data"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (pchanUnpackPromiseRequest *PchanUnpackPromiseRequest) Type() string {
+	return "pchan.unpackPromise"
+}
+
+// MarshalJSON marshals the request with its @type
+func (pchanUnpackPromiseRequest *PchanUnpackPromiseRequest) MarshalJSON() ([]byte, error) {
+	type fields PchanUnpackPromiseRequest
+	return marshalRequest(pchanUnpackPromiseRequest.Type(), (*fields)(pchanUnpackPromiseRequest))
+}
 
-	var data Data
-	err = json.Unmarshal(result.Raw, &data)
-	return &data, err
+// DecodeResponse decodes PchanPromise returned by tonlib
+func (pchanUnpackPromiseRequest *PchanUnpackPromiseRequest) DecodeResponse(raw []byte) (*PchanPromise, error) {
+	return decodeResponse[PchanPromise](raw)
+}
 
+// Validate checks PchanUnpackPromiseRequest before it is sent to tonlib
+func (pchanUnpackPromiseRequest *PchanUnpackPromiseRequest) Validate() error {
+	if pchanUnpackPromiseRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // PchanUnpackPromise
 // @param data
 func (client *Client) PchanUnpackPromise(data SecureBytes) (*PchanPromise, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string      `json:"@type"`
-			Data SecureBytes `json:"data"`
-		}{
-			Type: "pchan.unpackPromise",
-			Data: data,
-		},
-	)
+	return Execute[*PchanPromise](context.Background(), client, &PchanUnpackPromiseRequest{
+		Data: data,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// OnLiteServerQueryResultRequest is onLiteServerQueryResult function
+type OnLiteServerQueryResultRequest struct {
+	Bytes Bytes     `json:"bytes"`
+	Id    JSONInt64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (onLiteServerQueryResultRequest *OnLiteServerQueryResultRequest) Type() string {
+	return "onLiteServerQueryResult"
+}
+
+// MarshalJSON marshals the request with its @type
+func (onLiteServerQueryResultRequest *OnLiteServerQueryResultRequest) MarshalJSON() ([]byte, error) {
+	type fields OnLiteServerQueryResultRequest
+	return marshalRequest(onLiteServerQueryResultRequest.Type(), (*fields)(onLiteServerQueryResultRequest))
+}
 
-	var pchanPromise PchanPromise
-	err = json.Unmarshal(result.Raw, &pchanPromise)
-	return &pchanPromise, err
+// DecodeResponse decodes Ok returned by tonlib
+func (onLiteServerQueryResultRequest *OnLiteServerQueryResultRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks OnLiteServerQueryResultRequest before it is sent to tonlib
+func (onLiteServerQueryResultRequest *OnLiteServerQueryResultRequest) Validate() error {
+	if onLiteServerQueryResultRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // OnLiteServerQueryResult
 // @param bytes
 // @param id
-func (client *Client) OnLiteServerQueryResult(bytes []byte, id JSONInt64) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type  string    `json:"@type"`
-			Bytes []byte    `json:"bytes"`
-			Id    JSONInt64 `json:"id"`
-		}{
-			Type:  "onLiteServerQueryResult",
-			Bytes: bytes,
-			Id:    id,
-		},
-	)
+func (client *Client) OnLiteServerQueryResult(bytes Bytes, id JSONInt64) (*Ok, error) {
+	return Execute[*Ok](context.Background(), client, &OnLiteServerQueryResultRequest{
+		Bytes: bytes,
+		Id:    id,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// OnLiteServerQueryErrorRequest is onLiteServerQueryError function
+type OnLiteServerQueryErrorRequest struct {
+	Error Error     `json:"error"`
+	Id    JSONInt64 `json:"id"`
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (onLiteServerQueryErrorRequest *OnLiteServerQueryErrorRequest) Type() string {
+	return "onLiteServerQueryError"
+}
+
+// MarshalJSON marshals the request with its @type
+func (onLiteServerQueryErrorRequest *OnLiteServerQueryErrorRequest) MarshalJSON() ([]byte, error) {
+	type fields OnLiteServerQueryErrorRequest
+	return marshalRequest(onLiteServerQueryErrorRequest.Type(), (*fields)(onLiteServerQueryErrorRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes Ok returned by tonlib
+func (onLiteServerQueryErrorRequest *OnLiteServerQueryErrorRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks OnLiteServerQueryErrorRequest before it is sent to tonlib
+func (onLiteServerQueryErrorRequest *OnLiteServerQueryErrorRequest) Validate() error {
+	if onLiteServerQueryErrorRequest == nil {
+		return nil
+	}
+	if err := validate(&onLiteServerQueryErrorRequest.Error); err != nil {
+		return fmt.Errorf("onLiteServerQueryError: error: %w", err)
+	}
+	return nil
 }
 
 // OnLiteServerQueryError
 // @param error
 // @param id
 func (client *Client) OnLiteServerQueryError(error Error, id JSONInt64) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type  string    `json:"@type"`
-			Error Error     `json:"error"`
-			Id    JSONInt64 `json:"id"`
-		}{
-			Type:  "onLiteServerQueryError",
-			Error: error,
-			Id:    id,
-		},
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+	return Execute[*Ok](context.Background(), client, &OnLiteServerQueryErrorRequest{
+		Error: error,
+		Id:    id,
+	})
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// RunTestsRequest is runTests function
+type RunTestsRequest struct {
+	Dir string `json:"dir"`
+}
 
+// Type returns the tl name of the function
+func (runTestsRequest *RunTestsRequest) Type() string {
+	return "runTests"
 }
 
-// WithBlock
-// @param function
-// @param id
-func (client *Client) WithBlock(function Function, id TonBlockIdExt) (*Object, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type     string        `json:"@type"`
-			Function Function      `json:"function"`
-			Id       TonBlockIdExt `json:"id"`
-		}{
-			Type:     "withBlock",
-			Function: function,
-			Id:       id,
-		},
-	)
+// MarshalJSON marshals the request with its @type
+func (runTestsRequest *RunTestsRequest) MarshalJSON() ([]byte, error) {
+	type fields RunTestsRequest
+	return marshalRequest(runTestsRequest.Type(), (*fields)(runTestsRequest))
+}
 
-	if err != nil {
-		return nil, err
-	}
+// DecodeResponse decodes Ok returned by tonlib
+func (runTestsRequest *RunTestsRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
+// Validate checks RunTestsRequest before it is sent to tonlib
+func (runTestsRequest *RunTestsRequest) Validate() error {
+	if runTestsRequest == nil {
+		return nil
 	}
-
-	var object Object
-	err = json.Unmarshal(result.Raw, &object)
-	return &object, err
-
+	return nil
 }
 
 // RunTests
 // @param dir
 func (client *Client) RunTests(dir string) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Dir  string `json:"dir"`
-		}{
-			Type: "runTests",
-			Dir:  dir,
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &RunTestsRequest{
+		Dir: dir,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// LiteServerGetInfoRequest is liteServer.getInfo function
+type LiteServerGetInfoRequest struct {
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (liteServerGetInfoRequest *LiteServerGetInfoRequest) Type() string {
+	return "liteServer.getInfo"
+}
+
+// MarshalJSON marshals the request with its @type
+func (liteServerGetInfoRequest *LiteServerGetInfoRequest) MarshalJSON() ([]byte, error) {
+	type fields LiteServerGetInfoRequest
+	return marshalRequest(liteServerGetInfoRequest.Type(), (*fields)(liteServerGetInfoRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes LiteServerInfo returned by tonlib
+func (liteServerGetInfoRequest *LiteServerGetInfoRequest) DecodeResponse(raw []byte) (*LiteServerInfo, error) {
+	return decodeResponse[LiteServerInfo](raw)
+}
 
+// Validate checks LiteServerGetInfoRequest before it is sent to tonlib
+func (liteServerGetInfoRequest *LiteServerGetInfoRequest) Validate() error {
+	if liteServerGetInfoRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // LiteServerGetInfo
 func (client *Client) LiteServerGetInfo() (*LiteServerInfo, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-		}{
-			Type: "liteServer.getInfo",
-		},
-	)
+	return Execute[*LiteServerInfo](context.Background(), client, &LiteServerGetInfoRequest{})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// SetLogStreamRequest is setLogStream function Sets new log stream for internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
+type SetLogStreamRequest struct {
+	LogStream LogStream `json:"log_stream"` // New log stream
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (setLogStreamRequest *SetLogStreamRequest) Type() string {
+	return "setLogStream"
+}
+
+// MarshalJSON marshals the request with its @type
+func (setLogStreamRequest *SetLogStreamRequest) MarshalJSON() ([]byte, error) {
+	type fields SetLogStreamRequest
+	return marshalRequest(setLogStreamRequest.Type(), (*fields)(setLogStreamRequest))
+}
 
-	var liteServerInfo LiteServerInfo
-	err = json.Unmarshal(result.Raw, &liteServerInfo)
-	return &liteServerInfo, err
+// DecodeResponse decodes Ok returned by tonlib
+func (setLogStreamRequest *SetLogStreamRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks SetLogStreamRequest before it is sent to tonlib
+func (setLogStreamRequest *SetLogStreamRequest) Validate() error {
+	if setLogStreamRequest == nil {
+		return nil
+	}
+	if err := validate(setLogStreamRequest.LogStream); err != nil {
+		return fmt.Errorf("setLogStream: log_stream: %w", err)
+	}
+	return nil
 }
 
 // SetLogStream Sets new log stream for internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
 // @param logStream New log stream
 func (client *Client) SetLogStream(logStream LogStream) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type      string    `json:"@type"`
-			LogStream LogStream `json:"log_stream"`
-		}{
-			Type:      "setLogStream",
-			LogStream: logStream,
-		},
-	)
-
-	if err != nil {
-		return nil, err
-	}
+	return Execute[*Ok](context.Background(), client, &SetLogStreamRequest{
+		LogStream: logStream,
+	})
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// GetLogStreamRequest is getLogStream function Returns information about currently used log stream for internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
+type GetLogStreamRequest struct {
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// Type returns the tl name of the function
+func (getLogStreamRequest *GetLogStreamRequest) Type() string {
+	return "getLogStream"
+}
 
+// MarshalJSON marshals the request with its @type
+func (getLogStreamRequest *GetLogStreamRequest) MarshalJSON() ([]byte, error) {
+	type fields GetLogStreamRequest
+	return marshalRequest(getLogStreamRequest.Type(), (*fields)(getLogStreamRequest))
 }
 
-// GetLogStream Returns information about currently used log stream for internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
-func (client *Client) GetLogStream() (LogStream, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-		}{
-			Type: "getLogStream",
-		},
-	)
+// DecodeResponse decodes LogStream returned by tonlib
+func (getLogStreamRequest *GetLogStreamRequest) DecodeResponse(raw []byte) (LogStream, error) {
+	return unmarshalLogStream((*json.RawMessage)(&raw))
+}
 
-	if err != nil {
-		return nil, err
+// Validate checks GetLogStreamRequest before it is sent to tonlib
+func (getLogStreamRequest *GetLogStreamRequest) Validate() error {
+	if getLogStreamRequest == nil {
+		return nil
 	}
+	return nil
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// GetLogStream Returns information about currently used log stream for internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
+func (client *Client) GetLogStream() (LogStream, error) {
+	return Execute[LogStream](context.Background(), client, &GetLogStreamRequest{})
+}
 
-	switch LogStreamEnum(result.Data["@type"].(string)) {
+// SetLogVerbosityLevelRequest is setLogVerbosityLevel function Sets the verbosity level of the internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
+type SetLogVerbosityLevelRequest struct {
+	NewVerbosityLevel int32 `json:"new_verbosity_level"` // New value of the verbosity level for logging. Value 0 corresponds to fatal errors, value 1 corresponds to errors, value 2 corresponds to warnings and debug warnings, value 3 corresponds to informational, value 4 corresponds to debug, value 5 corresponds to verbose debug, value greater than 5 and up to 1023 can be used to enable even more logging
+}
 
-	case LogStreamDefaultType:
-		var logStream LogStreamDefault
-		err = json.Unmarshal(result.Raw, &logStream)
-		return &logStream, err
+// Type returns the tl name of the function
+func (setLogVerbosityLevelRequest *SetLogVerbosityLevelRequest) Type() string {
+	return "setLogVerbosityLevel"
+}
 
-	case LogStreamFileType:
-		var logStream LogStreamFile
-		err = json.Unmarshal(result.Raw, &logStream)
-		return &logStream, err
+// MarshalJSON marshals the request with its @type
+func (setLogVerbosityLevelRequest *SetLogVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type fields SetLogVerbosityLevelRequest
+	return marshalRequest(setLogVerbosityLevelRequest.Type(), (*fields)(setLogVerbosityLevelRequest))
+}
 
-	case LogStreamEmptyType:
-		var logStream LogStreamEmpty
-		err = json.Unmarshal(result.Raw, &logStream)
-		return &logStream, err
+// DecodeResponse decodes Ok returned by tonlib
+func (setLogVerbosityLevelRequest *SetLogVerbosityLevelRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
-	default:
-		return nil, fmt.Errorf("Invalid type")
+// Validate checks SetLogVerbosityLevelRequest before it is sent to tonlib
+func (setLogVerbosityLevelRequest *SetLogVerbosityLevelRequest) Validate() error {
+	if setLogVerbosityLevelRequest == nil {
+		return nil
 	}
+	return nil
 }
 
 // SetLogVerbosityLevel Sets the verbosity level of the internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
 // @param newVerbosityLevel New value of the verbosity level for logging. Value 0 corresponds to fatal errors, value 1 corresponds to errors, value 2 corresponds to warnings and debug warnings, value 3 corresponds to informational, value 4 corresponds to debug, value 5 corresponds to verbose debug, value greater than 5 and up to 1023 can be used to enable even more logging
 func (client *Client) SetLogVerbosityLevel(newVerbosityLevel int32) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type              string `json:"@type"`
-			NewVerbosityLevel int32  `json:"new_verbosity_level"`
-		}{
-			Type:              "setLogVerbosityLevel",
-			NewVerbosityLevel: newVerbosityLevel,
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &SetLogVerbosityLevelRequest{
+		NewVerbosityLevel: newVerbosityLevel,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GetLogVerbosityLevelRequest is getLogVerbosityLevel function Returns current verbosity level of the internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
+type GetLogVerbosityLevelRequest struct {
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (getLogVerbosityLevelRequest *GetLogVerbosityLevelRequest) Type() string {
+	return "getLogVerbosityLevel"
+}
+
+// MarshalJSON marshals the request with its @type
+func (getLogVerbosityLevelRequest *GetLogVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type fields GetLogVerbosityLevelRequest
+	return marshalRequest(getLogVerbosityLevelRequest.Type(), (*fields)(getLogVerbosityLevelRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes LogVerbosityLevel returned by tonlib
+func (getLogVerbosityLevelRequest *GetLogVerbosityLevelRequest) DecodeResponse(raw []byte) (*LogVerbosityLevel, error) {
+	return decodeResponse[LogVerbosityLevel](raw)
+}
 
+// Validate checks GetLogVerbosityLevelRequest before it is sent to tonlib
+func (getLogVerbosityLevelRequest *GetLogVerbosityLevelRequest) Validate() error {
+	if getLogVerbosityLevelRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // GetLogVerbosityLevel Returns current verbosity level of the internal logging of tonlib. This is an offline method. Can be called before authorization. Can be called synchronously
 func (client *Client) GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-		}{
-			Type: "getLogVerbosityLevel",
-		},
-	)
+	return Execute[*LogVerbosityLevel](context.Background(), client, &GetLogVerbosityLevelRequest{})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GetLogTagsRequest is getLogTags function Returns list of available tonlib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. This is an offline method. Can be called before authorization. Can be called synchronously
+type GetLogTagsRequest struct {
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (getLogTagsRequest *GetLogTagsRequest) Type() string {
+	return "getLogTags"
+}
+
+// MarshalJSON marshals the request with its @type
+func (getLogTagsRequest *GetLogTagsRequest) MarshalJSON() ([]byte, error) {
+	type fields GetLogTagsRequest
+	return marshalRequest(getLogTagsRequest.Type(), (*fields)(getLogTagsRequest))
+}
 
-	var logVerbosityLevel LogVerbosityLevel
-	err = json.Unmarshal(result.Raw, &logVerbosityLevel)
-	return &logVerbosityLevel, err
+// DecodeResponse decodes LogTags returned by tonlib
+func (getLogTagsRequest *GetLogTagsRequest) DecodeResponse(raw []byte) (*LogTags, error) {
+	return decodeResponse[LogTags](raw)
+}
 
+// Validate checks GetLogTagsRequest before it is sent to tonlib
+func (getLogTagsRequest *GetLogTagsRequest) Validate() error {
+	if getLogTagsRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // GetLogTags Returns list of available tonlib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. This is an offline method. Can be called before authorization. Can be called synchronously
 func (client *Client) GetLogTags() (*LogTags, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-		}{
-			Type: "getLogTags",
-		},
-	)
+	return Execute[*LogTags](context.Background(), client, &GetLogTagsRequest{})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// SetLogTagVerbosityLevelRequest is setLogTagVerbosityLevel function Sets the verbosity level for a specified tonlib internal log tag. This is an offline method. Can be called before authorization. Can be called synchronously
+type SetLogTagVerbosityLevelRequest struct {
+	NewVerbosityLevel int32  `json:"new_verbosity_level"` // New verbosity level; 1-1024
+	Tag               string `json:"tag"`                 // Logging tag to change verbosity level
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (setLogTagVerbosityLevelRequest *SetLogTagVerbosityLevelRequest) Type() string {
+	return "setLogTagVerbosityLevel"
+}
 
-	var logTags LogTags
-	err = json.Unmarshal(result.Raw, &logTags)
-	return &logTags, err
+// MarshalJSON marshals the request with its @type
+func (setLogTagVerbosityLevelRequest *SetLogTagVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type fields SetLogTagVerbosityLevelRequest
+	return marshalRequest(setLogTagVerbosityLevelRequest.Type(), (*fields)(setLogTagVerbosityLevelRequest))
+}
+
+// DecodeResponse decodes Ok returned by tonlib
+func (setLogTagVerbosityLevelRequest *SetLogTagVerbosityLevelRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks SetLogTagVerbosityLevelRequest before it is sent to tonlib
+func (setLogTagVerbosityLevelRequest *SetLogTagVerbosityLevelRequest) Validate() error {
+	if setLogTagVerbosityLevelRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // SetLogTagVerbosityLevel Sets the verbosity level for a specified tonlib internal log tag. This is an offline method. Can be called before authorization. Can be called synchronously
 // @param newVerbosityLevel New verbosity level; 1-1024
 // @param tag Logging tag to change verbosity level
 func (client *Client) SetLogTagVerbosityLevel(newVerbosityLevel int32, tag string) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type              string `json:"@type"`
-			NewVerbosityLevel int32  `json:"new_verbosity_level"`
-			Tag               string `json:"tag"`
-		}{
-			Type:              "setLogTagVerbosityLevel",
-			NewVerbosityLevel: newVerbosityLevel,
-			Tag:               tag,
-		},
-	)
+	return Execute[*Ok](context.Background(), client, &SetLogTagVerbosityLevelRequest{
+		NewVerbosityLevel: newVerbosityLevel,
+		Tag:               tag,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// GetLogTagVerbosityLevelRequest is getLogTagVerbosityLevel function Returns current verbosity level for a specified tonlib internal log tag. This is an offline method. Can be called before authorization. Can be called synchronously
+type GetLogTagVerbosityLevelRequest struct {
+	Tag string `json:"tag"` // Logging tag to change verbosity level
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (getLogTagVerbosityLevelRequest *GetLogTagVerbosityLevelRequest) Type() string {
+	return "getLogTagVerbosityLevel"
+}
+
+// MarshalJSON marshals the request with its @type
+func (getLogTagVerbosityLevelRequest *GetLogTagVerbosityLevelRequest) MarshalJSON() ([]byte, error) {
+	type fields GetLogTagVerbosityLevelRequest
+	return marshalRequest(getLogTagVerbosityLevelRequest.Type(), (*fields)(getLogTagVerbosityLevelRequest))
+}
 
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
+// DecodeResponse decodes LogVerbosityLevel returned by tonlib
+func (getLogTagVerbosityLevelRequest *GetLogTagVerbosityLevelRequest) DecodeResponse(raw []byte) (*LogVerbosityLevel, error) {
+	return decodeResponse[LogVerbosityLevel](raw)
+}
 
+// Validate checks GetLogTagVerbosityLevelRequest before it is sent to tonlib
+func (getLogTagVerbosityLevelRequest *GetLogTagVerbosityLevelRequest) Validate() error {
+	if getLogTagVerbosityLevelRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // GetLogTagVerbosityLevel Returns current verbosity level for a specified tonlib internal log tag. This is an offline method. Can be called before authorization. Can be called synchronously
 // @param tag Logging tag to change verbosity level
 func (client *Client) GetLogTagVerbosityLevel(tag string) (*LogVerbosityLevel, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type string `json:"@type"`
-			Tag  string `json:"tag"`
-		}{
-			Type: "getLogTagVerbosityLevel",
-			Tag:  tag,
-		},
-	)
+	return Execute[*LogVerbosityLevel](context.Background(), client, &GetLogTagVerbosityLevelRequest{
+		Tag: tag,
+	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// AddLogMessageRequest is addLogMessage function Adds a message to tonlib internal log. This is an offline method. Can be called before authorization. Can be called synchronously
+type AddLogMessageRequest struct {
+	Text           string `json:"text"`            // Text of a message to log
+	VerbosityLevel int32  `json:"verbosity_level"` // Minimum verbosity level needed for the message to be logged, 0-1023
+}
 
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
+// Type returns the tl name of the function
+func (addLogMessageRequest *AddLogMessageRequest) Type() string {
+	return "addLogMessage"
+}
+
+// MarshalJSON marshals the request with its @type
+func (addLogMessageRequest *AddLogMessageRequest) MarshalJSON() ([]byte, error) {
+	type fields AddLogMessageRequest
+	return marshalRequest(addLogMessageRequest.Type(), (*fields)(addLogMessageRequest))
+}
 
-	var logVerbosityLevel LogVerbosityLevel
-	err = json.Unmarshal(result.Raw, &logVerbosityLevel)
-	return &logVerbosityLevel, err
+// DecodeResponse decodes Ok returned by tonlib
+func (addLogMessageRequest *AddLogMessageRequest) DecodeResponse(raw []byte) (*Ok, error) {
+	return decodeResponse[Ok](raw)
+}
 
+// Validate checks AddLogMessageRequest before it is sent to tonlib
+func (addLogMessageRequest *AddLogMessageRequest) Validate() error {
+	if addLogMessageRequest == nil {
+		return nil
+	}
+	return nil
 }
 
 // AddLogMessage Adds a message to tonlib internal log. This is an offline method. Can be called before authorization. Can be called synchronously
 // @param text Text of a message to log
 // @param verbosityLevel Minimum verbosity level needed for the message to be logged, 0-1023
 func (client *Client) AddLogMessage(text string, verbosityLevel int32) (*Ok, error) {
-	result, err := client.executeAsynchronously(
-		struct {
-			Type           string `json:"@type"`
-			Text           string `json:"text"`
-			VerbosityLevel int32  `json:"verbosity_level"`
-		}{
-			Type:           "addLogMessage",
-			Text:           text,
-			VerbosityLevel: verbosityLevel,
-		},
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if result.Data["@type"].(string) == "error" {
-		return nil, fmt.Errorf("error! code: %d msg: %s", result.Data["code"], result.Data["message"])
-	}
-
-	var ok Ok
-	err = json.Unmarshal(result.Raw, &ok)
-	return &ok, err
-
+	return Execute[*Ok](context.Background(), client, &AddLogMessageRequest{
+		Text:           text,
+		VerbosityLevel: verbosityLevel,
+	})
 }
@@ -0,0 +1,178 @@
+// Package mnemonic generates, validates and derives ed25519 keys from TON mnemonic
+// phrases the same way tonlib does, without the C library.
+//
+// TON phrases use the BIP39 english word list but not the BIP39 checksum: a phrase is
+// valid when a PBKDF2 of its HMAC-SHA512 entropy starts with a zero byte, and a phrase
+// made for a mnemonic password is additionally marked so it can't be used without one.
+package mnemonic
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// DefaultWordsCount is the phrase length tonlib's CreateNewKey produces
+	DefaultWordsCount = 24
+
+	seedSalt              = "TON default seed"
+	seedIterations        = 100000
+	basicSeedSalt         = "TON seed version"
+	basicSeedIterations   = seedIterations / 256
+	passwordSeedSalt      = "TON fast seed version"
+	passwordSeedIteration = 1
+)
+
+var (
+	ErrUnknownWord      = errors.New("unknown mnemonic word")
+	ErrInvalidMnemonic  = errors.New("invalid mnemonic")
+	ErrPasswordRequired = errors.New("mnemonic requires password")
+	ErrPasswordNotUsed  = errors.New("mnemonic is not protected by password")
+)
+
+var (
+	words     = wordlists.English
+	wordIndex = map[string]int{}
+)
+
+func init() {
+	for i, word := range words {
+		wordIndex[word] = i
+	}
+}
+
+// Words returns the word list phrases are built of
+func Words() []string {
+	return append([]string{}, words...)
+}
+
+// Hints returns words starting with prefix, like GetBip39Hints
+func Hints(prefix string) []string {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	start := sort.SearchStrings(words, prefix)
+	var hints []string
+	for i := start; i < len(words) && strings.HasPrefix(words[i], prefix); i++ {
+		hints = append(hints, words[i])
+	}
+	return hints
+}
+
+// Normalize lowercases words and drops extra whitespace
+func Normalize(words []string) []string {
+	return strings.Fields(strings.ToLower(strings.Join(words, " ")))
+}
+
+// Generate creates a new phrase of wordsCount words. Non-empty password produces a phrase
+// that is valid only together with that password, as CreateNewKey with mnemonic_password does
+func Generate(wordsCount int, password string) ([]string, error) {
+	if wordsCount <= 0 {
+		return nil, fmt.Errorf("invalid words count: %d", wordsCount)
+	}
+	for {
+		phrase, err := randomWords(wordsCount)
+		if err != nil {
+			return nil, err
+		}
+		if password != "" && !IsPasswordNeeded(phrase) {
+			continue
+		}
+		if !isBasicSeed(Entropy(phrase, password)) {
+			continue
+		}
+		return phrase, nil
+	}
+}
+
+// Validate checks that all words are known and the phrase is a valid TON mnemonic for the password
+func Validate(phrase []string, password string) error {
+	phrase = Normalize(phrase)
+	if len(phrase) == 0 {
+		return ErrInvalidMnemonic
+	}
+	for _, word := range phrase {
+		if _, ok := wordIndex[word]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownWord, word)
+		}
+	}
+	if password == "" {
+		if !isBasicSeed(Entropy(phrase, "")) {
+			if IsPasswordNeeded(phrase) {
+				return ErrPasswordRequired
+			}
+			return ErrInvalidMnemonic
+		}
+		return nil
+	}
+	if !IsPasswordNeeded(phrase) {
+		return ErrPasswordNotUsed
+	}
+	if !isBasicSeed(Entropy(phrase, password)) {
+		return ErrInvalidMnemonic
+	}
+	return nil
+}
+
+// IsPasswordNeeded reports whether the phrase has been generated for a mnemonic password
+func IsPasswordNeeded(phrase []string) bool {
+	entropy := Entropy(phrase, "")
+	return isPasswordSeed(entropy) && !isBasicSeed(entropy)
+}
+
+// Entropy returns HMAC-SHA512 of the password keyed by the phrase, used by all TON derivations
+func Entropy(phrase []string, password string) []byte {
+	mac := hmac.New(sha512.New, []byte(strings.Join(Normalize(phrase), " ")))
+	mac.Write([]byte(password))
+	return mac.Sum(nil)
+}
+
+// ToSeed derives 64 bytes seed from the phrase, first 32 bytes are the ed25519 seed
+func ToSeed(phrase []string, password string) []byte {
+	return pbkdf2.Key(Entropy(phrase, password), []byte(seedSalt), seedIterations, 64, sha512.New)
+}
+
+// ToPrivateKey derives ed25519 private key from the phrase
+func ToPrivateKey(phrase []string, password string) ed25519.PrivateKey {
+	seed := ToSeed(phrase, password)
+	return ed25519.NewKeyFromSeed(seed[:ed25519.SeedSize])
+}
+
+// NewKey validates the phrase and derives its private key
+func NewKey(phrase []string, password string) (ed25519.PrivateKey, error) {
+	if err := Validate(phrase, password); err != nil {
+		return nil, err
+	}
+	return ToPrivateKey(phrase, password), nil
+}
+
+func isBasicSeed(entropy []byte) bool {
+	seed := pbkdf2.Key(entropy, []byte(basicSeedSalt), basicSeedIterations, 64, sha512.New)
+	return seed[0] == 0
+}
+
+func isPasswordSeed(entropy []byte) bool {
+	seed := pbkdf2.Key(entropy, []byte(passwordSeedSalt), passwordSeedIteration, 64, sha512.New)
+	return seed[0] == 1
+}
+
+func randomWords(count int) ([]string, error) {
+	buf := make([]byte, 2*count)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	phrase := make([]string, count)
+	for i := range phrase {
+		// the list has exactly 2048 words, so 11 bits give a uniform index
+		phrase[i] = words[binary.BigEndian.Uint16(buf[2*i:])&0x7ff]
+	}
+	return phrase, nil
+}
@@ -0,0 +1,77 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// vectors are regression vectors of this package, the derivation is checked against tonlib itself by
+// TestClient_Mnemonic of the client package, which derives keys from phrases created and exported by tonlib
+var vectors = []struct {
+	phrase   string
+	password string
+	seed     string
+}{
+	{
+		phrase: "lizard combine exclude other spatial find bullet pledge friend assume bulb obtain " +
+			"sibling crater phrase employ spy planet lottery scare digital box guilt kingdom",
+		seed: "f74c8cd86bfe901f80beb2ceb682790b45921a39ae58b94f86466842e29b0ab4" +
+			"1a5e87d626e4859764b0179b45f5fc89dce5c5f9b57accc778e242f6d7923179",
+	},
+	{
+		phrase: "boat pretty soap dragon december pony soldier bacon bike cheese square car " +
+			"goose warm rotate brisk enroll camera typical alley bright chalk extra spike",
+		password: "secret",
+		seed: "cbb531e8b018de6f3655d7654cb2dbbaac3946d37a3547a32f2c3ea874cf6297" +
+			"073504d058c7a6cebb41a0fbe8a035c9d7eaa2ec1a28ef7b4ddaa5a68019e9ab",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		phrase := strings.Fields(v.phrase)
+		if err := Validate(phrase, v.password); err != nil {
+			t.Fatalf("%s: %v", v.phrase, err)
+		}
+		if seed := hex.EncodeToString(ToSeed(phrase, v.password)); seed != v.seed {
+			t.Fatalf("%s: unexpected seed %s", v.phrase, seed)
+		}
+	}
+
+	protected := strings.Fields(vectors[1].phrase)
+	if err := Validate(protected, ""); err != ErrPasswordRequired {
+		t.Fatalf("expected password required error, got %v", err)
+	}
+	if err := Validate(protected, "wrong"); err == nil {
+		t.Fatal("expected error for wrong password")
+	}
+	if err := Validate(strings.Fields(vectors[0].phrase), "secret"); err != ErrPasswordNotUsed {
+		t.Fatalf("expected password not used error, got %v", err)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	phrase, err := Generate(DefaultWordsCount, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(phrase) != DefaultWordsCount {
+		t.Fatalf("unexpected words count: %d", len(phrase))
+	}
+	if err := Validate(phrase, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	phrase[0] = "notaword"
+	if err := Validate(phrase, ""); err == nil {
+		t.Fatal("expected unknown word error")
+	}
+}
+
+func TestHints(t *testing.T) {
+	hints := Hints("zo")
+	if len(hints) != 2 || hints[0] != "zone" || hints[1] != "zoo" {
+		t.Fatalf("unexpected hints: %v", hints)
+	}
+}
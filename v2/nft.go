@@ -0,0 +1,99 @@
+package v2
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/metadata"
+	"github.com/mercuryoio/tonlib-go/v2/nft"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+// GetNftCollectionData returns NFT collection data
+func (client *Client) GetNftCollectionData(collection string) (*nft.CollectionData, error) {
+	stack, err := client.runGetMethodValues(collection, nft.GetCollectionDataMethod)
+	if err != nil {
+		return nil, err
+	}
+	return nft.ParseCollectionData(stack)
+}
+
+// GetNftAddressByIndex returns address of the collection's item with the index
+func (client *Client) GetNftAddressByIndex(collection string, index *big.Int) (*address.Address, error) {
+	stack, err := client.runGetMethodValues(collection, nft.GetNftAddressByIndexMethod, tvm.NumberEntry(index))
+	if err != nil {
+		return nil, err
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%s returned %d values, expected 1", nft.GetNftAddressByIndexMethod, len(stack))
+	}
+	return tvm.Address(stack[0])
+}
+
+// GetNftData returns NFT item data
+func (client *Client) GetNftData(item string) (*nft.ItemData, error) {
+	stack, err := client.runGetMethodValues(item, nft.GetNftDataMethod)
+	if err != nil {
+		return nil, err
+	}
+	return nft.ParseItemData(stack)
+}
+
+// GetNftContent returns full content of the collection's item from its index and individual content
+func (client *Client) GetNftContent(collection string, index *big.Int, individualContent *cell.Cell) (*metadata.Content, error) {
+	stack, err := client.runGetMethodValues(collection, nft.GetNftContentMethod,
+		tvm.NumberEntry(index),
+		tvm.CellEntry(individualContent),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("%s returned %d values, expected 1", nft.GetNftContentMethod, len(stack))
+	}
+	content, err := tvm.Cell(stack[0])
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Parse(content)
+}
+
+// GetNftItemContent returns item metadata, asking the item's collection for the full content if it has one
+func (client *Client) GetNftItemContent(item string) (*metadata.Content, error) {
+	data, err := client.GetNftData(item)
+	if err != nil {
+		return nil, err
+	}
+	if data.IndividualContent == nil {
+		return nil, fmt.Errorf("nft item %s is not initialized", item)
+	}
+	if data.Collection == nil {
+		return metadata.Parse(data.IndividualContent)
+	}
+	return client.GetNftContent(data.Collection.String(), data.Index, data.IndividualContent)
+}
+
+// GetNftCollectionContent returns collection metadata
+func (client *Client) GetNftCollectionContent(collection string) (*metadata.Content, error) {
+	data, err := client.GetNftCollectionData(collection)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Parse(data.Content)
+}
+
+// NewNftTransferMessage builds the message the owner's wallet sends to the item, to be used
+// in ActionMsg. The forward amount and fee are attached, nil fee means nft.DefaultTransferFee
+func NewNftTransferMessage(item *address.Address, transfer nft.Transfer, fee *big.Int) (*MsgMessage, error) {
+	body, err := transfer.Body()
+	if err != nil {
+		return nil, err
+	}
+	amount := transfer.AttachedAmount(fee)
+	if !amount.IsInt64() {
+		return nil, fmt.Errorf("attached amount is too big: %s", amount)
+	}
+	return NewMsgMessage(JSONInt64(amount.Int64()), rawMsgData(body), NewAccountAddress(item.String()), ""), nil
+}
@@ -0,0 +1,159 @@
+// Package nft implements TEP-62 non-fungible tokens: decoding of get method results
+// of NFT collections and items and building of transfer message bodies.
+package nft
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+const (
+	OpTransfer          = 0x5fcc3d14
+	OpOwnershipAssigned = 0x05138d91
+	OpExcesses          = 0xd53276db
+	OpGetStaticData     = 0x2fcb26a2
+	OpReportStaticData  = 0x8b771735
+
+	GetCollectionDataMethod    = "get_collection_data"
+	GetNftAddressByIndexMethod = "get_nft_address_by_index"
+	GetNftContentMethod        = "get_nft_content"
+	GetNftDataMethod           = "get_nft_data"
+
+	// DefaultTransferFee is attached to the transfer on top of the forward amount
+	// to pay for the item's processing, nanograms
+	DefaultTransferFee = 50000000
+)
+
+// CollectionData is the result of get_collection_data of an NFT collection
+type CollectionData struct {
+	// NextItemIndex is the index of the next item to mint, -1 for collections with arbitrary indexes
+	NextItemIndex *big.Int
+	Content       *cell.Cell
+	Owner         *address.Address
+}
+
+// ParseCollectionData decodes get_collection_data stack
+func ParseCollectionData(stack []interface{}) (*CollectionData, error) {
+	if len(stack) != 3 {
+		return nil, fmt.Errorf("%s returned %d values, expected 3", GetCollectionDataMethod, len(stack))
+	}
+	var data CollectionData
+	var err error
+	if data.NextItemIndex, err = tvm.Number(stack[0]); err != nil {
+		return nil, fmt.Errorf("invalid next item index: %v", err)
+	}
+	if data.Content, err = tvm.Cell(stack[1]); err != nil {
+		return nil, fmt.Errorf("invalid collection content: %v", err)
+	}
+	if data.Owner, err = tvm.Address(stack[2]); err != nil {
+		return nil, fmt.Errorf("invalid owner: %v", err)
+	}
+	return &data, nil
+}
+
+// ItemData is the result of get_nft_data of an NFT item
+type ItemData struct {
+	Initialized bool
+	Index       *big.Int
+	// Collection is nil for items without a collection
+	Collection *address.Address
+	Owner      *address.Address
+	// IndividualContent is the full content for items without a collection,
+	// otherwise it has to be passed to the collection's get_nft_content
+	IndividualContent *cell.Cell
+}
+
+// ParseItemData decodes get_nft_data stack
+func ParseItemData(stack []interface{}) (*ItemData, error) {
+	if len(stack) != 5 {
+		return nil, fmt.Errorf("%s returned %d values, expected 5", GetNftDataMethod, len(stack))
+	}
+	var data ItemData
+	var err error
+	if data.Initialized, err = tvm.Bool(stack[0]); err != nil {
+		return nil, fmt.Errorf("invalid init flag: %v", err)
+	}
+	if data.Index, err = tvm.Number(stack[1]); err != nil {
+		return nil, fmt.Errorf("invalid index: %v", err)
+	}
+	if data.Collection, err = tvm.Address(stack[2]); err != nil {
+		return nil, fmt.Errorf("invalid collection address: %v", err)
+	}
+	// owner and content are null until the item is initialized
+	if !tvm.IsNull(stack[3]) {
+		if data.Owner, err = tvm.Address(stack[3]); err != nil {
+			return nil, fmt.Errorf("invalid owner: %v", err)
+		}
+	}
+	if !tvm.IsNull(stack[4]) {
+		if data.IndividualContent, err = tvm.Cell(stack[4]); err != nil {
+			return nil, fmt.Errorf("invalid individual content: %v", err)
+		}
+	}
+	return &data, nil
+}
+
+// Transfer is the ownership transfer request sent by the owner to the item
+type Transfer struct {
+	QueryID  uint64
+	NewOwner *address.Address
+	// ResponseDestination receives the excess of attached TON, usually the sender
+	ResponseDestination *address.Address
+	CustomPayload       *cell.Cell
+	// ForwardAmount is sent to the new owner with ownership_assigned notification, nanograms
+	ForwardAmount *big.Int
+	// ForwardPayload is delivered with the notification, e.g. wallet.BuildComment
+	ForwardPayload *cell.Cell
+}
+
+// Body builds transfer#5fcc3d14 message body
+func (t Transfer) Body() (*cell.Cell, error) {
+	if t.NewOwner == nil {
+		return nil, fmt.Errorf("empty new owner")
+	}
+	forward := t.ForwardAmount
+	if forward == nil {
+		forward = new(big.Int)
+	}
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(OpTransfer, 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(t.QueryID, 64); err != nil {
+		return nil, err
+	}
+	if err := b.StoreAddress(t.NewOwner); err != nil {
+		return nil, err
+	}
+	if err := b.StoreAddress(t.ResponseDestination); err != nil {
+		return nil, err
+	}
+	if err := b.StoreMaybeRef(t.CustomPayload); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBigCoins(forward); err != nil {
+		return nil, err
+	}
+	// forward_payload:(Either Cell ^Cell), kept in a reference when present
+	if err := b.StoreMaybeRef(t.ForwardPayload); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// AttachedAmount returns TON amount the owner has to send to the item with the transfer:
+// the forward amount plus the fee for processing
+func (t Transfer) AttachedAmount(fee *big.Int) *big.Int {
+	amount := new(big.Int)
+	if t.ForwardAmount != nil {
+		amount.Set(t.ForwardAmount)
+	}
+	if fee == nil {
+		fee = big.NewInt(DefaultTransferFee)
+	}
+	return amount.Add(amount, fee)
+}
@@ -0,0 +1,76 @@
+package nft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+func TestTransferBody(t *testing.T) {
+	owner := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	transfer := Transfer{QueryID: 7, NewOwner: owner, ForwardAmount: big.NewInt(1)}
+	body, err := transfer.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := body.BeginParse()
+	op, _ := s.LoadUInt(32)
+	queryID, _ := s.LoadUInt(64)
+	newOwner, _ := s.LoadAddress()
+	response, _ := s.LoadAddress()
+	custom, _ := s.LoadMaybeRef()
+	forward, _ := s.LoadCoins()
+	payload, err := s.LoadMaybeRef()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op != OpTransfer || queryID != 7 || !newOwner.Equal(owner) || response != nil || custom != nil ||
+		forward.Int64() != 1 || payload != nil || s.BitsLeft() != 0 {
+		t.Fatalf("unexpected body: %v", body)
+	}
+	if attached := transfer.AttachedAmount(big.NewInt(10)); attached.Int64() != 11 {
+		t.Fatalf("unexpected attached amount: %s", attached)
+	}
+	if _, err := (Transfer{}).Body(); err == nil {
+		t.Fatal("expected error for empty new owner")
+	}
+}
+
+func TestParseItemData(t *testing.T) {
+	collection := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	collectionEntry, err := tvm.AddressEntry(collection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := cell.NewBuilder().EndCell()
+	null := tvm.ListEntry{}
+
+	data, err := ParseItemData([]interface{}{
+		tvm.NumberEntry(big.NewInt(0)),
+		tvm.NumberEntry(big.NewInt(12)),
+		collectionEntry,
+		null,
+		null,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.Initialized || data.Index.Int64() != 12 || !data.Collection.Equal(collection) || data.Owner != nil || data.IndividualContent != nil {
+		t.Fatalf("unexpected item data: %#v", data)
+	}
+
+	collectionData, err := ParseCollectionData([]interface{}{tvm.NumberEntry(big.NewInt(-1)), tvm.CellEntry(content), collectionEntry})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if collectionData.NextItemIndex.Int64() != -1 || !collectionData.Owner.Equal(collection) {
+		t.Fatalf("unexpected collection data: %#v", collectionData)
+	}
+	if _, err := ParseCollectionData([]interface{}{collectionEntry, tvm.CellEntry(content), collectionEntry}); err == nil {
+		t.Fatal("expected error for invalid index")
+	}
+}
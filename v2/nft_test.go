@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/nft"
+)
+
+func TestNewNftTransferMessage(t *testing.T) {
+	item := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	msg, err := NewNftTransferMessage(item, nft.Transfer{NewOwner: item}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Amount != nft.DefaultTransferFee || msg.Destination.AccountAddress != item.String() {
+		t.Fatalf("unexpected message: %#v", msg)
+	}
+	if _, ok := msg.Data.(*MsgDataRaw); !ok {
+		t.Fatalf("unexpected message data: %#v", msg.Data)
+	}
+	if _, err := NewNftTransferMessage(item, nft.Transfer{}, nil); err == nil {
+		t.Fatal("expected error for empty new owner")
+	}
+}
@@ -0,0 +1,318 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/signer"
+)
+
+// DefaultPchanQueryTimeout is the validity of pchan queries in seconds
+const DefaultPchanQueryTimeout = 60
+
+// PchanSide is a party of the payment channel
+type PchanSide int
+
+const (
+	PchanAlice PchanSide = iota
+	PchanBob
+)
+
+func (side PchanSide) String() string {
+	if side == PchanAlice {
+		return "alice"
+	}
+	return "bob"
+}
+
+var (
+	ErrStalePromise   = errors.New("promise is older than the last known one")
+	ErrNoPromise      = errors.New("no promise signed by counterparty")
+	ErrChannelClosed  = errors.New("channel is already paid out")
+	ErrNotChannelSide = errors.New("key belongs to neither side of the channel")
+)
+
+// Channel drives a payment channel: deployment and init, off-chain promises,
+// cooperative close and timeout. It is safe for concurrent use
+type Channel struct {
+	client       *Client
+	config       PchanConfig
+	side         PchanSide
+	key          InputKey
+	QueryTimeout int32
+
+	mu           sync.Mutex
+	address      *AccountAddress
+	state        *PchanAccountState
+	ourPromise   *PchanPromise
+	theirPromise *PchanPromise
+}
+
+// NewChannel creates channel manager for the config. The side is detected by the key's public key
+func NewChannel(client *Client, config PchanConfig, key InputKey) (*Channel, error) {
+	var side PchanSide
+	switch key.Key.PublicKey {
+	case config.AlicePublicKey:
+		side = PchanAlice
+	case config.BobPublicKey:
+		side = PchanBob
+	default:
+		return nil, ErrNotChannelSide
+	}
+	config.tonCommon = tonCommon{Type: "pchan.config"}
+	return &Channel{
+		client:       client,
+		config:       config,
+		side:         side,
+		key:          key,
+		QueryTimeout: DefaultPchanQueryTimeout,
+	}, nil
+}
+
+// Config returns channel config
+func (c *Channel) Config() PchanConfig {
+	return c.config
+}
+
+// Side returns the side the channel's key belongs to
+func (c *Channel) Side() PchanSide {
+	return c.side
+}
+
+// Address returns the channel contract address, it is computed by tonlib once
+func (c *Channel) Address() (*AccountAddress, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getAddress()
+}
+
+func (c *Channel) getAddress() (*AccountAddress, error) {
+	if c.address != nil {
+		return c.address, nil
+	}
+	var workchain int32
+	if c.config.AliceAddress != nil {
+		addr, err := address.Parse(c.config.AliceAddress.AccountAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid alice address: %v", err)
+		}
+		workchain = addr.Workchain
+	}
+	addr, err := c.client.GetAccountAddress(c.initialState(), 0, workchain)
+	if err != nil {
+		return nil, err
+	}
+	c.address = addr
+	return addr, nil
+}
+
+func (c *Channel) initialState() *PchanInitialAccountState {
+	config := c.config
+	return NewPchanInitialAccountState(&config)
+}
+
+// Refresh loads channel state from the blockchain. Nil state means the channel is not deployed yet
+func (c *Channel) Refresh() (*PchanAccountState, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	addr, err := c.getAddress()
+	if err != nil {
+		return nil, err
+	}
+	state, err := c.client.PchanGetAccountState(*addr)
+	if err != nil {
+		return nil, err
+	}
+	c.state = state
+	return state, nil
+}
+
+// State returns the state loaded by the last Refresh
+func (c *Channel) State() *PchanAccountState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+// OurPromise returns the latest promise signed by this side
+func (c *Channel) OurPromise() *PchanPromise {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ourPromise
+}
+
+// TheirPromise returns the latest validated promise signed by the counterparty
+func (c *Channel) TheirPromise() *PchanPromise {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.theirPromise
+}
+
+// Init deploys the channel if needed and signs its init state by this side.
+// incA and incB are the amounts the sides deposit, minA and minB the amounts they expect from the other side
+func (c *Channel) Init(incA, incB, minA, minB int64) (*QueryInfo, error) {
+	return c.sendAction(NewPchanActionInit(JSONInt64(incA), JSONInt64(incB), JSONInt64(minA), JSONInt64(minB)))
+}
+
+// Close cooperatively closes the channel with the latest counterparty promise.
+// extraA and extraB are added to the promised amounts, e.g. the payment of this side it hasn't promised off-chain
+func (c *Channel) Close(extraA, extraB int64) (*QueryInfo, error) {
+	promise := c.TheirPromise()
+	if promise == nil {
+		return nil, ErrNoPromise
+	}
+	return c.sendAction(NewPchanActionClose(JSONInt64(extraA), JSONInt64(extraB), promise))
+}
+
+// Timeout finishes the channel when the other side hasn't signed init or close in time
+func (c *Channel) Timeout() (*QueryInfo, error) {
+	return c.sendAction(NewPchanActionTimeout())
+}
+
+func (c *Channel) sendAction(action PchanAction) (*QueryInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state != nil {
+		if _, ok := c.state.State.(*PchanStatePayout); ok {
+			return nil, ErrChannelClosed
+		}
+	}
+	addr, err := c.getAddress()
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.client.CreateQuery(NewActionPchan(action), *addr, c.initialState(), c.key, c.QueryTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s query: %v", action.MessageType(), err)
+	}
+	if _, err := c.client.QuerySend(info.Id); err != nil {
+		return nil, fmt.Errorf("failed to send %s query: %v", action.MessageType(), err)
+	}
+	return info, nil
+}
+
+// SignPromise signs promise with absolute amounts owed by both sides.
+// Amounts can't decrease relative to the previous promise signed by this side
+func (c *Channel) SignPromise(promiseA, promiseB int64) (*PchanPromise, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.signPromise(promiseA, promiseB)
+}
+
+func (c *Channel) signPromise(promiseA, promiseB int64) (*PchanPromise, error) {
+	promise := NewPchanPromise(c.config.ChannelId, JSONInt64(promiseA), JSONInt64(promiseB), nil)
+	if err := checkPromise(c.config.ChannelId, c.ourPromise, promise); err != nil {
+		return nil, err
+	}
+	signed, err := c.client.PchanSignPromise(c.key, *promise)
+	if err != nil {
+		return nil, err
+	}
+	c.ourPromise = signed
+	return signed, nil
+}
+
+// Pay signs a promise increasing the amount this side owes by amount.
+// The counterparty amount is taken from the newest promise known to this side
+func (c *Channel) Pay(amount int64) (*PchanPromise, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("invalid payment amount: %d", amount)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	promiseA, promiseB := latestPromised(c.ourPromise, c.theirPromise)
+	if c.side == PchanAlice {
+		promiseA += amount
+	} else {
+		promiseB += amount
+	}
+	return c.signPromise(promiseA, promiseB)
+}
+
+// AcceptPromise validates promise signature with the counterparty key and keeps it
+// as the latest one if it is not older than the previous counterparty promise
+func (c *Channel) AcceptPromise(promise PchanPromise) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := checkPromise(c.config.ChannelId, c.theirPromise, &promise); err != nil {
+		return err
+	}
+	publicKey, err := signer.DecodePublicKey(c.counterpartyPublicKey())
+	if err != nil {
+		return fmt.Errorf("invalid %s public key: %v", c.side.counterparty(), err)
+	}
+	if _, err := c.client.PchanValidatePromise(promise, Bytes(publicKey)); err != nil {
+		return fmt.Errorf("invalid promise signature: %v", err)
+	}
+	c.theirPromise = &promise
+	return nil
+}
+
+func (c *Channel) counterpartyPublicKey() string {
+	if c.side == PchanAlice {
+		return c.config.BobPublicKey
+	}
+	return c.config.AlicePublicKey
+}
+
+func (side PchanSide) counterparty() PchanSide {
+	if side == PchanAlice {
+		return PchanBob
+	}
+	return PchanAlice
+}
+
+// checkPromise ensures the promise belongs to the channel and doesn't decrease any amount
+func checkPromise(channelID JSONInt64, prev, next *PchanPromise) error {
+	if next.ChannelId != channelID {
+		return fmt.Errorf("promise for channel %d, expected %d", next.ChannelId, channelID)
+	}
+	if next.PromiseA < 0 || next.PromiseB < 0 {
+		return fmt.Errorf("negative promise amount")
+	}
+	if prev != nil && (next.PromiseA < prev.PromiseA || next.PromiseB < prev.PromiseB) {
+		return ErrStalePromise
+	}
+	return nil
+}
+
+func latestPromised(promises ...*PchanPromise) (int64, int64) {
+	var promiseA, promiseB int64
+	for _, promise := range promises {
+		if promise == nil {
+			continue
+		}
+		if int64(promise.PromiseA) > promiseA {
+			promiseA = int64(promise.PromiseA)
+		}
+		if int64(promise.PromiseB) > promiseB {
+			promiseB = int64(promise.PromiseB)
+		}
+	}
+	return promiseA, promiseB
+}
+
+// PchanGetAccountState loads payment channel state.
+// Nil state is returned for a channel which is not deployed yet
+func (client *Client) PchanGetAccountState(accountAddress AccountAddress) (*PchanAccountState, error) {
+	fullState, err := client.GetAccountState(accountAddress)
+	if err != nil {
+		return nil, err
+	}
+	return parsePchanAccountState(fullState)
+}
+
+func parsePchanAccountState(fullState *FullAccountState) (*PchanAccountState, error) {
+	switch state := fullState.AccountState.(type) {
+	case *PchanAccountState:
+		return state, nil
+	case *UninitedAccountState:
+		return nil, nil
+	case nil:
+		return nil, fmt.Errorf("no account state")
+	default:
+		return nil, fmt.Errorf("account is not a payment channel: %s", state.MessageType())
+	}
+}
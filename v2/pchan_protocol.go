@@ -0,0 +1,314 @@
+package v2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Off-chain promise exchange between two channel sides. The paying side sends a promise message
+// with the next sequence number and the packed promise, the other side validates it and answers
+// with ack or nack carrying the same sequence number. Resending the last acknowledged message is
+// answered with ack again, so a sender may retry until it gets a reply. Another promise with the
+// already acknowledged sequence number is rejected, so a sender that got no reply resends the same
+// message before it sends the next promise
+const (
+	PchanMessagePromise = "pchan.promiseUpdate"
+	PchanMessageAck     = "pchan.ack"
+	PchanMessageNack    = "pchan.nack"
+
+	DefaultPchanTransportTimeout = 10 * time.Second
+)
+
+// PchanMessage is a single protocol message. Promise holds PchanPackPromise data
+type PchanMessage struct {
+	Type      string    `json:"@type"`
+	ChannelId JSONInt64 `json:"channel_id"`
+	Seq       uint64    `json:"seq"`
+	Promise   []byte    `json:"promise,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// PchanHandler answers incoming protocol messages, PchanPeer is the one to use
+type PchanHandler interface {
+	Handle(msg *PchanMessage) *PchanMessage
+}
+
+// PchanTransport delivers a message to the other side and returns its reply
+type PchanTransport interface {
+	Send(msg *PchanMessage) (*PchanMessage, error)
+}
+
+// PchanPeer exchanges promises of a Channel with the other side
+type PchanPeer struct {
+	channel   *Channel
+	transport PchanTransport
+
+	// OnPromise is called with every accepted promise
+	OnPromise func(promise PchanPromise)
+
+	sendMu  sync.Mutex
+	sendSeq uint64
+	// pending is the last message sent without a reply, the other side may have accepted it
+	pending *PchanMessage
+	recvMu  sync.Mutex
+	recvSeq uint64
+	// recvPromise is the packed promise accepted with recvSeq
+	recvPromise []byte
+}
+
+// NewPchanPeer creates peer sending promises over transport. Transport may be nil for a side that only receives
+func NewPchanPeer(channel *Channel, transport PchanTransport) *PchanPeer {
+	return &PchanPeer{channel: channel, transport: transport}
+}
+
+// SetTransport replaces transport, e.g. after reconnection. Sequence numbers are kept
+func (peer *PchanPeer) SetTransport(transport PchanTransport) {
+	peer.sendMu.Lock()
+	defer peer.sendMu.Unlock()
+	peer.transport = transport
+}
+
+// Pay signs a promise increased by amount and delivers it to the other side
+func (peer *PchanPeer) Pay(amount int64) (*PchanPromise, error) {
+	promise, err := peer.channel.Pay(amount)
+	if err != nil {
+		return nil, err
+	}
+	return promise, peer.SendPromise(promise)
+}
+
+// SendPromise delivers signed promise and waits for acknowledgement.
+// A promise left without a reply by a previous call is resent unchanged first
+func (peer *PchanPeer) SendPromise(promise *PchanPromise) error {
+	peer.sendMu.Lock()
+	defer peer.sendMu.Unlock()
+	if peer.transport == nil {
+		return fmt.Errorf("no transport to send promise")
+	}
+	data, err := peer.channel.client.PchanPackPromise(*promise)
+	if err != nil {
+		return fmt.Errorf("failed to pack promise: %v", err)
+	}
+	if len(data.Bytes) == 0 {
+		return fmt.Errorf("empty packed promise")
+	}
+	return peer.send(data.Bytes)
+}
+
+// send delivers the packed promise with the next seq after the pending message, caller must hold sendMu
+func (peer *PchanPeer) send(promise []byte) error {
+	if pending := peer.pending; pending != nil {
+		// a rejected pending promise is replaced by the new one with the same seq
+		if err := peer.deliver(pending); err != nil && peer.pending != nil {
+			return fmt.Errorf("failed to resend promise with seq %d: %v", pending.Seq, err)
+		}
+	}
+	return peer.deliver(&PchanMessage{
+		Type:      PchanMessagePromise,
+		ChannelId: peer.channel.config.ChannelId,
+		Seq:       peer.sendSeq + 1,
+		Promise:   promise,
+	})
+}
+
+// deliver sends the message and keeps it pending until the other side replies, caller must hold sendMu
+func (peer *PchanPeer) deliver(msg *PchanMessage) error {
+	peer.pending = msg
+	reply, err := peer.transport.Send(msg)
+	if err != nil {
+		return err
+	}
+	if reply.Seq != msg.Seq {
+		return fmt.Errorf("reply for seq %d, expected %d", reply.Seq, msg.Seq)
+	}
+	switch reply.Type {
+	case PchanMessageAck:
+		peer.pending = nil
+		peer.sendSeq = msg.Seq
+		return nil
+	case PchanMessageNack:
+		peer.pending = nil
+		return fmt.Errorf("promise rejected: %s", reply.Error)
+	default:
+		return fmt.Errorf("unexpected reply: %s", reply.Type)
+	}
+}
+
+// Handle validates incoming promise with PchanValidatePromise and accepts it into the channel
+func (peer *PchanPeer) Handle(msg *PchanMessage) *PchanMessage {
+	peer.recvMu.Lock()
+	defer peer.recvMu.Unlock()
+	if msg.Type != PchanMessagePromise {
+		return peer.nack(msg, fmt.Errorf("unexpected message: %s", msg.Type))
+	}
+	if msg.ChannelId != peer.channel.config.ChannelId {
+		return peer.nack(msg, fmt.Errorf("unknown channel: %d", msg.ChannelId))
+	}
+	switch {
+	case msg.Seq == peer.recvSeq && msg.Seq != 0:
+		// retry of the message we've already accepted, its ack may have been lost
+		if !bytes.Equal(msg.Promise, peer.recvPromise) {
+			return peer.nack(msg, fmt.Errorf("another promise with accepted seq %d", msg.Seq))
+		}
+		return peer.ack(msg)
+	case msg.Seq != peer.recvSeq+1:
+		return peer.nack(msg, fmt.Errorf("unexpected seq %d, expected %d", msg.Seq, peer.recvSeq+1))
+	}
+
+	promise, err := peer.channel.client.PchanUnpackPromise(SecureBytes(msg.Promise))
+	if err != nil {
+		return peer.nack(msg, fmt.Errorf("failed to unpack promise: %v", err))
+	}
+	if err := peer.channel.AcceptPromise(*promise); err != nil {
+		return peer.nack(msg, err)
+	}
+	peer.recvSeq = msg.Seq
+	peer.recvPromise = msg.Promise
+	if peer.OnPromise != nil {
+		peer.OnPromise(*promise)
+	}
+	return peer.ack(msg)
+}
+
+func (peer *PchanPeer) ack(msg *PchanMessage) *PchanMessage {
+	return &PchanMessage{Type: PchanMessageAck, ChannelId: msg.ChannelId, Seq: msg.Seq}
+}
+
+func (peer *PchanPeer) nack(msg *PchanMessage, err error) *PchanMessage {
+	return &PchanMessage{Type: PchanMessageNack, ChannelId: msg.ChannelId, Seq: msg.Seq, Error: err.Error()}
+}
+
+// pchanMemoryTransport delivers messages to a handler in the same process
+type pchanMemoryTransport struct {
+	handler PchanHandler
+}
+
+func (transport pchanMemoryTransport) Send(msg *PchanMessage) (*PchanMessage, error) {
+	// round trip through json, so both sides never share buffers
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var copied PchanMessage
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return nil, err
+	}
+	return transport.handler.Handle(&copied), nil
+}
+
+// NewPchanMemoryPair connects two channel sides in memory, handy for tests
+func NewPchanMemoryPair(alice, bob *Channel) (*PchanPeer, *PchanPeer) {
+	alicePeer := NewPchanPeer(alice, nil)
+	bobPeer := NewPchanPeer(bob, nil)
+	alicePeer.SetTransport(pchanMemoryTransport{handler: bobPeer})
+	bobPeer.SetTransport(pchanMemoryTransport{handler: alicePeer})
+	return alicePeer, bobPeer
+}
+
+// PchanConnTransport sends messages as JSON lines over a stream connection served by ServePchanConn
+type PchanConnTransport struct {
+	mu      sync.Mutex
+	conn    net.Conn
+	reader  *json.Decoder
+	timeout time.Duration
+}
+
+// NewPchanConnTransport creates transport over established connection
+func NewPchanConnTransport(conn net.Conn, timeout time.Duration) *PchanConnTransport {
+	if timeout <= 0 {
+		timeout = DefaultPchanTransportTimeout
+	}
+	return &PchanConnTransport{conn: conn, reader: json.NewDecoder(bufio.NewReader(conn)), timeout: timeout}
+}
+
+// Send writes message and reads the reply
+func (transport *PchanConnTransport) Send(msg *PchanMessage) (*PchanMessage, error) {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	if err := transport.conn.SetDeadline(time.Now().Add(transport.timeout)); err != nil {
+		return nil, err
+	}
+	if err := json.NewEncoder(transport.conn).Encode(msg); err != nil {
+		return nil, err
+	}
+	var reply PchanMessage
+	if err := transport.reader.Decode(&reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Close closes the connection
+func (transport *PchanConnTransport) Close() error {
+	return transport.conn.Close()
+}
+
+// ServePchanConn answers messages read from conn with handler until the connection is closed
+func ServePchanConn(conn net.Conn, handler PchanHandler) error {
+	defer conn.Close()
+	decoder := json.NewDecoder(bufio.NewReader(conn))
+	encoder := json.NewEncoder(conn)
+	for {
+		var msg PchanMessage
+		if err := decoder.Decode(&msg); err != nil {
+			return err
+		}
+		if err := encoder.Encode(handler.Handle(&msg)); err != nil {
+			return err
+		}
+	}
+}
+
+// PchanHTTPTransport posts messages to a PchanHTTPHandler
+type PchanHTTPTransport struct {
+	URL    string
+	Client *http.Client
+}
+
+// Send posts message and decodes the reply
+func (transport *PchanHTTPTransport) Send(msg *PchanMessage) (*PchanMessage, error) {
+	client := transport.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultPchanTransportTimeout}
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Post(transport.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected http status: %s", resp.Status)
+	}
+	var reply PchanMessage
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// PchanHTTPHandler serves messages posted by PchanHTTPTransport
+func PchanHTTPHandler(handler PchanHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var msg PchanMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			http.Error(w, "invalid message", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(handler.Handle(&msg))
+	})
+}
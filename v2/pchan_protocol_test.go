@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/http/httptest"
+	"testing"
+)
+
+type echoPchanHandler struct {
+	received []*PchanMessage
+}
+
+func (handler *echoPchanHandler) Handle(msg *PchanMessage) *PchanMessage {
+	handler.received = append(handler.received, msg)
+	return &PchanMessage{Type: PchanMessageAck, ChannelId: msg.ChannelId, Seq: msg.Seq}
+}
+
+func checkPchanTransport(t *testing.T, transport PchanTransport, handler *echoPchanHandler) {
+	msg := &PchanMessage{Type: PchanMessagePromise, ChannelId: 7, Seq: 3, Promise: []byte{1, 2, 3}}
+	reply, err := transport.Send(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.Type != PchanMessageAck || reply.Seq != 3 || reply.ChannelId != 7 {
+		t.Fatalf("unexpected reply: %#v", reply)
+	}
+	if len(handler.received) != 1 || !bytes.Equal(handler.received[0].Promise, msg.Promise) {
+		t.Fatalf("unexpected received messages: %#v", handler.received)
+	}
+}
+
+func TestPchanMemoryTransport(t *testing.T) {
+	handler := &echoPchanHandler{}
+	checkPchanTransport(t, pchanMemoryTransport{handler: handler}, handler)
+}
+
+func TestPchanConnTransport(t *testing.T) {
+	client, server := net.Pipe()
+	handler := &echoPchanHandler{}
+	go ServePchanConn(server, handler)
+	transport := NewPchanConnTransport(client, 0)
+	defer transport.Close()
+	checkPchanTransport(t, transport, handler)
+}
+
+func TestPchanHTTPTransport(t *testing.T) {
+	handler := &echoPchanHandler{}
+	server := httptest.NewServer(PchanHTTPHandler(handler))
+	defer server.Close()
+	checkPchanTransport(t, &PchanHTTPTransport{URL: server.URL}, handler)
+}
+
+func TestPchanPeerRejectsUnexpectedMessages(t *testing.T) {
+	config := NewPchanConfig(nil, "PuYa", nil, "PuYb", 7, 3600, 3600)
+	channel, err := NewChannel(nil, *config, InputKey{Key: TONPrivateKey{PublicKey: "PuYb"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer := NewPchanPeer(channel, nil)
+	for _, msg := range []*PchanMessage{
+		{Type: PchanMessageAck, ChannelId: 7, Seq: 1},
+		{Type: PchanMessagePromise, ChannelId: 8, Seq: 1},
+		{Type: PchanMessagePromise, ChannelId: 7, Seq: 0},
+		{Type: PchanMessagePromise, ChannelId: 7, Seq: 2},
+	} {
+		reply := peer.Handle(msg)
+		if reply.Type != PchanMessageNack || reply.Seq != msg.Seq || reply.Error == "" {
+			t.Fatalf("expected nack for %#v, got %#v", msg, reply)
+		}
+	}
+	if err := peer.SendPromise(NewPchanPromise(7, 1, 0, nil)); err == nil {
+		t.Fatal("expected error without transport")
+	}
+}
+
+func TestPchanPeerRetry(t *testing.T) {
+	config := NewPchanConfig(nil, "PuYa", nil, "PuYb", 7, 3600, 3600)
+	channel, err := NewChannel(nil, *config, InputKey{Key: TONPrivateKey{PublicKey: "PuYb"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer := NewPchanPeer(channel, nil)
+	// the promise with seq 1 has been accepted, but its ack was lost
+	peer.recvSeq = 1
+	peer.recvPromise = []byte{1, 2, 3}
+
+	reply := peer.Handle(&PchanMessage{Type: PchanMessagePromise, ChannelId: 7, Seq: 1, Promise: []byte{1, 2, 3}})
+	if reply.Type != PchanMessageAck || reply.Seq != 1 {
+		t.Fatalf("expected ack for retry, got %#v", reply)
+	}
+	reply = peer.Handle(&PchanMessage{Type: PchanMessagePromise, ChannelId: 7, Seq: 1, Promise: []byte{1, 2, 4}})
+	if reply.Type != PchanMessageNack || reply.Seq != 1 || reply.Error == "" {
+		t.Fatalf("expected nack for another promise with the same seq, got %#v", reply)
+	}
+}
+
+// pchanSeqHandler answers promises by their seq like PchanPeer.Handle, without unpacking them with tonlib
+type pchanSeqHandler struct {
+	recvSeq     uint64
+	recvPromise []byte
+}
+
+func (handler *pchanSeqHandler) Handle(msg *PchanMessage) *PchanMessage {
+	reply := &PchanMessage{Type: PchanMessageAck, ChannelId: msg.ChannelId, Seq: msg.Seq}
+	switch {
+	case msg.Seq == handler.recvSeq && bytes.Equal(msg.Promise, handler.recvPromise):
+	case msg.Seq == handler.recvSeq+1:
+		handler.recvSeq, handler.recvPromise = msg.Seq, msg.Promise
+	default:
+		reply.Type, reply.Error = PchanMessageNack, "unexpected promise"
+	}
+	return reply
+}
+
+// lossyPchanTransport delivers messages but loses the replies while lose is set
+type lossyPchanTransport struct {
+	handler PchanHandler
+	lose    bool
+}
+
+func (transport *lossyPchanTransport) Send(msg *PchanMessage) (*PchanMessage, error) {
+	reply := transport.handler.Handle(msg)
+	if transport.lose {
+		return nil, errors.New("connection reset")
+	}
+	return reply, nil
+}
+
+func TestPchanPeerLostAck(t *testing.T) {
+	config := NewPchanConfig(nil, "PuYa", nil, "PuYb", 7, 3600, 3600)
+	channel, err := NewChannel(nil, *config, InputKey{Key: TONPrivateKey{PublicKey: "PuYa"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := &pchanSeqHandler{}
+	transport := &lossyPchanTransport{handler: handler, lose: true}
+	peer := NewPchanPeer(channel, transport)
+
+	// the promise is accepted, but its ack is lost
+	if err := peer.send([]byte{1}); err == nil {
+		t.Fatal("expected error for the lost ack")
+	}
+	if handler.recvSeq != 1 || peer.sendSeq != 0 {
+		t.Fatalf("unexpected seqs: %d %d", handler.recvSeq, peer.sendSeq)
+	}
+	// the lost one is still unanswered
+	if err := peer.send([]byte{2}); err == nil || handler.recvSeq != 1 {
+		t.Fatalf("expected resend error, got %v with seq %d", err, handler.recvSeq)
+	}
+
+	// the next promise follows the lost one once it is acked
+	transport.lose = false
+	if err := peer.send([]byte{3}); err != nil {
+		t.Fatal(err)
+	}
+	if handler.recvSeq != 2 || !bytes.Equal(handler.recvPromise, []byte{3}) || peer.sendSeq != 2 || peer.pending != nil {
+		t.Fatalf("unexpected state: %d %v %d %v", handler.recvSeq, handler.recvPromise, peer.sendSeq, peer.pending)
+	}
+}
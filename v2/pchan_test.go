@@ -0,0 +1,86 @@
+package v2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPchanGetAccountState(t *testing.T) {
+	response := `{"@type":"fullAccountState","balance":"3000000000","account_state":{"@type":"pchan.accountState",` +
+		`"config":{"@type":"pchan.config","alice_public_key":"PuYa","bob_public_key":"PuYb","channel_id":"7",` +
+		`"init_timeout":3600,"close_timeout":3600},"state":{"@type":"pchan.stateClose","signed_A":true,` +
+		`"signed_B":false,"min_A":"1000","min_B":"0","expire_at":1600000000,"A":"2000","B":"1000"},"description":""}}`
+	client, fake := newFakeClient(func(req map[string]interface{}) string {
+		return response
+	})
+	channel := *NewAccountAddress("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	state, err := client.PchanGetAccountState(channel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.requests) != 1 || !strings.Contains(fake.requests[0], `"@type":"getAccountState"`) {
+		t.Fatalf("unexpected requests: %v", fake.requests)
+	}
+	if state.Config.ChannelId != 7 {
+		t.Fatalf("unexpected channel id: %d", state.Config.ChannelId)
+	}
+	closeState, ok := state.State.(*PchanStateClose)
+	if !ok {
+		t.Fatalf("unexpected state: %#v", state.State)
+	}
+	if closeState.A != 2000 || closeState.B != 1000 || !closeState.SignedA || closeState.SignedB {
+		t.Fatalf("unexpected close state: %#v", closeState)
+	}
+
+	response = `{"@type":"fullAccountState","account_state":{"@type":"uninited.accountState","frozen_hash":""}}`
+	state, err = client.PchanGetAccountState(channel)
+	if err != nil || state != nil {
+		t.Fatalf("expected nil state for uninited account, got %#v, %v", state, err)
+	}
+	response = `{"@type":"fullAccountState","account_state":{"@type":"wallet.v3.accountState"}}`
+	if _, err = client.PchanGetAccountState(channel); err == nil {
+		t.Fatal("expected error for wallet account")
+	}
+
+	// the address is validated before the request is sent
+	if _, err = client.PchanGetAccountState(*NewAccountAddress("not an address")); err == nil {
+		t.Fatal("expected error for invalid address")
+	}
+	if len(fake.requests) != 3 {
+		t.Fatalf("invalid address has been sent: %v", fake.requests)
+	}
+}
+
+func TestCheckPromise(t *testing.T) {
+	prev := NewPchanPromise(7, 100, 50, nil)
+	if err := checkPromise(7, nil, prev); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkPromise(7, prev, NewPchanPromise(7, 150, 50, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkPromise(7, prev, NewPchanPromise(7, 150, 40, nil)); err != ErrStalePromise {
+		t.Fatalf("expected stale promise error, got %v", err)
+	}
+	if err := checkPromise(7, prev, NewPchanPromise(8, 150, 50, nil)); err == nil {
+		t.Fatal("expected channel id mismatch")
+	}
+}
+
+func TestChannelSide(t *testing.T) {
+	config := NewPchanConfig(nil, "PuYa", nil, "PuYb", 7, 3600, 3600)
+	channel, err := NewChannel(nil, *config, InputKey{Key: TONPrivateKey{PublicKey: "PuYb"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if channel.Side() != PchanBob || channel.counterpartyPublicKey() != "PuYa" {
+		t.Fatalf("unexpected side: %s", channel.Side())
+	}
+	if _, err := NewChannel(nil, *config, InputKey{Key: TONPrivateKey{PublicKey: "PuYc"}}); err != ErrNotChannelSide {
+		t.Fatalf("expected foreign key error, got %v", err)
+	}
+	a, b := latestPromised(NewPchanPromise(7, 100, 10, nil), nil, NewPchanPromise(7, 90, 20, nil))
+	if a != 100 || b != 20 {
+		t.Fatalf("unexpected latest promised amounts: %d %d", a, b)
+	}
+}
@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
+)
+
+// Request is a call of a tonlib function returning Resp. Requests are generated for every tl function,
+// e.g. GetAccountStateRequest, so they can be queued, logged or serialised before they are sent with Execute
+type Request[Resp any] interface {
+	// Type returns the tl name of the function
+	Type() string
+	// DecodeResponse decodes the result of the function returned by tonlib
+	DecodeResponse(raw []byte) (Resp, error)
+}
+
+// Execute validates the request, sends it to tonlib and decodes the response.
+// The request is not sent when ctx is done or the bundled tonlib does not support it, a sent request can't be cancelled
+func Execute[Resp any](ctx context.Context, client *Client, req Request[Resp]) (Resp, error) {
+	var resp Resp
+	if err := ctx.Err(); err != nil {
+		return resp, err
+	}
+	if err := validate(req); err != nil {
+		return resp, err
+	}
+	result, err := client.executeAsynchronously(req)
+	if err != nil {
+		return resp, err
+	}
+	if tonlibjson.IsError(result.Raw) {
+		return resp, tonlibjson.ParseError(result.Raw)
+	}
+	return req.DecodeResponse(result.Raw)
+}
+
+// WithBlockRequest runs the function against the state of the block instead of the last one
+//
+//tlgen:skip withBlock
+type WithBlockRequest[Resp any] struct {
+	Id       TonBlockIdExt `json:"id"`
+	Function Request[Resp] `json:"function"`
+}
+
+// WithBlock wraps the request to run it against the state of the block:
+//
+//	state, err := Execute[*FullAccountState](ctx, client, WithBlock[*FullAccountState](id, &GetAccountStateRequest{...}))
+func WithBlock[Resp any](id TonBlockIdExt, function Request[Resp]) *WithBlockRequest[Resp] {
+	return &WithBlockRequest[Resp]{Id: id, Function: function}
+}
+
+// Type returns the tl name of the function
+func (withBlockRequest *WithBlockRequest[Resp]) Type() string {
+	return "withBlock"
+}
+
+// MarshalJSON marshals the request with its @type
+func (withBlockRequest *WithBlockRequest[Resp]) MarshalJSON() ([]byte, error) {
+	type fields WithBlockRequest[Resp]
+	return marshalRequest(withBlockRequest.Type(), (*fields)(withBlockRequest))
+}
+
+// DecodeResponse decodes the result of the wrapped function
+func (withBlockRequest *WithBlockRequest[Resp]) DecodeResponse(raw []byte) (Resp, error) {
+	return withBlockRequest.Function.DecodeResponse(raw)
+}
+
+// Validate checks the block id and the wrapped function
+func (withBlockRequest *WithBlockRequest[Resp]) Validate() error {
+	if withBlockRequest == nil {
+		return nil
+	}
+	return validate(&withBlockRequest.Id, withBlockRequest.Function)
+}
+
+// marshalRequest marshals fields of the request as a tonlib object of the type
+func marshalRequest(typeName string, fields interface{}) ([]byte, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	typeJSON, err := json.Marshal(typeName)
+	if err != nil {
+		return nil, err
+	}
+	object := append([]byte(`{"@type":`), typeJSON...)
+	if len(data) > len("{}") {
+		object = append(object, ',')
+	}
+	return append(object, data[1:]...), nil
+}
+
+// decodeResponse decodes the struct returned by tonlib
+func decodeResponse[T any](raw []byte) (*T, error) {
+	var resp T
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,117 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/transport"
+)
+
+// fakeTransport answers every request sent by Client with handle, sent requests are recorded
+type fakeTransport struct {
+	handle    func(req map[string]interface{}) string
+	requests  []string
+	responses [][]byte
+}
+
+// newFakeClient creates a client talking to handle instead of tonlib
+func newFakeClient(handle func(req map[string]interface{}) string) (*Client, *fakeTransport) {
+	fake := &fakeTransport{handle: handle}
+	return &Client{transport: fake}, fake
+}
+
+func (fake *fakeTransport) Send(req []byte) {
+	fake.requests = append(fake.requests, string(req))
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(req, &decoded); err != nil {
+		panic(err)
+	}
+	fake.responses = append(fake.responses, []byte(fake.handle(decoded)))
+}
+
+func (fake *fakeTransport) Receive(timeout float64) []byte {
+	if len(fake.responses) == 0 {
+		return nil
+	}
+	res := fake.responses[0]
+	fake.responses = fake.responses[1:]
+	return res
+}
+
+func (fake *fakeTransport) ReceiveRetrying(timeout float64, retries int) ([]byte, error) {
+	if res := fake.Receive(timeout); res != nil {
+		return res, nil
+	}
+	return nil, transport.ErrNoResponse
+}
+
+func (fake *fakeTransport) Execute(req []byte) []byte {
+	return []byte(`{"@type":"ok"}`)
+}
+
+func (fake *fakeTransport) Destroy() {}
+
+func TestRequestMarshalJSON(t *testing.T) {
+	req := &GetAccountStateRequest{AccountAddress: *NewAccountAddress("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")}
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"@type":"getAccountState","account_address":{"@type":"accountAddress","@extra":"",` +
+		`"account_address":"EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a"}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected request:\n%s\nexpected:\n%s", data, expected)
+	}
+
+	if data, err = json.Marshal(&CloseRequest{}); err != nil || string(data) != `{"@type":"close"}` {
+		t.Fatalf("unexpected request without params: %s, %v", data, err)
+	}
+
+	block := TonBlockIdExt{Workchain: -1, Shard: -9223372036854775808, Seqno: 100}
+	data, err = json.Marshal(WithBlock[*FullAccountState](block, req))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), `{"@type":"withBlock","id":{`) ||
+		!strings.HasSuffix(string(data), `"function":`+expected+`}`) {
+		t.Fatalf("unexpected request with block: %s", data)
+	}
+}
+
+func TestRequestDecodeResponse(t *testing.T) {
+	raw := []byte(`{"@type":"fullAccountState","balance":"100","account_state":{"@type":"wallet.v3.accountState",` +
+		`"wallet_id":"698983191","seqno":5}}`)
+	var req Request[*FullAccountState] = WithBlock[*FullAccountState](TonBlockIdExt{}, &GetAccountStateRequest{})
+	state, err := req.DecodeResponse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wallet, ok := state.AccountState.(*WalletV3AccountState); !ok || wallet.Seqno != 5 || state.Balance != 100 {
+		t.Fatalf("unexpected state: %#v", state)
+	}
+
+	data, err := (&MsgDecryptWithProofRequest{}).DecodeResponse([]byte(`{"@type":"msg.dataText","text":"aGk="}`))
+	if text, ok := data.(*MsgDataText); err != nil || !ok || string(text.Text) != "hi" {
+		t.Fatalf("unexpected msg data: %#v, %v", data, err)
+	}
+}
+
+func TestExecuteValidate(t *testing.T) {
+	ctx := context.Background()
+	req := &RawGetTransactionsRequest{AccountAddress: *NewAccountAddress("EQD")}
+	if _, err := Execute[*RawTransactions](ctx, nil, req); err == nil ||
+		!strings.HasPrefix(err.Error(), "raw.getTransactions: account_address: accountAddress: account_address is not valid") {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if _, err := Execute[*RawTransactions](ctx, nil, WithBlock[*RawTransactions](TonBlockIdExt{}, req)); err == nil {
+		t.Fatal("expected validation error of the wrapped request")
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := Execute[*Ok](canceled, nil, &CloseRequest{}); err != context.Canceled {
+		t.Fatalf("expected context error, got %v", err)
+	}
+}
@@ -0,0 +1,201 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// DefaultRwalletQueryTimeout is the validity of rwallet queries in seconds
+const DefaultRwalletQueryTimeout = 60
+
+// ErrRwalletLocked is returned for transfers exceeding the unlocked balance
+var ErrRwalletLocked = errors.New("transfer exceeds unlocked balance")
+
+// RwalletVesting describes a vesting schedule: nothing is available until Cliff passes since Start,
+// then Total is unlocked in Periods equal parts, one part every Period starting at the cliff
+type RwalletVesting struct {
+	Start   time.Time
+	Total   int64
+	Cliff   time.Duration
+	Period  time.Duration
+	Periods int
+}
+
+// Config builds restricted wallet limits for the schedule
+func (vesting RwalletVesting) Config() (*RwalletConfig, error) {
+	if vesting.Total <= 0 {
+		return nil, fmt.Errorf("invalid vesting total: %d", vesting.Total)
+	}
+	if vesting.Periods <= 0 || (vesting.Periods > 1 && vesting.Period <= 0) || vesting.Cliff < 0 {
+		return nil, fmt.Errorf("invalid vesting schedule")
+	}
+	last := vesting.Cliff + time.Duration(vesting.Periods-1)*vesting.Period
+	if last/time.Second > math.MaxInt32 {
+		return nil, fmt.Errorf("vesting schedule is too long")
+	}
+
+	// the contract takes the limit with the greatest seconds not exceeding the time passed since
+	// start_at, -1 is used before start_at
+	limits := []RwalletLimit{*NewRwalletLimit(-1, JSONInt64(vesting.Total))}
+	if vesting.Cliff > 0 {
+		limits = append(limits, *NewRwalletLimit(0, JSONInt64(vesting.Total)))
+	}
+	total := big.NewInt(vesting.Total)
+	for i := 1; i <= vesting.Periods; i++ {
+		// total * i / periods without overflow
+		unlocked := new(big.Int).Mul(total, big.NewInt(int64(i)))
+		unlocked.Quo(unlocked, big.NewInt(int64(vesting.Periods)))
+		seconds := (vesting.Cliff + time.Duration(i-1)*vesting.Period) / time.Second
+		limits = append(limits, *NewRwalletLimit(int32(seconds), JSONInt64(vesting.Total-unlocked.Int64())))
+	}
+	return NewRwalletConfig(limits, vesting.Start.Unix()), nil
+}
+
+// RwalletLocked returns the amount the config keeps locked at the time
+func RwalletLocked(config RwalletConfig, at time.Time) int64 {
+	passed := at.Unix() - config.StartAt
+	if passed < 0 {
+		passed = -1
+	}
+	var locked int64
+	found := false
+	var best int32
+	for _, limit := range config.Limits {
+		if int64(limit.Seconds) > passed {
+			continue
+		}
+		if !found || limit.Seconds > best {
+			found = true
+			best = limit.Seconds
+			locked = int64(limit.Value)
+		}
+	}
+	return locked
+}
+
+// RwalletUnlocked returns the part of balance which can be spent at the time
+func RwalletUnlocked(balance int64, config RwalletConfig, at time.Time) int64 {
+	unlocked := balance - RwalletLocked(config, at)
+	if unlocked < 0 {
+		return 0
+	}
+	return unlocked
+}
+
+// Rwallet is a restricted wallet. The init key sets its vesting config once,
+// the owner key sends transfers within the unlocked balance
+type Rwallet struct {
+	client       *Client
+	initialState *RwalletInitialAccountState
+	QueryTimeout int32
+	address      *AccountAddress
+}
+
+// NewRwallet creates restricted wallet helper for the keys and wallet id
+func NewRwallet(client *Client, initPublicKey, publicKey string, walletID int64) *Rwallet {
+	return &Rwallet{
+		client:       client,
+		initialState: NewRwalletInitialAccountState(initPublicKey, publicKey, JSONInt64(walletID)),
+		QueryTimeout: DefaultRwalletQueryTimeout,
+	}
+}
+
+// Address returns the wallet address
+func (w *Rwallet) Address() (*AccountAddress, error) {
+	if w.address != nil {
+		return w.address, nil
+	}
+	addr, err := w.client.GetAccountAddress(w.initialState, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	w.address = addr
+	return addr, nil
+}
+
+// Init deploys the wallet and sets its vesting config, the query is signed by the init key
+func (w *Rwallet) Init(initKey InputKey, config RwalletConfig) (*QueryInfo, error) {
+	config.tonCommon = tonCommon{Type: "rwallet.config"}
+	return w.send(NewActionRwallet(NewRwalletActionInit(&config)), initKey)
+}
+
+// State returns current wallet state and balance
+func (w *Rwallet) State() (*RwalletAccountState, int64, error) {
+	addr, err := w.Address()
+	if err != nil {
+		return nil, 0, err
+	}
+	return w.client.RwalletGetAccountState(*addr)
+}
+
+// Unlocked returns the amount that can be spent now
+func (w *Rwallet) Unlocked() (int64, error) {
+	state, balance, err := w.State()
+	if err != nil {
+		return 0, err
+	}
+	if state == nil || state.Config == nil {
+		return 0, fmt.Errorf("rwallet is not initialized")
+	}
+	return RwalletUnlocked(balance, *state.Config, time.Now()), nil
+}
+
+// Transfer sends messages signed by the owner key, refusing them if their total exceeds the unlocked balance
+func (w *Rwallet) Transfer(key InputKey, messages []MsgMessage) (*QueryInfo, error) {
+	unlocked, err := w.Unlocked()
+	if err != nil {
+		return nil, err
+	}
+	var total int64
+	for _, msg := range messages {
+		if msg.Amount < 0 {
+			return nil, fmt.Errorf("invalid message amount: %d", msg.Amount)
+		}
+		total += int64(msg.Amount)
+	}
+	if total > unlocked {
+		return nil, fmt.Errorf("%w: %d > %d", ErrRwalletLocked, total, unlocked)
+	}
+	return w.send(NewActionMsg(false, messages), key)
+}
+
+func (w *Rwallet) send(action Action, key InputKey) (*QueryInfo, error) {
+	addr, err := w.Address()
+	if err != nil {
+		return nil, err
+	}
+	info, err := w.client.CreateQuery(action, *addr, w.initialState, key, w.QueryTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s query: %v", action.MessageType(), err)
+	}
+	if _, err := w.client.QuerySend(info.Id); err != nil {
+		return nil, fmt.Errorf("failed to send %s query: %v", action.MessageType(), err)
+	}
+	return info, nil
+}
+
+// RwalletGetAccountState loads restricted wallet state and balance.
+// Nil state is returned for a wallet which is not deployed yet
+func (client *Client) RwalletGetAccountState(accountAddress AccountAddress) (*RwalletAccountState, int64, error) {
+	fullState, err := client.GetAccountState(accountAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+	return parseRwalletAccountState(fullState)
+}
+
+func parseRwalletAccountState(fullState *FullAccountState) (*RwalletAccountState, int64, error) {
+	switch state := fullState.AccountState.(type) {
+	case *RwalletAccountState:
+		return state, int64(fullState.Balance), nil
+	case *UninitedAccountState:
+		return nil, int64(fullState.Balance), nil
+	case nil:
+		return nil, 0, fmt.Errorf("no account state")
+	default:
+		return nil, 0, fmt.Errorf("account is not a restricted wallet: %s", state.MessageType())
+	}
+}
@@ -0,0 +1,80 @@
+package v2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRwalletVesting(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	vesting := RwalletVesting{
+		Start:   start,
+		Total:   1000,
+		Cliff:   30 * 24 * time.Hour,
+		Period:  24 * time.Hour,
+		Periods: 3,
+	}
+	config, err := vesting.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		at     time.Time
+		locked int64
+	}{
+		{start.Add(-time.Hour), 1000},
+		{start, 1000},
+		{start.Add(vesting.Cliff - time.Second), 1000},
+		{start.Add(vesting.Cliff), 667},
+		{start.Add(vesting.Cliff + vesting.Period), 334},
+		{start.Add(vesting.Cliff + 2*vesting.Period - time.Second), 334},
+		{start.Add(vesting.Cliff + 2*vesting.Period), 0},
+		{start.Add(365 * 24 * time.Hour), 0},
+	} {
+		if locked := RwalletLocked(*config, c.at); locked != c.locked {
+			t.Fatalf("locked at %v: %d, expected %d", c.at.Sub(start), locked, c.locked)
+		}
+	}
+	if unlocked := RwalletUnlocked(1500, *config, start.Add(vesting.Cliff)); unlocked != 833 {
+		t.Fatalf("unexpected unlocked amount: %d", unlocked)
+	}
+	if unlocked := RwalletUnlocked(500, *config, start); unlocked != 0 {
+		t.Fatalf("unexpected unlocked amount: %d", unlocked)
+	}
+
+	if _, err := (RwalletVesting{Start: start, Total: 1000, Periods: 2}).Config(); err == nil {
+		t.Fatal("expected error for zero period")
+	}
+	if _, err := (RwalletVesting{Start: start, Total: 1000, Periods: 1, Cliff: 100 * 365 * 24 * time.Hour}).Config(); err == nil {
+		t.Fatal("expected error for too long schedule")
+	}
+}
+
+func TestRwalletGetAccountState(t *testing.T) {
+	response := `{"@type":"fullAccountState","balance":"2000","account_state":{"@type":"rwallet.accountState",` +
+		`"wallet_id":"698983191","seqno":3,"unlocked_balance":"1000","config":{"@type":"rwallet.config",` +
+		`"start_at":1600000000,"limits":[{"@type":"rwallet.limit","seconds":0,"value":"1000"}]}}}`
+	client, fake := newFakeClient(func(req map[string]interface{}) string {
+		return response
+	})
+	wallet := *NewAccountAddress("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	state, balance, err := client.RwalletGetAccountState(wallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != 2000 || state.Seqno != 3 || state.Config.StartAt != 1600000000 || len(state.Config.Limits) != 1 {
+		t.Fatalf("unexpected state: %#v, balance %d", state, balance)
+	}
+	response = `{"@type":"fullAccountState","account_state":{"@type":"wallet.v3.accountState"}}`
+	if _, _, err := client.RwalletGetAccountState(wallet); err == nil {
+		t.Fatal("expected error for wallet account")
+	}
+
+	// the address is validated before the request is sent
+	if _, _, err := client.RwalletGetAccountState(*NewAccountAddress("not an address")); err == nil {
+		t.Fatal("expected error for invalid address")
+	}
+	if len(fake.requests) != 2 {
+		t.Fatalf("invalid address has been sent: %v", fake.requests)
+	}
+}
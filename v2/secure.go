@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/mercuryoio/tonlib-go/v2/internal/redact"
+)
+
+// Format prints SecureBytes redacted with any verb, so secrets don't end up in logs.
+// JSON encoding is untouched, tonlib still receives the real value
+func (s SecureBytes) Format(f fmt.State, verb rune) {
+	fmt.Fprint(f, redact.Placeholder)
+}
+
+// Wipe zeroes the underlying bytes, call it once the secret is not needed anymore
+func (s SecureBytes) Wipe() {
+	for i := range s {
+		s[i] = 0
+	}
+}
+
+// MarshalJSON marshals to base64 string, nil is encoded as empty string like Bytes
+func (s SecureBytes) MarshalJSON() ([]byte, error) {
+	return Bytes(s).MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals from base64 string
+func (s *SecureBytes) UnmarshalJSON(data []byte) error {
+	return (*Bytes)(s).UnmarshalJSON(data)
+}
+
+// Format prints SecureString redacted with any verb
+func (s SecureString) Format(f fmt.State, verb rune) {
+	fmt.Fprint(f, redact.Placeholder)
+}
+
+// Wipe drops the value. Go strings are immutable and can't be zeroed in place,
+// use SecureBytes for secrets which have to be erased from memory
+func (s *SecureString) Wipe() {
+	*s = ""
+}
+
+// logJSON prints request or response dump with secret fields masked
+func (client *Client) logJSON(prefix string, data []byte) {
+	if client.clientLogging {
+		fmt.Println(prefix, string(redact.JSON(data)))
+	}
+}
@@ -0,0 +1,91 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// FileSigner reads a base64 encoded ed25519 seed from a file on every signature,
+// so the secret stays in memory only while signing
+type FileSigner struct {
+	path      string
+	publicKey ed25519.PublicKey
+}
+
+// NewFileSigner creates a signer over the key file and caches its public key
+func NewFileSigner(path string) (*FileSigner, error) {
+	privateKey, err := readKeyFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(privateKey)
+
+	publicKey := make(ed25519.PublicKey, ed25519.PublicKeySize)
+	copy(publicKey, privateKey.Public().(ed25519.PublicKey))
+	return &FileSigner{path: path, publicKey: publicKey}, nil
+}
+
+// WriteKeyFile stores seed of privateKey to the path readable only by the owner
+func WriteKeyFile(path string, privateKey ed25519.PrivateKey) error {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return fmt.Errorf("invalid private key length: %d", len(privateKey))
+	}
+	data := base64.StdEncoding.EncodeToString(privateKey.Seed())
+	return ioutil.WriteFile(path, []byte(data+"\n"), 0600)
+}
+
+// PublicKey returns public part of the key
+func (signer *FileSigner) PublicKey() ed25519.PublicKey {
+	return signer.publicKey
+}
+
+// Sign signs message with the key loaded from the file
+func (signer *FileSigner) Sign(message []byte) ([]byte, error) {
+	privateKey, err := readKeyFile(signer.path)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(privateKey)
+
+	if !bytes.Equal(privateKey.Public().(ed25519.PublicKey), signer.publicKey) {
+		return nil, fmt.Errorf("key file %s has been changed", signer.path)
+	}
+	return ed25519.Sign(privateKey, message), nil
+}
+
+func readKeyFile(path string) (ed25519.PrivateKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("key file %s is accessible by other users", path)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(data)
+
+	encoded := bytes.TrimSpace(data)
+	seed := make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+	defer wipe(seed)
+	n, err := base64.StdEncoding.Decode(seed, encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key file %s: %v", path, err)
+	}
+	if n != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid seed length in key file %s: %d", path, n)
+	}
+	return ed25519.NewKeyFromSeed(seed[:n]), nil
+}
+
+func wipe(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+}
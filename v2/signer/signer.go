@@ -0,0 +1,100 @@
+// Package signer abstracts ed25519 signing so that messages built on the Go side
+// can be signed by keys living outside of tonlib: in a separate process, behind a
+// Unix socket, in a file on disk or simply in memory.
+package signer
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/mercuryoio/tonlib-go/v2/internal/crc16"
+)
+
+// public key tag used by tonlib for serialized ed25519 public keys
+var publicKeyTag = []byte{0x3e, 0xe6}
+
+// Signer signs messages with an ed25519 key it does not have to expose
+type Signer interface {
+	PublicKey() ed25519.PublicKey
+	Sign(message []byte) ([]byte, error)
+}
+
+// MemorySigner keeps the private key in process memory. It is meant for tests and tools
+type MemorySigner struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NewMemorySigner creates a signer over an existing private key
+func NewMemorySigner(privateKey ed25519.PrivateKey) (*MemorySigner, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: %d", len(privateKey))
+	}
+	return &MemorySigner{privateKey: privateKey}, nil
+}
+
+// NewMemorySignerFromSeed creates a signer from a 32 bytes ed25519 seed
+func NewMemorySignerFromSeed(seed []byte) (*MemorySigner, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid seed length: %d", len(seed))
+	}
+	return &MemorySigner{privateKey: ed25519.NewKeyFromSeed(seed)}, nil
+}
+
+// GenerateMemorySigner creates a signer with a fresh random key
+func GenerateMemorySigner() (*MemorySigner, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &MemorySigner{privateKey: privateKey}, nil
+}
+
+// PublicKey returns public part of the key
+func (signer *MemorySigner) PublicKey() ed25519.PublicKey {
+	return signer.privateKey.Public().(ed25519.PublicKey)
+}
+
+// Sign signs message with the key
+func (signer *MemorySigner) Sign(message []byte) ([]byte, error) {
+	return ed25519.Sign(signer.privateKey, message), nil
+}
+
+// Verify checks signature of message made by signer
+func Verify(signer Signer, message, signature []byte) bool {
+	return ed25519.Verify(signer.PublicKey(), message, signature)
+}
+
+// EncodePublicKey serializes public key to the 48 chars form used by tonlib in Key.PublicKey
+func EncodePublicKey(publicKey ed25519.PublicKey) string {
+	data := make([]byte, 0, 36)
+	data = append(data, publicKeyTag...)
+	data = append(data, publicKey...)
+	crc := make([]byte, 2)
+	binary.BigEndian.PutUint16(crc, crc16.Checksum(data))
+	data = append(data, crc...)
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// DecodePublicKey parses public key serialized by tonlib
+func DecodePublicKey(publicKey string) (ed25519.PublicKey, error) {
+	data, err := base64.URLEncoding.DecodeString(publicKey)
+	if err != nil {
+		data, err = base64.StdEncoding.DecodeString(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode public key: %v", err)
+		}
+	}
+	if len(data) != 36 {
+		return nil, fmt.Errorf("invalid public key length: %d", len(data))
+	}
+	if data[0] != publicKeyTag[0] || data[1] != publicKeyTag[1] {
+		return nil, fmt.Errorf("invalid public key tag: %x", data[:2])
+	}
+	if crc16.Checksum(data[:34]) != binary.BigEndian.Uint16(data[34:]) {
+		return nil, fmt.Errorf("invalid public key checksum")
+	}
+	return ed25519.PublicKey(data[2:34]), nil
+}
@@ -0,0 +1,120 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testSeed = bytes.Repeat([]byte{7}, ed25519.SeedSize)
+
+func TestMemorySigner(t *testing.T) {
+	s, err := NewMemorySignerFromSeed(testSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("test message")
+	signature, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(s, msg, signature) {
+		t.Fatal("signature verification failed")
+	}
+}
+
+func TestEncodePublicKey(t *testing.T) {
+	s, err := NewMemorySignerFromSeed(testSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := EncodePublicKey(s.PublicKey())
+	if len(encoded) != 48 {
+		t.Fatalf("expected 48 chars, got %d: %s", len(encoded), encoded)
+	}
+	decoded, err := DecodePublicKey(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decoded, s.PublicKey()) {
+		t.Fatalf("decoded key mismatch: %x != %x", decoded, s.PublicKey())
+	}
+
+	broken := []byte(encoded)
+	broken[10]++
+	if _, err := DecodePublicKey(string(broken)); err == nil {
+		t.Fatal("expected checksum error")
+	}
+}
+
+func TestFileSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "key")
+	privateKey := ed25519.NewKeyFromSeed(testSeed)
+	if err := WriteKeyFile(path, privateKey); err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewFileSigner(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("test message")
+	signature, err := s.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(signature, ed25519.Sign(privateKey, msg)) {
+		t.Fatal("file signer produced unexpected signature")
+	}
+
+	if err := os.Chmod(path, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Sign(msg); err == nil {
+		t.Fatal("expected error for world readable key file")
+	}
+}
+
+func TestSocketSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	local, err := NewMemorySignerFromSeed(testSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, "signer.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go Serve(listener, local)
+
+	remote, err := NewUnixSigner(listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(remote.PublicKey(), local.PublicKey()) {
+		t.Fatal("public key mismatch")
+	}
+	msg := []byte("test message")
+	signature, err := remote.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Verify(local, msg, signature) {
+		t.Fatal("signature verification failed")
+	}
+}
@@ -0,0 +1,147 @@
+package signer
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	socketRequestPublicKey = "getPublicKey"
+	socketRequestSign      = "sign"
+	socketResponsePublic   = "publicKey"
+	socketResponseSign     = "signature"
+	socketResponseError    = "error"
+
+	DefaultSocketTimeout = 10 * time.Second
+)
+
+// socketMessage is a single JSON line exchanged between SocketSigner and Serve
+type socketMessage struct {
+	Type      string `json:"@type"`
+	Message   []byte `json:"message,omitempty"`
+	PublicKey []byte `json:"public_key,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// SocketSigner asks a separate signing process listening on a Unix (or any other stream) socket
+// to sign messages. The process is expected to run Serve
+type SocketSigner struct {
+	network   string
+	address   string
+	timeout   time.Duration
+	publicKey ed25519.PublicKey
+}
+
+// NewSocketSigner connects to the signing process and fetches its public key
+func NewSocketSigner(network, address string, timeout time.Duration) (*SocketSigner, error) {
+	if timeout <= 0 {
+		timeout = DefaultSocketTimeout
+	}
+	signer := &SocketSigner{network: network, address: address, timeout: timeout}
+	resp, err := signer.call(socketMessage{Type: socketRequestPublicKey})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Type != socketResponsePublic || len(resp.PublicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("unexpected response from signer: %s", resp.Type)
+	}
+	signer.publicKey = resp.PublicKey
+	return signer, nil
+}
+
+// NewUnixSigner is a shortcut for NewSocketSigner over a Unix socket
+func NewUnixSigner(path string) (*SocketSigner, error) {
+	return NewSocketSigner("unix", path, DefaultSocketTimeout)
+}
+
+// PublicKey returns public key of the remote signer
+func (signer *SocketSigner) PublicKey() ed25519.PublicKey {
+	return signer.publicKey
+}
+
+// Sign sends message to the remote signer and verifies returned signature
+func (signer *SocketSigner) Sign(message []byte) ([]byte, error) {
+	resp, err := signer.call(socketMessage{Type: socketRequestSign, Message: message})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Type != socketResponseSign {
+		return nil, fmt.Errorf("unexpected response from signer: %s", resp.Type)
+	}
+	if !ed25519.Verify(signer.publicKey, message, resp.Signature) {
+		return nil, fmt.Errorf("signer returned invalid signature")
+	}
+	return resp.Signature, nil
+}
+
+func (signer *SocketSigner) call(req socketMessage) (*socketMessage, error) {
+	conn, err := net.DialTimeout(signer.network, signer.address, signer.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(signer.timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.NewEncoder(conn).Encode(req)
+	if err != nil {
+		return nil, err
+	}
+	var resp socketMessage
+	err = json.NewDecoder(bufio.NewReader(conn)).Decode(&resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Type == socketResponseError {
+		return nil, fmt.Errorf("signer error: %s", resp.Error)
+	}
+	return &resp, nil
+}
+
+// Serve answers SocketSigner requests accepted on listener using signer until listener is closed
+func Serve(listener net.Listener, signer Signer) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go serveConn(conn, signer)
+	}
+}
+
+func serveConn(conn net.Conn, signer Signer) {
+	defer conn.Close()
+	decoder := json.NewDecoder(bufio.NewReader(conn))
+	encoder := json.NewEncoder(conn)
+	for {
+		var req socketMessage
+		if err := decoder.Decode(&req); err != nil {
+			return
+		}
+
+		var resp socketMessage
+		switch req.Type {
+		case socketRequestPublicKey:
+			resp = socketMessage{Type: socketResponsePublic, PublicKey: signer.PublicKey()}
+		case socketRequestSign:
+			signature, err := signer.Sign(req.Message)
+			if err != nil {
+				resp = socketMessage{Type: socketResponseError, Error: err.Error()}
+			} else {
+				resp = socketMessage{Type: socketResponseSign, Signature: signature}
+			}
+		default:
+			resp = socketMessage{Type: socketResponseError, Error: fmt.Sprintf("unknown request type: %s", req.Type)}
+		}
+		if err := encoder.Encode(resp); err != nil {
+			return
+		}
+	}
+}
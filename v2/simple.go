@@ -3,7 +3,8 @@ package v2
 import (
 	"fmt"
 	"io/ioutil"
-	"strconv"
+
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
 )
 
 const SmcRunResultType = "smc.runResult"
@@ -44,35 +45,57 @@ func (client *Client) GetActiveElectionID(address string) (int64, error) {
 		return 0, fmt.Errorf("Empty stack response: %#v", runMethodResult.Type, *runMethodResult)
 	}
 
-	// map response
-	firstEntity, ok := runMethodResult.Stack[0].(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", runMethodResult.Stack[0])
+	return stackInt64(runMethodResult.Stack[0])
+}
+
+func (client *Client) LoadContract(address string) (*SmcInfo, error) {
+	contract := NewAccountAddress(address)
+	smcInfo, err := client.SmcLoad(*contract)
+	if err != nil {
+		return nil, err
 	}
-	firstNum, ok := firstEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty 'number': %#v", firstEntity)
+	return smcInfo, nil
+}
+
+// RunGetMethod runs get method of the contract and returns its stack, non-zero exit code is an error
+func (client *Client) RunGetMethod(contract string, method string, params []TvmStackEntry) ([]TvmStackEntry, error) {
+	smcInfo, err := client.LoadContract(contract)
+	if err != nil {
+		return nil, err
 	}
+	return client.runLoadedGetMethod(smcInfo, method, params)
+}
 
-	secondEntity, ok := firstNum.(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", secondEntity)
+// runLoadedGetMethod runs get method of the contract loaded with smc.load
+func (client *Client) runLoadedGetMethod(smcInfo *SmcInfo, method string, params []TvmStackEntry) ([]TvmStackEntry, error) {
+	if params == nil {
+		params = []TvmStackEntry{}
 	}
-	secondNum, ok := secondEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty second 'number': %#v", secondEntity)
+	result, err := client.SmcRunGetMethod(smcInfo.Id, NewSmcMethodIdName(method), params)
+	if err != nil {
+		return nil, err
 	}
-
-	return strconv.ParseInt(secondNum.(string), 10, 64)
+	if result.Type != SmcRunResultType {
+		return nil, fmt.Errorf("unexpected %s result type: %s", method, result.Type)
+	}
+	// 1 is the alternative successful exit code
+	if result.ExitCode != NoErrorCode && result.ExitCode != 1 {
+		return nil, fmt.Errorf("%s failed with exit code %d", method, result.ExitCode)
+	}
+	return result.Stack, nil
 }
 
-func (client *Client) LoadContract(address string) (*SmcInfo, error) {
-	contract := NewAccountAddress(address)
-	smcInfo, err := client.SmcLoad(*contract)
+// runGetMethodValues runs get method with the tvm package entries as params and converts its stack to them
+func (client *Client) runGetMethodValues(contract string, method string, params ...tvm.Entry) ([]tvm.Entry, error) {
+	entries, err := StackEntries(params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s params: %v", method, err)
+	}
+	stack, err := client.RunGetMethod(contract, method, entries)
 	if err != nil {
 		return nil, err
 	}
-	return smcInfo, nil
+	return StackValues(stack)
 }
 
 func (client *Client) GetWalletSeqno(address string) (int64, error) {
@@ -93,26 +116,7 @@ func (client *Client) GetWalletSeqno(address string) (int64, error) {
 		return 0, fmt.Errorf("Empty stack response: %#v", runMethodResult.Type, *runMethodResult)
 	}
 
-	// map response
-	firstEntity, ok := runMethodResult.Stack[0].(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", runMethodResult.Stack[0])
-	}
-	firstNum, ok := firstEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty 'number': %#v", firstEntity)
-	}
-
-	secondEntity, ok := firstNum.(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", secondEntity)
-	}
-	secondNum, ok := secondEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty second 'number': %#v", secondEntity)
-	}
-
-	return strconv.ParseInt(secondNum.(string), 10, 64)
+	return stackInt64(runMethodResult.Stack[0])
 }
 
 func (client *Client) GetParticipantList(address string) (*[]TvmStackEntry, error) {
@@ -149,125 +153,47 @@ func (client *Client) GetParticipantListExtended(electorAddress string) (*[]Elec
 	if len(runMethodResult.Stack) != 1 {
 		return nil, fmt.Errorf("expected length of Stack: 1, but got: %d. Resp: %#v", len(runMethodResult.Stack), runMethodResult.Stack)
 	}
-	stackEntryList, ok := runMethodResult.Stack[0].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("#1 failed to parse element as map[string]interface{}. element: %#v", runMethodResult.Stack[0])
-	}
-	listValueInterface, ok := stackEntryList["list"]
-	if !ok {
-		return nil, fmt.Errorf("#2 failed to find `list` in dict. element: %#v", stackEntryList)
-	}
-	tvmList, ok := listValueInterface.(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("#3 failed to parse element as map[string]interface{}. element: %#v", listValueInterface)
-	}
-	elementsListInterface, ok := tvmList["elements"]
-	if !ok {
-		return nil, fmt.Errorf("#4 failed to find `elements` in dict. element: %#v", tvmList)
-	}
-	elementsList, ok := elementsListInterface.([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("#5 failed to parse element as []interface{}. element: %#v", elementsListInterface)
+	list, ok := runMethodResult.Stack[0].(*TvmStackEntryList)
+	if !ok || list.List == nil {
+		return nil, fmt.Errorf("expected list, got: %#v", runMethodResult.Stack[0])
 	}
 
-	// parse elements
+	// parse elements: tuples of id and tuple of stake, max factor, address and adnl address
 	participants := []ElectionParticipant{}
-	for _, el := range elementsList {
-		tupleEl, ok := el.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#6 failed to parse element as map[string]interface{}. element: %#v", el)
+	for _, el := range list.List.Elements {
+		value, err := StackValue(el)
+		if err != nil {
+			return nil, err
 		}
-		tupleElementsInterface, ok := tupleEl["tuple"]
-		if !ok {
-			return nil, fmt.Errorf("#7 failed to find `tuple` in dict. element: %#v", tupleEl)
-		}
-		tupleElementsDict, ok := tupleElementsInterface.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#8 failed to parse element as map[string]interface{}. element: %#v", tupleElementsInterface)
-		}
-		elElementsInterface, ok := tupleElementsDict["elements"]
-		if !ok {
-			return nil, fmt.Errorf("#9 failed to find `elements` in dict. element: %#v", tupleElementsDict)
-		}
-		elElements, ok := elElementsInterface.([]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#10 failed to parse element as []interface{}. element: %#v", elElementsInterface)
+		elElements, err := tvm.Tuple(value)
+		if err != nil {
+			return nil, err
 		}
 		if len(elElements) != 2 {
-			return nil, fmt.Errorf("#11 expected length of elElements: 2, but got: %d. elements: %#v", len(elElements), elElements)
-		}
-		// parse id
-		idElementDict, ok := elElements[0].(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#12 failed to parse element as map[string]interface{}. element: %#v", elElements[0])
+			return nil, fmt.Errorf("expected length of elElements: 2, but got: %d. elements: %#v", len(elElements), elElements)
 		}
-		idElementNumberInterface, ok := idElementDict["number"]
-		if !ok {
-			return nil, fmt.Errorf("#13 failed to find `number` in dict. element: %#v", idElementDict)
+		id, err := tvm.Number(elElements[0])
+		if err != nil {
+			return nil, err
 		}
-		idElementNumberDict, ok := idElementNumberInterface.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#14 failed to parse element as map[string]interface{}. element: %#v", idElementNumberInterface)
+		valuesElements, err := tvm.Tuple(elElements[1])
+		if err != nil {
+			return nil, err
 		}
-		idElementNumberValueInterface, ok := idElementNumberDict["number"]
-		if !ok {
-			return nil, fmt.Errorf("#15 failed to find `number` in dict. element: %#v", idElementNumberDict)
-		}
-		idElementValue, ok := idElementNumberValueInterface.(string)
-		if !ok {
-			return nil, fmt.Errorf("#16 failed to parse element as string. element: %#v", idElementNumberValueInterface)
-		}
-		// parse other values struct
-		valuesElementDict, ok := elElements[1].(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#17 failed to parse element as map[string]interface{}. element: %#v", elElements[1])
-		}
-		valuesElementTupleInterface, ok := valuesElementDict["tuple"]
-		if !ok {
-			return nil, fmt.Errorf("#18 failed to find `tuple` in dict. element: %#v", valuesElementDict)
-		}
-		valuesElementTupleDict, ok := valuesElementTupleInterface.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#19 failed to parse element as map[string]interface{}. element: %#v", valuesElementTupleInterface)
-		}
-		valuesElementTupleElementsInterface, ok := valuesElementTupleDict["elements"]
-		if !ok {
-			return nil, fmt.Errorf("#20 failed to find `elements` in dict. element: %#v", valuesElementTupleDict)
-		}
-		valuesElementTupleElements, ok := valuesElementTupleElementsInterface.([]interface{})
-		if !ok {
-			return nil, fmt.Errorf("#21 failed to parse element as []interface{}. element: %#v", valuesElementTupleElementsInterface)
-		}
-		if len(valuesElementTupleElements) != 4 {
-			return nil, fmt.Errorf("#22 expected length of valuesElementTupleElements: 4, but got: %d. elements: %#v", len(valuesElementTupleElements), valuesElementTupleElements)
+		if len(valuesElements) != 4 {
+			return nil, fmt.Errorf("expected length of valuesElements: 4, but got: %d. elements: %#v", len(valuesElements), valuesElements)
 		}
 		values := []string{"", "", "", ""}
-		// parse value
-		for i, valuesElementTupleElementInterface := range valuesElementTupleElements {
-			valuesElementTupleElement, ok := valuesElementTupleElementInterface.(map[string]interface{})
-			if !ok {
-				return nil, fmt.Errorf("#23 failed to parse element as map[string]interface{}. element: %#v", valuesElementTupleElementInterface)
-			}
-			valuesElementTupleElementNumberInterface, ok := valuesElementTupleElement["number"]
-			if !ok {
-				return nil, fmt.Errorf("#24 failed to find `number` in dict. element: %#v", valuesElementTupleElement)
-			}
-			valuesElementTupleElementNumberDict, ok := valuesElementTupleElementNumberInterface.(map[string]interface{})
-			if !ok {
-				return nil, fmt.Errorf("#25 failed to parse element as map[string]interface{}. element: %#v", valuesElementTupleElementNumberInterface)
-			}
-			valuesElementTupleElementNumberValueInterface, ok := valuesElementTupleElementNumberDict["number"]
-			if !ok {
-				return nil, fmt.Errorf("#26 failed to find `number` in dict. element: %#v", valuesElementTupleElementNumberDict)
-			}
-			values[i], ok = valuesElementTupleElementNumberValueInterface.(string)
-			if !ok {
-				return nil, fmt.Errorf("#27 failed to parse element as string. element: %#v", valuesElementTupleElementNumberValueInterface)
+		for i, valuesElement := range valuesElements {
+			number, err := tvm.Number(valuesElement)
+			if err != nil {
+				return nil, err
 			}
+			values[i] = number.String()
 		}
 
 		item := ElectionParticipant{
-			Id:                 idElementValue,
+			Id:                 id.String(),
 			Stake:              values[0],
 			MaxFactor:          values[1],
 			ParticipantAddress: values[2],
@@ -303,26 +229,7 @@ func (client *Client) CheckParticipatesIn(pubKey, address string) (int64, error)
 		return 0, fmt.Errorf("got an empty Stack in the response")
 	}
 
-	// map response
-	firstEntity, ok := runMethodResult.Stack[0].(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", runMethodResult.Stack[0])
-	}
-	firstNum, ok := firstEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty 'number': %#v", firstEntity)
-	}
-
-	secondEntity, ok := firstNum.(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", secondEntity)
-	}
-	secondNum, ok := secondEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty second 'number': %#v", secondEntity)
-	}
-
-	return strconv.ParseInt(secondNum.(string), 10, 64)
+	return stackInt64(runMethodResult.Stack[0])
 }
 
 func (client *Client) CheckReward(address, electorAddress string) (int64, error) {
@@ -347,26 +254,7 @@ func (client *Client) CheckReward(address, electorAddress string) (int64, error)
 		return 0, fmt.Errorf("got response with empty stack: %#v", runMethodResult)
 	}
 
-	// map response
-	firstEntity, ok := runMethodResult.Stack[0].(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", runMethodResult.Stack[0])
-	}
-	firstNum, ok := firstEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty 'number': %#v", firstEntity)
-	}
-
-	secondEntity, ok := firstNum.(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Failed to map `%#v  to `map[string]interface{}`", secondEntity)
-	}
-	secondNum, ok := secondEntity["number"]
-	if !ok {
-		return 0, fmt.Errorf("got response with empty second 'number': %#v", secondEntity)
-	}
-
-	return strconv.ParseInt(secondNum.(string), 10, 64)
+	return stackInt64(runMethodResult.Stack[0])
 }
 
 func (client *Client) GetAccountStateSimple(address string) (*FullAccountState, error) {
@@ -0,0 +1,128 @@
+package v2
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+// StackValues converts get method stack to entries for the tvm package decoders
+func StackValues(stack []TvmStackEntry) ([]tvm.Entry, error) {
+	values := make([]tvm.Entry, len(stack))
+	for i, entry := range stack {
+		value, err := StackValue(entry)
+		if err != nil {
+			return nil, fmt.Errorf("stack[%d]: %v", i, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// StackValue converts a single stack entry, tvm.stackEntryUnsupported is a null
+func StackValue(entry TvmStackEntry) (tvm.Entry, error) {
+	switch entry := entry.(type) {
+	case *TvmStackEntryNumber:
+		decimal, ok := entry.Number.(*TvmNumberDecimal)
+		if !ok {
+			return nil, fmt.Errorf("unsupported number: %#v", entry.Number)
+		}
+		number, ok := new(big.Int).SetString(decimal.Number, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid number: %s", decimal.Number)
+		}
+		return number, nil
+	case *TvmStackEntryCell:
+		if entry.Cell == nil {
+			return nil, fmt.Errorf("cell entry without cell")
+		}
+		return cell.FromBOC(entry.Cell.Bytes)
+	case *TvmStackEntrySlice:
+		if entry.Slice == nil {
+			return nil, fmt.Errorf("slice entry without slice")
+		}
+		c, err := cell.FromBOC(entry.Slice.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return c.BeginParse(), nil
+	case *TvmStackEntryTuple:
+		if entry.Tuple == nil {
+			return nil, fmt.Errorf("tuple entry without tuple")
+		}
+		elements, err := StackValues(entry.Tuple.Elements)
+		if err != nil {
+			return nil, err
+		}
+		return tvm.TupleEntry(elements), nil
+	case *TvmStackEntryList:
+		if entry.List == nil {
+			return nil, fmt.Errorf("list entry without list")
+		}
+		elements, err := StackValues(entry.List.Elements)
+		if err != nil {
+			return nil, err
+		}
+		return tvm.ListEntry(elements), nil
+	case *TvmStackEntryUnsupported:
+		return nil, nil
+	case nil:
+		return nil, fmt.Errorf("empty stack entry")
+	default:
+		return nil, fmt.Errorf("unsupported stack entry: %s", entry.MessageType())
+	}
+}
+
+// StackEntries converts the tvm package entries to get method params
+func StackEntries(values ...tvm.Entry) ([]TvmStackEntry, error) {
+	entries := make([]TvmStackEntry, len(values))
+	for i, value := range values {
+		entry, err := StackEntry(value)
+		if err != nil {
+			return nil, fmt.Errorf("stack[%d]: %v", i, err)
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
+
+// StackEntry converts a single tvm package entry
+func StackEntry(value tvm.Entry) (TvmStackEntry, error) {
+	switch value := value.(type) {
+	case *big.Int:
+		return NewTvmStackEntryNumber(NewTvmNumberDecimal(value.String())), nil
+	case *cell.Cell:
+		return NewTvmStackEntryCell(NewTvmCell(value.ToBOC())), nil
+	case *cell.Slice:
+		c, err := value.ToCell()
+		if err != nil {
+			return nil, err
+		}
+		return NewTvmStackEntrySlice(NewTvmSlice(c.ToBOC())), nil
+	case tvm.TupleEntry:
+		elements, err := StackEntries(value...)
+		if err != nil {
+			return nil, err
+		}
+		return NewTvmStackEntryTuple(NewTvmTuple(elements)), nil
+	case tvm.ListEntry:
+		elements, err := StackEntries(value...)
+		if err != nil {
+			return nil, err
+		}
+		return NewTvmStackEntryList(NewTvmList(elements)), nil
+	default:
+		return nil, fmt.Errorf("unsupported stack value: %T", value)
+	}
+}
+
+// stackInt64 decodes a number entry which has to fit into int64
+func stackInt64(entry TvmStackEntry) (int64, error) {
+	value, err := StackValue(entry)
+	if err != nil {
+		return 0, err
+	}
+	return tvm.Int64(value)
+}
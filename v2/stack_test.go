@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/tvm"
+)
+
+func TestStackValues(t *testing.T) {
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(0xdeadbeef, 32); err != nil {
+		t.Fatal(err)
+	}
+	c := b.EndCell()
+	params, err := StackEntries(
+		tvm.NumberEntry(big.NewInt(-7)),
+		tvm.TupleEntry{tvm.CellEntry(c), tvm.SliceEntry(c)},
+		tvm.ListEntry{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the stack goes through json as it does from tonlib
+	data, err := json.Marshal(NewSmcRunResult(0, 100, params))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result SmcRunResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := result.Stack[1].(*TvmStackEntryTuple); !ok {
+		t.Fatalf("unexpected stack entry: %#v", result.Stack[1])
+	}
+	stack, err := StackValues(result.Stack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := tvm.Int64(stack[0]); err != nil || n != -7 {
+		t.Fatalf("unexpected number: %v %v", n, err)
+	}
+	tuple, err := tvm.Tuple(stack[1])
+	if err != nil || len(tuple) != 2 {
+		t.Fatalf("unexpected tuple: %v %v", tuple, err)
+	}
+	if decoded, err := tvm.Cell(tuple[0]); err != nil || string(decoded.Hash()) != string(c.Hash()) {
+		t.Fatalf("unexpected cell: %v %v", decoded, err)
+	}
+	if slice, err := tvm.Slice(tuple[1]); err != nil || slice.BitsLeft() != 32 {
+		t.Fatalf("unexpected slice: %v %v", slice, err)
+	}
+	if !tvm.IsNull(stack[2]) {
+		t.Fatalf("expected null, got %#v", stack[2])
+	}
+
+	if _, err := StackEntries("text"); err == nil {
+		t.Fatal("expected error for unsupported value")
+	}
+}
+
+func TestStackUnknownEntry(t *testing.T) {
+	raw := `{"@type":"smc.runResult","gas_used":1,"exit_code":0,"stack":[` +
+		`{"@type":"tvm.stackEntryUnsupported"},{"@type":"tvm.stackEntryFuture","value":1}]}`
+	var result SmcRunResult
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		t.Fatal(err)
+	}
+	unknown, ok := result.Stack[1].(*UnknownTvmStackEntry)
+	if !ok || unknown.MessageType() != "tvm.stackEntryFuture" {
+		t.Fatalf("unexpected stack entry: %#v", result.Stack[1])
+	}
+	if data, err := json.Marshal(unknown); err != nil || string(data) != `{"@type":"tvm.stackEntryFuture","value":1}` {
+		t.Fatalf("unexpected json: %s %v", data, err)
+	}
+	if value, err := StackValue(result.Stack[0]); err != nil || !tvm.IsNull(value) {
+		t.Fatalf("unexpected unsupported entry: %v %v", value, err)
+	}
+	if _, err := StackValues(result.Stack); err == nil {
+		t.Fatal("expected error for unknown entry")
+	}
+}
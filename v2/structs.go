@@ -3,8 +3,8 @@ package v2
 import (
 	"encoding/json"
 	"fmt"
-	"strconv"
-	"strings"
+
+	"github.com/mercuryoio/tonlib-go/v2/tonlibjson"
 )
 
 type tonCommon struct {
@@ -14,32 +14,12 @@ type tonCommon struct {
 
 type SecureBytes []byte
 type SecureString string
-type Bytes []byte
-type TvmStackEntry interface{}
-type SmcMethodId interface{}
-type TvmNumber interface{}
+type Bytes = tonlibjson.Bytes
+type Int256 = tonlibjson.Int256
 type GenericAccountState string
 
 // JSONInt64 alias for int64, in order to deal with json big number problem
-type JSONInt64 int64
-
-// MarshalJSON marshals to json
-func (jsonInt *JSONInt64) MarshalJSON() ([]byte, error) {
-	intStr := strconv.FormatInt(int64(*jsonInt), 10)
-	return []byte(intStr), nil
-}
-
-// UnmarshalJSON unmarshals from json
-func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
-	intStr := string(b)
-	intStr = strings.Replace(intStr, "\"", "", 2)
-	jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
-	if err != nil {
-		return err
-	}
-	*jsonInt = JSONInt64(jsonBigInt)
-	return nil
-}
+type JSONInt64 = tonlibjson.JSONInt64
 
 // TonMessage is the interface for all messages send and received to/from tonlib
 type TonMessage interface {
@@ -54,11 +34,566 @@ const (
 	LogStreamDefaultType LogStreamEnum = "logStreamDefault"
 	LogStreamFileType    LogStreamEnum = "logStreamFile"
 	LogStreamEmptyType   LogStreamEnum = "logStreamEmpty"
+)
+
+// InternalBlockIdEnum Alias for abstract InternalBlockId 'Sub-Classes', used as constant-enum here
+type InternalBlockIdEnum string
+
+// InternalBlockId enums
+const (
+	TonBlockIdType InternalBlockIdEnum = "ton.blockId"
+)
+
+// InitialAccountStateEnum Alias for abstract InitialAccountState 'Sub-Classes', used as constant-enum here
+type InitialAccountStateEnum string
+
+// InitialAccountState enums
+const (
+	RawInitialAccountStateType              InitialAccountStateEnum = "raw.initialAccountState"
+	TestGiverInitialAccountStateType        InitialAccountStateEnum = "testGiver.initialAccountState"
+	TestWalletInitialAccountStateType       InitialAccountStateEnum = "testWallet.initialAccountState"
+	WalletInitialAccountStateType           InitialAccountStateEnum = "wallet.initialAccountState"
+	WalletV3InitialAccountStateType         InitialAccountStateEnum = "wallet.v3.initialAccountState"
+	WalletHighloadV1InitialAccountStateType InitialAccountStateEnum = "wallet.highload.v1.initialAccountState"
+	WalletHighloadV2InitialAccountStateType InitialAccountStateEnum = "wallet.highload.v2.initialAccountState"
+	RwalletInitialAccountStateType          InitialAccountStateEnum = "rwallet.initialAccountState"
+	DnsInitialAccountStateType              InitialAccountStateEnum = "dns.initialAccountState"
+	PchanInitialAccountStateType            InitialAccountStateEnum = "pchan.initialAccountState"
+)
+
+// AccountStateEnum Alias for abstract AccountState 'Sub-Classes', used as constant-enum here
+type AccountStateEnum string
+
+// AccountState enums
+const (
+	RawAccountStateType              AccountStateEnum = "raw.accountState"
+	TestWalletAccountStateType       AccountStateEnum = "testWallet.accountState"
+	WalletAccountStateType           AccountStateEnum = "wallet.accountState"
+	WalletV3AccountStateType         AccountStateEnum = "wallet.v3.accountState"
+	WalletHighloadV1AccountStateType AccountStateEnum = "wallet.highload.v1.accountState"
+	WalletHighloadV2AccountStateType AccountStateEnum = "wallet.highload.v2.accountState"
+	TestGiverAccountStateType        AccountStateEnum = "testGiver.accountState"
+	DnsAccountStateType              AccountStateEnum = "dns.accountState"
+	RwalletAccountStateType          AccountStateEnum = "rwallet.accountState"
+	PchanAccountStateType            AccountStateEnum = "pchan.accountState"
+	UninitedAccountStateType         AccountStateEnum = "uninited.accountState"
+)
+
+// PchanStateEnum Alias for abstract PchanState 'Sub-Classes', used as constant-enum here
+type PchanStateEnum string
+
+// PchanState enums
+const (
+	PchanStateInitType   PchanStateEnum = "pchan.stateInit"
+	PchanStateCloseType  PchanStateEnum = "pchan.stateClose"
+	PchanStatePayoutType PchanStateEnum = "pchan.statePayout"
+)
+
+// MsgDataEnum Alias for abstract MsgData 'Sub-Classes', used as constant-enum here
+type MsgDataEnum string
+
+// MsgData enums
+const (
+	MsgDataRawType           MsgDataEnum = "msg.dataRaw"
+	MsgDataTextType          MsgDataEnum = "msg.dataText"
+	MsgDataDecryptedTextType MsgDataEnum = "msg.dataDecryptedText"
+	MsgDataEncryptedTextType MsgDataEnum = "msg.dataEncryptedText"
+)
+
+// DnsEntryDataEnum Alias for abstract DnsEntryData 'Sub-Classes', used as constant-enum here
+type DnsEntryDataEnum string
+
+// DnsEntryData enums
+const (
+	DnsEntryDataUnknownType      DnsEntryDataEnum = "dns.entryDataUnknown"
+	DnsEntryDataTextType         DnsEntryDataEnum = "dns.entryDataText"
+	DnsEntryDataNextResolverType DnsEntryDataEnum = "dns.entryDataNextResolver"
+	DnsEntryDataSmcAddressType   DnsEntryDataEnum = "dns.entryDataSmcAddress"
+	DnsEntryDataAdnlAddressType  DnsEntryDataEnum = "dns.entryDataAdnlAddress"
+)
+
+// DnsActionEnum Alias for abstract DnsAction 'Sub-Classes', used as constant-enum here
+type DnsActionEnum string
+
+// DnsAction enums
+const (
+	DnsActionDeleteAllType DnsActionEnum = "dns.actionDeleteAll"
+	DnsActionDeleteType    DnsActionEnum = "dns.actionDelete"
+	DnsActionSetType       DnsActionEnum = "dns.actionSet"
+)
+
+// PchanActionEnum Alias for abstract PchanAction 'Sub-Classes', used as constant-enum here
+type PchanActionEnum string
+
+// PchanAction enums
+const (
+	PchanActionInitType    PchanActionEnum = "pchan.actionInit"
+	PchanActionCloseType   PchanActionEnum = "pchan.actionClose"
+	PchanActionTimeoutType PchanActionEnum = "pchan.actionTimeout"
+)
+
+// RwalletActionEnum Alias for abstract RwalletAction 'Sub-Classes', used as constant-enum here
+type RwalletActionEnum string
+
+// RwalletAction enums
+const (
+	RwalletActionInitType RwalletActionEnum = "rwallet.actionInit"
+)
+
+// ActionEnum Alias for abstract Action 'Sub-Classes', used as constant-enum here
+type ActionEnum string
+
+// Action enums
+const (
+	ActionNoopType    ActionEnum = "actionNoop"
+	ActionMsgType     ActionEnum = "actionMsg"
+	ActionDnsType     ActionEnum = "actionDns"
+	ActionPchanType   ActionEnum = "actionPchan"
+	ActionRwalletType ActionEnum = "actionRwallet"
+)
+
+// TvmNumberEnum Alias for abstract TvmNumber 'Sub-Classes', used as constant-enum here
+type TvmNumberEnum string
+
+// TvmNumber enums
+const (
+	TvmNumberDecimalType TvmNumberEnum = "tvm.numberDecimal"
+)
+
+// TvmStackEntryEnum Alias for abstract TvmStackEntry 'Sub-Classes', used as constant-enum here
+type TvmStackEntryEnum string
+
+// TvmStackEntry enums
+const (
+	TvmStackEntrySliceType       TvmStackEntryEnum = "tvm.stackEntrySlice"
+	TvmStackEntryCellType        TvmStackEntryEnum = "tvm.stackEntryCell"
+	TvmStackEntryNumberType      TvmStackEntryEnum = "tvm.stackEntryNumber"
+	TvmStackEntryTupleType       TvmStackEntryEnum = "tvm.stackEntryTuple"
+	TvmStackEntryListType        TvmStackEntryEnum = "tvm.stackEntryList"
+	TvmStackEntryUnsupportedType TvmStackEntryEnum = "tvm.stackEntryUnsupported"
+)
+
+// SmcMethodIdEnum Alias for abstract SmcMethodId 'Sub-Classes', used as constant-enum here
+type SmcMethodIdEnum string
+
+// SmcMethodId enums
+const (
+	SmcMethodIdNumberType SmcMethodIdEnum = "smc.methodIdNumber"
+	SmcMethodIdNameType   SmcMethodIdEnum = "smc.methodIdName"
+)
+
+// UpdateEnum Alias for abstract Update 'Sub-Classes', used as constant-enum here
+type UpdateEnum string
+
+// Update enums
+const (
+	UpdateSendLiteServerQueryType UpdateEnum = "updateSendLiteServerQuery"
+	UpdateSyncStateType           UpdateEnum = "updateSyncState"
 ) // LogStream Describes a stream to which tonlib internal log is written
 type LogStream interface {
+	TonMessage
 	GetLogStreamEnum() LogStreamEnum
 }
 
+// UnknownLogStream holds any LogStream constructor missing in the schema, Raw keeps it as received
+type UnknownLogStream struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownLogStream
+func (unknown *UnknownLogStream) MessageType() string {
+	return unknown.Type
+}
+
+// GetLogStreamEnum return the enum type of UnknownLogStream
+func (unknown *UnknownLogStream) GetLogStreamEnum() LogStreamEnum {
+	return LogStreamEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownLogStream) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// InternalBlockId
+type InternalBlockId interface {
+	TonMessage
+	GetInternalBlockIdEnum() InternalBlockIdEnum
+}
+
+// UnknownInternalBlockId holds any InternalBlockId constructor missing in the schema, Raw keeps it as received
+type UnknownInternalBlockId struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownInternalBlockId
+func (unknown *UnknownInternalBlockId) MessageType() string {
+	return unknown.Type
+}
+
+// GetInternalBlockIdEnum return the enum type of UnknownInternalBlockId
+func (unknown *UnknownInternalBlockId) GetInternalBlockIdEnum() InternalBlockIdEnum {
+	return InternalBlockIdEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownInternalBlockId) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// InitialAccountState
+type InitialAccountState interface {
+	TonMessage
+	GetInitialAccountStateEnum() InitialAccountStateEnum
+}
+
+// UnknownInitialAccountState holds any InitialAccountState constructor missing in the schema, Raw keeps it as received
+type UnknownInitialAccountState struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownInitialAccountState
+func (unknown *UnknownInitialAccountState) MessageType() string {
+	return unknown.Type
+}
+
+// GetInitialAccountStateEnum return the enum type of UnknownInitialAccountState
+func (unknown *UnknownInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return InitialAccountStateEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownInitialAccountState) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// AccountState
+type AccountState interface {
+	TonMessage
+	GetAccountStateEnum() AccountStateEnum
+}
+
+// UnknownAccountState holds any AccountState constructor missing in the schema, Raw keeps it as received
+type UnknownAccountState struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownAccountState
+func (unknown *UnknownAccountState) MessageType() string {
+	return unknown.Type
+}
+
+// GetAccountStateEnum return the enum type of UnknownAccountState
+func (unknown *UnknownAccountState) GetAccountStateEnum() AccountStateEnum {
+	return AccountStateEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownAccountState) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// PchanState
+type PchanState interface {
+	TonMessage
+	GetPchanStateEnum() PchanStateEnum
+}
+
+// UnknownPchanState holds any PchanState constructor missing in the schema, Raw keeps it as received
+type UnknownPchanState struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownPchanState
+func (unknown *UnknownPchanState) MessageType() string {
+	return unknown.Type
+}
+
+// GetPchanStateEnum return the enum type of UnknownPchanState
+func (unknown *UnknownPchanState) GetPchanStateEnum() PchanStateEnum {
+	return PchanStateEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownPchanState) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// MsgData
+type MsgData interface {
+	TonMessage
+	GetMsgDataEnum() MsgDataEnum
+}
+
+// UnknownMsgData holds any MsgData constructor missing in the schema, Raw keeps it as received
+type UnknownMsgData struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownMsgData
+func (unknown *UnknownMsgData) MessageType() string {
+	return unknown.Type
+}
+
+// GetMsgDataEnum return the enum type of UnknownMsgData
+func (unknown *UnknownMsgData) GetMsgDataEnum() MsgDataEnum {
+	return MsgDataEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownMsgData) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// DnsEntryData
+type DnsEntryData interface {
+	TonMessage
+	GetDnsEntryDataEnum() DnsEntryDataEnum
+}
+
+// UnknownDnsEntryData holds any DnsEntryData constructor missing in the schema, Raw keeps it as received
+type UnknownDnsEntryData struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownDnsEntryData
+func (unknown *UnknownDnsEntryData) MessageType() string {
+	return unknown.Type
+}
+
+// GetDnsEntryDataEnum return the enum type of UnknownDnsEntryData
+func (unknown *UnknownDnsEntryData) GetDnsEntryDataEnum() DnsEntryDataEnum {
+	return DnsEntryDataEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownDnsEntryData) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// DnsAction
+type DnsAction interface {
+	TonMessage
+	GetDnsActionEnum() DnsActionEnum
+}
+
+// UnknownDnsAction holds any DnsAction constructor missing in the schema, Raw keeps it as received
+type UnknownDnsAction struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownDnsAction
+func (unknown *UnknownDnsAction) MessageType() string {
+	return unknown.Type
+}
+
+// GetDnsActionEnum return the enum type of UnknownDnsAction
+func (unknown *UnknownDnsAction) GetDnsActionEnum() DnsActionEnum {
+	return DnsActionEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownDnsAction) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// PchanAction
+type PchanAction interface {
+	TonMessage
+	GetPchanActionEnum() PchanActionEnum
+}
+
+// UnknownPchanAction holds any PchanAction constructor missing in the schema, Raw keeps it as received
+type UnknownPchanAction struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownPchanAction
+func (unknown *UnknownPchanAction) MessageType() string {
+	return unknown.Type
+}
+
+// GetPchanActionEnum return the enum type of UnknownPchanAction
+func (unknown *UnknownPchanAction) GetPchanActionEnum() PchanActionEnum {
+	return PchanActionEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownPchanAction) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// RwalletAction
+type RwalletAction interface {
+	TonMessage
+	GetRwalletActionEnum() RwalletActionEnum
+}
+
+// UnknownRwalletAction holds any RwalletAction constructor missing in the schema, Raw keeps it as received
+type UnknownRwalletAction struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownRwalletAction
+func (unknown *UnknownRwalletAction) MessageType() string {
+	return unknown.Type
+}
+
+// GetRwalletActionEnum return the enum type of UnknownRwalletAction
+func (unknown *UnknownRwalletAction) GetRwalletActionEnum() RwalletActionEnum {
+	return RwalletActionEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownRwalletAction) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// Action
+type Action interface {
+	TonMessage
+	GetActionEnum() ActionEnum
+}
+
+// UnknownAction holds any Action constructor missing in the schema, Raw keeps it as received
+type UnknownAction struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownAction
+func (unknown *UnknownAction) MessageType() string {
+	return unknown.Type
+}
+
+// GetActionEnum return the enum type of UnknownAction
+func (unknown *UnknownAction) GetActionEnum() ActionEnum {
+	return ActionEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownAction) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// TvmNumber
+type TvmNumber interface {
+	TonMessage
+	GetTvmNumberEnum() TvmNumberEnum
+}
+
+// UnknownTvmNumber holds any TvmNumber constructor missing in the schema, Raw keeps it as received
+type UnknownTvmNumber struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownTvmNumber
+func (unknown *UnknownTvmNumber) MessageType() string {
+	return unknown.Type
+}
+
+// GetTvmNumberEnum return the enum type of UnknownTvmNumber
+func (unknown *UnknownTvmNumber) GetTvmNumberEnum() TvmNumberEnum {
+	return TvmNumberEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownTvmNumber) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// TvmStackEntry
+type TvmStackEntry interface {
+	TonMessage
+	GetTvmStackEntryEnum() TvmStackEntryEnum
+}
+
+// UnknownTvmStackEntry holds any TvmStackEntry constructor missing in the schema, Raw keeps it as received
+type UnknownTvmStackEntry struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownTvmStackEntry
+func (unknown *UnknownTvmStackEntry) MessageType() string {
+	return unknown.Type
+}
+
+// GetTvmStackEntryEnum return the enum type of UnknownTvmStackEntry
+func (unknown *UnknownTvmStackEntry) GetTvmStackEntryEnum() TvmStackEntryEnum {
+	return TvmStackEntryEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownTvmStackEntry) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// SmcMethodId
+type SmcMethodId interface {
+	TonMessage
+	GetSmcMethodIdEnum() SmcMethodIdEnum
+}
+
+// UnknownSmcMethodId holds any SmcMethodId constructor missing in the schema, Raw keeps it as received
+type UnknownSmcMethodId struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownSmcMethodId
+func (unknown *UnknownSmcMethodId) MessageType() string {
+	return unknown.Type
+}
+
+// GetSmcMethodIdEnum return the enum type of UnknownSmcMethodId
+func (unknown *UnknownSmcMethodId) GetSmcMethodIdEnum() SmcMethodIdEnum {
+	return SmcMethodIdEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownSmcMethodId) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
+// Update
+type Update interface {
+	TonMessage
+	GetUpdateEnum() UpdateEnum
+}
+
+// UnknownUpdate holds any Update constructor missing in the schema, Raw keeps it as received
+type UnknownUpdate struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+// MessageType return the string telegram-type of UnknownUpdate
+func (unknown *UnknownUpdate) MessageType() string {
+	return unknown.Type
+}
+
+// GetUpdateEnum return the enum type of UnknownUpdate
+func (unknown *UnknownUpdate) GetUpdateEnum() UpdateEnum {
+	return UpdateEnum(unknown.Type)
+}
+
+// MarshalJSON returns the object as received
+func (unknown *UnknownUpdate) MarshalJSON() ([]byte, error) {
+	return unknown.Raw, nil
+}
+
 // Double
 type Double struct {
 	tonCommon
@@ -69,6 +604,14 @@ func (double *Double) MessageType() string {
 	return "double"
 }
 
+// Validate checks Double before it is sent to tonlib
+func (double *Double) Validate() error {
+	if double == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewDouble creates a new Double
 //
 func NewDouble() *Double {
@@ -89,6 +632,14 @@ func (string *String) MessageType() string {
 	return "string"
 }
 
+// Validate checks String before it is sent to tonlib
+func (string *String) Validate() error {
+	if string == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewString creates a new String
 //
 func NewString() *String {
@@ -109,6 +660,14 @@ func (int32 *Int32) MessageType() string {
 	return "int32"
 }
 
+// Validate checks Int32 before it is sent to tonlib
+func (int32 *Int32) Validate() error {
+	if int32 == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewInt32 creates a new Int32
 //
 func NewInt32() *Int32 {
@@ -129,6 +688,14 @@ func (int53 *Int53) MessageType() string {
 	return "int53"
 }
 
+// Validate checks Int53 before it is sent to tonlib
+func (int53 *Int53) Validate() error {
+	if int53 == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewInt53 creates a new Int53
 //
 func NewInt53() *Int53 {
@@ -149,6 +716,14 @@ func (int64 *Int64) MessageType() string {
 	return "int64"
 }
 
+// Validate checks Int64 before it is sent to tonlib
+func (int64 *Int64) Validate() error {
+	if int64 == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewInt64 creates a new Int64
 //
 func NewInt64() *Int64 {
@@ -169,6 +744,14 @@ func (object *Object) MessageType() string {
 	return "object"
 }
 
+// Validate checks Object before it is sent to tonlib
+func (object *Object) Validate() error {
+	if object == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewObject creates a new Object
 //
 func NewObject() *Object {
@@ -189,6 +772,14 @@ func (function *Function) MessageType() string {
 	return "function"
 }
 
+// Validate checks Function before it is sent to tonlib
+func (function *Function) Validate() error {
+	if function == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewFunction creates a new Function
 //
 func NewFunction() *Function {
@@ -209,6 +800,14 @@ func (boolFalse *BoolFalse) MessageType() string {
 	return "boolFalse"
 }
 
+// Validate checks BoolFalse before it is sent to tonlib
+func (boolFalse *BoolFalse) Validate() error {
+	if boolFalse == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewBoolFalse creates a new BoolFalse
 //
 func NewBoolFalse() *BoolFalse {
@@ -229,6 +828,14 @@ func (boolTrue *BoolTrue) MessageType() string {
 	return "boolTrue"
 }
 
+// Validate checks BoolTrue before it is sent to tonlib
+func (boolTrue *BoolTrue) Validate() error {
+	if boolTrue == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewBoolTrue creates a new BoolTrue
 //
 func NewBoolTrue() *BoolTrue {
@@ -251,6 +858,14 @@ func (error *Error) MessageType() string {
 	return "error"
 }
 
+// Validate checks Error before it is sent to tonlib
+func (tonError *Error) Validate() error {
+	if tonError == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewError creates a new Error
 //
 // @param code
@@ -275,9 +890,17 @@ func (ok *Ok) MessageType() string {
 	return "ok"
 }
 
-// NewOk creates a new Ok
-//
-func NewOk() *Ok {
+// Validate checks Ok before it is sent to tonlib
+func (ok *Ok) Validate() error {
+	if ok == nil {
+		return nil
+	}
+	return nil
+}
+
+// NewOk creates a new Ok
+//
+func NewOk() *Ok {
 	okTemp := Ok{
 		tonCommon: tonCommon{Type: "ok"},
 	}
@@ -296,6 +919,14 @@ func (keyStoreTypeDirectory *KeyStoreTypeDirectory) MessageType() string {
 	return "keyStoreTypeDirectory"
 }
 
+// Validate checks KeyStoreTypeDirectory before it is sent to tonlib
+func (keyStoreTypeDirectory *KeyStoreTypeDirectory) Validate() error {
+	if keyStoreTypeDirectory == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewKeyStoreTypeDirectory creates a new KeyStoreTypeDirectory
 //
 // @param directory
@@ -318,6 +949,14 @@ func (keyStoreTypeInMemory *KeyStoreTypeInMemory) MessageType() string {
 	return "keyStoreTypeInMemory"
 }
 
+// Validate checks KeyStoreTypeInMemory before it is sent to tonlib
+func (keyStoreTypeInMemory *KeyStoreTypeInMemory) Validate() error {
+	if keyStoreTypeInMemory == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewKeyStoreTypeInMemory creates a new KeyStoreTypeInMemory
 //
 func NewKeyStoreTypeInMemory() *KeyStoreTypeInMemory {
@@ -342,6 +981,14 @@ func (config *Config) MessageType() string {
 	return "config"
 }
 
+// Validate checks Config before it is sent to tonlib
+func (config *Config) Validate() error {
+	if config == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewConfig creates a new Config
 //
 // @param blockchainName
@@ -372,6 +1019,26 @@ func (options *Options) MessageType() string {
 	return "options"
 }
 
+// Validate checks Options before it is sent to tonlib
+func (options *Options) Validate() error {
+	if options == nil {
+		return nil
+	}
+	if options.Config == nil {
+		return fmt.Errorf("options: config is required")
+	}
+	if err := validate(options.Config); err != nil {
+		return fmt.Errorf("options: config: %w", err)
+	}
+	if options.KeystoreType == nil {
+		return fmt.Errorf("options: keystore_type is required")
+	}
+	if err := validate(options.KeystoreType); err != nil {
+		return fmt.Errorf("options: keystore_type: %w", err)
+	}
+	return nil
+}
+
 // NewOptions creates a new Options
 //
 // @param config
@@ -398,6 +1065,14 @@ func (optionsConfigInfo *OptionsConfigInfo) MessageType() string {
 	return "options.configInfo"
 }
 
+// Validate checks OptionsConfigInfo before it is sent to tonlib
+func (optionsConfigInfo *OptionsConfigInfo) Validate() error {
+	if optionsConfigInfo == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewOptionsConfigInfo creates a new OptionsConfigInfo
 //
 // @param defaultRwalletInitPublicKey
@@ -423,6 +1098,20 @@ func (optionsInfo *OptionsInfo) MessageType() string {
 	return "options.info"
 }
 
+// Validate checks OptionsInfo before it is sent to tonlib
+func (optionsInfo *OptionsInfo) Validate() error {
+	if optionsInfo == nil {
+		return nil
+	}
+	if optionsInfo.ConfigInfo == nil {
+		return fmt.Errorf("options.info: config_info is required")
+	}
+	if err := validate(optionsInfo.ConfigInfo); err != nil {
+		return fmt.Errorf("options.info: config_info: %w", err)
+	}
+	return nil
+}
+
 // NewOptionsInfo creates a new OptionsInfo
 //
 // @param configInfo
@@ -438,8 +1127,8 @@ func NewOptionsInfo(configInfo *OptionsConfigInfo) *OptionsInfo {
 // InputKeyRegular
 type InputKeyRegular struct {
 	tonCommon
-	Key           *Key         `json:"key"`            //
-	LocalPassword *SecureBytes `json:"local_password"` //
+	Key           *Key        `json:"key"`            //
+	LocalPassword SecureBytes `json:"local_password"` //
 }
 
 // MessageType return the string telegram-type of InputKeyRegular
@@ -447,11 +1136,25 @@ func (inputKeyRegular *InputKeyRegular) MessageType() string {
 	return "inputKeyRegular"
 }
 
+// Validate checks InputKeyRegular before it is sent to tonlib
+func (inputKeyRegular *InputKeyRegular) Validate() error {
+	if inputKeyRegular == nil {
+		return nil
+	}
+	if inputKeyRegular.Key == nil {
+		return fmt.Errorf("inputKeyRegular: key is required")
+	}
+	if err := validate(inputKeyRegular.Key); err != nil {
+		return fmt.Errorf("inputKeyRegular: key: %w", err)
+	}
+	return nil
+}
+
 // NewInputKeyRegular creates a new InputKeyRegular
 //
 // @param key
 // @param localPassword
-func NewInputKeyRegular(key *Key, localPassword *SecureBytes) *InputKeyRegular {
+func NewInputKeyRegular(key *Key, localPassword SecureBytes) *InputKeyRegular {
 	inputKeyRegularTemp := InputKeyRegular{
 		tonCommon:     tonCommon{Type: "inputKeyRegular"},
 		Key:           key,
@@ -471,6 +1174,14 @@ func (inputKeyFake *InputKeyFake) MessageType() string {
 	return "inputKeyFake"
 }
 
+// Validate checks InputKeyFake before it is sent to tonlib
+func (inputKeyFake *InputKeyFake) Validate() error {
+	if inputKeyFake == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewInputKeyFake creates a new InputKeyFake
 //
 func NewInputKeyFake() *InputKeyFake {
@@ -492,6 +1203,14 @@ func (exportedKey *ExportedKey) MessageType() string {
 	return "exportedKey"
 }
 
+// Validate checks ExportedKey before it is sent to tonlib
+func (exportedKey *ExportedKey) Validate() error {
+	if exportedKey == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewExportedKey creates a new ExportedKey
 //
 // @param wordList
@@ -507,7 +1226,7 @@ func NewExportedKey(wordList []SecureString) *ExportedKey {
 // ExportedPemKey
 type ExportedPemKey struct {
 	tonCommon
-	Pem *SecureString `json:"pem"` //
+	Pem SecureString `json:"pem"` //
 }
 
 // MessageType return the string telegram-type of ExportedPemKey
@@ -515,10 +1234,18 @@ func (exportedPemKey *ExportedPemKey) MessageType() string {
 	return "exportedPemKey"
 }
 
+// Validate checks ExportedPemKey before it is sent to tonlib
+func (exportedPemKey *ExportedPemKey) Validate() error {
+	if exportedPemKey == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewExportedPemKey creates a new ExportedPemKey
 //
 // @param pem
-func NewExportedPemKey(pem *SecureString) *ExportedPemKey {
+func NewExportedPemKey(pem SecureString) *ExportedPemKey {
 	exportedPemKeyTemp := ExportedPemKey{
 		tonCommon: tonCommon{Type: "exportedPemKey"},
 		Pem:       pem,
@@ -530,7 +1257,7 @@ func NewExportedPemKey(pem *SecureString) *ExportedPemKey {
 // ExportedEncryptedKey
 type ExportedEncryptedKey struct {
 	tonCommon
-	Data *SecureBytes `json:"data"` //
+	Data SecureBytes `json:"data"` //
 }
 
 // MessageType return the string telegram-type of ExportedEncryptedKey
@@ -538,10 +1265,18 @@ func (exportedEncryptedKey *ExportedEncryptedKey) MessageType() string {
 	return "exportedEncryptedKey"
 }
 
+// Validate checks ExportedEncryptedKey before it is sent to tonlib
+func (exportedEncryptedKey *ExportedEncryptedKey) Validate() error {
+	if exportedEncryptedKey == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewExportedEncryptedKey creates a new ExportedEncryptedKey
 //
 // @param data
-func NewExportedEncryptedKey(data *SecureBytes) *ExportedEncryptedKey {
+func NewExportedEncryptedKey(data SecureBytes) *ExportedEncryptedKey {
 	exportedEncryptedKeyTemp := ExportedEncryptedKey{
 		tonCommon: tonCommon{Type: "exportedEncryptedKey"},
 		Data:      data,
@@ -553,7 +1288,7 @@ func NewExportedEncryptedKey(data *SecureBytes) *ExportedEncryptedKey {
 // ExportedUnencryptedKey
 type ExportedUnencryptedKey struct {
 	tonCommon
-	Data *SecureBytes `json:"data"` //
+	Data SecureBytes `json:"data"` //
 }
 
 // MessageType return the string telegram-type of ExportedUnencryptedKey
@@ -561,10 +1296,18 @@ func (exportedUnencryptedKey *ExportedUnencryptedKey) MessageType() string {
 	return "exportedUnencryptedKey"
 }
 
+// Validate checks ExportedUnencryptedKey before it is sent to tonlib
+func (exportedUnencryptedKey *ExportedUnencryptedKey) Validate() error {
+	if exportedUnencryptedKey == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewExportedUnencryptedKey creates a new ExportedUnencryptedKey
 //
 // @param data
-func NewExportedUnencryptedKey(data *SecureBytes) *ExportedUnencryptedKey {
+func NewExportedUnencryptedKey(data SecureBytes) *ExportedUnencryptedKey {
 	exportedUnencryptedKeyTemp := ExportedUnencryptedKey{
 		tonCommon: tonCommon{Type: "exportedUnencryptedKey"},
 		Data:      data,
@@ -584,6 +1327,14 @@ func (bip39Hints *Bip39Hints) MessageType() string {
 	return "bip39Hints"
 }
 
+// Validate checks Bip39Hints before it is sent to tonlib
+func (bip39Hints *Bip39Hints) Validate() error {
+	if bip39Hints == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewBip39Hints creates a new Bip39Hints
 //
 // @param words
@@ -607,6 +1358,14 @@ func (adnlAddress *AdnlAddress) MessageType() string {
 	return "adnlAddress"
 }
 
+// Validate checks AdnlAddress before it is sent to tonlib
+func (adnlAddress *AdnlAddress) Validate() error {
+	if adnlAddress == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewAdnlAddress creates a new AdnlAddress
 //
 // @param adnlAddress
@@ -630,6 +1389,17 @@ func (accountAddress *AccountAddress) MessageType() string {
 	return "accountAddress"
 }
 
+// Validate checks AccountAddress before it is sent to tonlib
+func (accountAddress *AccountAddress) Validate() error {
+	if accountAddress == nil {
+		return nil
+	}
+	if err := validateAddress(accountAddress.AccountAddress); err != nil {
+		return fmt.Errorf("accountAddress: account_address is not valid: %v", err)
+	}
+	return nil
+}
+
 // NewAccountAddress creates a new AccountAddress
 //
 // @param accountAddress
@@ -645,10 +1415,10 @@ func NewAccountAddress(accountAddress string) *AccountAddress {
 // UnpackedAccountAddress
 type UnpackedAccountAddress struct {
 	tonCommon
-	Addr        string `json:"addr"`         //
-	Bounceable  bool   `json:"bounceable"`   //
-	Testnet     bool   `json:"testnet"`      //
-	WorkchainId int32  `json:"workchain_id"` //
+	Addr        Bytes `json:"addr"`         //
+	Bounceable  bool  `json:"bounceable"`   //
+	Testnet     bool  `json:"testnet"`      //
+	WorkchainId int32 `json:"workchain_id"` //
 }
 
 // MessageType return the string telegram-type of UnpackedAccountAddress
@@ -656,13 +1426,21 @@ func (unpackedAccountAddress *UnpackedAccountAddress) MessageType() string {
 	return "unpackedAccountAddress"
 }
 
+// Validate checks UnpackedAccountAddress before it is sent to tonlib
+func (unpackedAccountAddress *UnpackedAccountAddress) Validate() error {
+	if unpackedAccountAddress == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewUnpackedAccountAddress creates a new UnpackedAccountAddress
 //
 // @param addr
 // @param bounceable
 // @param testnet
 // @param workchainId
-func NewUnpackedAccountAddress(addr string, bounceable bool, testnet bool, workchainId int32) *UnpackedAccountAddress {
+func NewUnpackedAccountAddress(addr Bytes, bounceable bool, testnet bool, workchainId int32) *UnpackedAccountAddress {
 	unpackedAccountAddressTemp := UnpackedAccountAddress{
 		tonCommon:   tonCommon{Type: "unpackedAccountAddress"},
 		Addr:        addr,
@@ -677,7 +1455,7 @@ func NewUnpackedAccountAddress(addr string, bounceable bool, testnet bool, workc
 // InternalTransactionId
 type InternalTransactionId struct {
 	tonCommon
-	Hash string    `json:"hash"` //
+	Hash Bytes     `json:"hash"` //
 	Lt   JSONInt64 `json:"lt"`   //
 }
 
@@ -686,11 +1464,19 @@ func (internalTransactionId *InternalTransactionId) MessageType() string {
 	return "internal.transactionId"
 }
 
+// Validate checks InternalTransactionId before it is sent to tonlib
+func (internalTransactionId *InternalTransactionId) Validate() error {
+	if internalTransactionId == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewInternalTransactionId creates a new InternalTransactionId
 //
 // @param hash
 // @param lt
-func NewInternalTransactionId(hash string, lt JSONInt64) *InternalTransactionId {
+func NewInternalTransactionId(hash Bytes, lt JSONInt64) *InternalTransactionId {
 	internalTransactionIdTemp := InternalTransactionId{
 		tonCommon: tonCommon{Type: "internal.transactionId"},
 		Hash:      hash,
@@ -713,6 +1499,14 @@ func (tonBlockId *TonBlockId) MessageType() string {
 	return "ton.blockId"
 }
 
+// Validate checks TonBlockId before it is sent to tonlib
+func (tonBlockId *TonBlockId) Validate() error {
+	if tonBlockId == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTonBlockId creates a new TonBlockId
 //
 // @param seqno
@@ -729,11 +1523,16 @@ func NewTonBlockId(seqno int32, shard JSONInt64, workchain int32) *TonBlockId {
 	return &tonBlockIdTemp
 }
 
+// GetInternalBlockIdEnum return the enum type of this object
+func (tonBlockId *TonBlockId) GetInternalBlockIdEnum() InternalBlockIdEnum {
+	return TonBlockIdType
+}
+
 // TonBlockIdExt
 type TonBlockIdExt struct {
 	tonCommon
-	FileHash  string    `json:"file_hash"` //
-	RootHash  string    `json:"root_hash"` //
+	FileHash  Bytes     `json:"file_hash"` //
+	RootHash  Bytes     `json:"root_hash"` //
 	Seqno     int32     `json:"seqno"`     //
 	Shard     JSONInt64 `json:"shard"`     //
 	Workchain int32     `json:"workchain"` //
@@ -744,6 +1543,14 @@ func (tonBlockIdExt *TonBlockIdExt) MessageType() string {
 	return "ton.blockIdExt"
 }
 
+// Validate checks TonBlockIdExt before it is sent to tonlib
+func (tonBlockIdExt *TonBlockIdExt) Validate() error {
+	if tonBlockIdExt == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTonBlockIdExt creates a new TonBlockIdExt
 //
 // @param fileHash
@@ -751,7 +1558,7 @@ func (tonBlockIdExt *TonBlockIdExt) MessageType() string {
 // @param seqno
 // @param shard
 // @param workchain
-func NewTonBlockIdExt(fileHash string, rootHash string, seqno int32, shard JSONInt64, workchain int32) *TonBlockIdExt {
+func NewTonBlockIdExt(fileHash Bytes, rootHash Bytes, seqno int32, shard JSONInt64, workchain int32) *TonBlockIdExt {
 	tonBlockIdExtTemp := TonBlockIdExt{
 		tonCommon: tonCommon{Type: "ton.blockIdExt"},
 		FileHash:  fileHash,
@@ -769,9 +1576,9 @@ type RawFullAccountState struct {
 	tonCommon
 	Balance           JSONInt64              `json:"balance"`             //
 	BlockId           *TonBlockIdExt         `json:"block_id"`            //
-	Code              string                 `json:"code"`                //
-	Data              string                 `json:"data"`                //
-	FrozenHash        string                 `json:"frozen_hash"`         //
+	Code              Bytes                  `json:"code"`                //
+	Data              Bytes                  `json:"data"`                //
+	FrozenHash        Bytes                  `json:"frozen_hash"`         //
 	LastTransactionId *InternalTransactionId `json:"last_transaction_id"` //
 	SyncUtime         int64                  `json:"sync_utime"`          //
 }
@@ -781,6 +1588,26 @@ func (rawFullAccountState *RawFullAccountState) MessageType() string {
 	return "raw.fullAccountState"
 }
 
+// Validate checks RawFullAccountState before it is sent to tonlib
+func (rawFullAccountState *RawFullAccountState) Validate() error {
+	if rawFullAccountState == nil {
+		return nil
+	}
+	if rawFullAccountState.BlockId == nil {
+		return fmt.Errorf("raw.fullAccountState: block_id is required")
+	}
+	if err := validate(rawFullAccountState.BlockId); err != nil {
+		return fmt.Errorf("raw.fullAccountState: block_id: %w", err)
+	}
+	if rawFullAccountState.LastTransactionId == nil {
+		return fmt.Errorf("raw.fullAccountState: last_transaction_id is required")
+	}
+	if err := validate(rawFullAccountState.LastTransactionId); err != nil {
+		return fmt.Errorf("raw.fullAccountState: last_transaction_id: %w", err)
+	}
+	return nil
+}
+
 // NewRawFullAccountState creates a new RawFullAccountState
 //
 // @param balance
@@ -790,7 +1617,7 @@ func (rawFullAccountState *RawFullAccountState) MessageType() string {
 // @param frozenHash
 // @param lastTransactionId
 // @param syncUtime
-func NewRawFullAccountState(balance JSONInt64, blockId *TonBlockIdExt, code string, data string, frozenHash string, lastTransactionId *InternalTransactionId, syncUtime int64) *RawFullAccountState {
+func NewRawFullAccountState(balance JSONInt64, blockId *TonBlockIdExt, code Bytes, data Bytes, frozenHash Bytes, lastTransactionId *InternalTransactionId, syncUtime int64) *RawFullAccountState {
 	rawFullAccountStateTemp := RawFullAccountState{
 		tonCommon:         tonCommon{Type: "raw.fullAccountState"},
 		Balance:           balance,
@@ -808,12 +1635,12 @@ func NewRawFullAccountState(balance JSONInt64, blockId *TonBlockIdExt, code stri
 // RawMessage
 type RawMessage struct {
 	tonCommon
-	BodyHash    string          `json:"body_hash"`   //
+	BodyHash    Bytes           `json:"body_hash"`   //
 	CreatedLt   JSONInt64       `json:"created_lt"`  //
 	Destination *AccountAddress `json:"destination"` //
 	FwdFee      JSONInt64       `json:"fwd_fee"`     //
 	IhrFee      JSONInt64       `json:"ihr_fee"`     //
-	MsgData     MsgData        `json:"msg_data"`    //
+	MsgData     MsgData         `json:"msg_data"`    //
 	Source      *AccountAddress `json:"source"`      //
 	Value       JSONInt64       `json:"value"`       //
 }
@@ -823,6 +1650,29 @@ func (rawMessage *RawMessage) MessageType() string {
 	return "raw.message"
 }
 
+// Validate checks RawMessage before it is sent to tonlib
+func (rawMessage *RawMessage) Validate() error {
+	if rawMessage == nil {
+		return nil
+	}
+	if rawMessage.Destination == nil {
+		return fmt.Errorf("raw.message: destination is required")
+	}
+	if err := validate(rawMessage.Destination); err != nil {
+		return fmt.Errorf("raw.message: destination: %w", err)
+	}
+	if err := validate(rawMessage.MsgData); err != nil {
+		return fmt.Errorf("raw.message: msg_data: %w", err)
+	}
+	if rawMessage.Source == nil {
+		return fmt.Errorf("raw.message: source is required")
+	}
+	if err := validate(rawMessage.Source); err != nil {
+		return fmt.Errorf("raw.message: source: %w", err)
+	}
+	return nil
+}
+
 // NewRawMessage creates a new RawMessage
 //
 // @param bodyHash
@@ -833,7 +1683,7 @@ func (rawMessage *RawMessage) MessageType() string {
 // @param msgData
 // @param source
 // @param value
-func NewRawMessage(bodyHash string, createdLt JSONInt64, destination *AccountAddress, fwdFee JSONInt64, ihrFee JSONInt64, msgData MsgData, source *AccountAddress, value JSONInt64) *RawMessage {
+func NewRawMessage(bodyHash Bytes, createdLt JSONInt64, destination *AccountAddress, fwdFee JSONInt64, ihrFee JSONInt64, msgData MsgData, source *AccountAddress, value JSONInt64) *RawMessage {
 	rawMessageTemp := RawMessage{
 		tonCommon:   tonCommon{Type: "raw.message"},
 		BodyHash:    bodyHash,
@@ -849,10 +1699,50 @@ func NewRawMessage(bodyHash string, createdLt JSONInt64, destination *AccountAdd
 	return &rawMessageTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (rawMessage *RawMessage) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		BodyHash    Bytes           `json:"body_hash"`   //
+		CreatedLt   JSONInt64       `json:"created_lt"`  //
+		Destination *AccountAddress `json:"destination"` //
+		FwdFee      JSONInt64       `json:"fwd_fee"`     //
+		IhrFee      JSONInt64       `json:"ihr_fee"`     //
+		Source      *AccountAddress `json:"source"`      //
+		Value       JSONInt64       `json:"value"`       //
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	rawMessage.tonCommon = tempObj.tonCommon
+	rawMessage.BodyHash = tempObj.BodyHash
+	rawMessage.CreatedLt = tempObj.CreatedLt
+	rawMessage.Destination = tempObj.Destination
+	rawMessage.FwdFee = tempObj.FwdFee
+	rawMessage.IhrFee = tempObj.IhrFee
+	rawMessage.Source = tempObj.Source
+	rawMessage.Value = tempObj.Value
+
+	fieldMsgData, err := unmarshalMsgData(objMap["msg_data"])
+	if err != nil {
+		return err
+	}
+	rawMessage.MsgData = fieldMsgData
+
+	return nil
+}
+
 // RawTransaction
 type RawTransaction struct {
 	tonCommon
-	Data          string                 `json:"data"`           //
+	Data          Bytes                  `json:"data"`           //
 	Fee           JSONInt64              `json:"fee"`            //
 	InMsg         *RawMessage            `json:"in_msg"`         //
 	OtherFee      JSONInt64              `json:"other_fee"`      //
@@ -867,6 +1757,31 @@ func (rawTransaction *RawTransaction) MessageType() string {
 	return "raw.transaction"
 }
 
+// Validate checks RawTransaction before it is sent to tonlib
+func (rawTransaction *RawTransaction) Validate() error {
+	if rawTransaction == nil {
+		return nil
+	}
+	if rawTransaction.InMsg == nil {
+		return fmt.Errorf("raw.transaction: in_msg is required")
+	}
+	if err := validate(rawTransaction.InMsg); err != nil {
+		return fmt.Errorf("raw.transaction: in_msg: %w", err)
+	}
+	for i := range rawTransaction.OutMsgs {
+		if err := validate(&rawTransaction.OutMsgs[i]); err != nil {
+			return fmt.Errorf("raw.transaction: out_msgs[%d]: %w", i, err)
+		}
+	}
+	if rawTransaction.TransactionId == nil {
+		return fmt.Errorf("raw.transaction: transaction_id is required")
+	}
+	if err := validate(rawTransaction.TransactionId); err != nil {
+		return fmt.Errorf("raw.transaction: transaction_id: %w", err)
+	}
+	return nil
+}
+
 // NewRawTransaction creates a new RawTransaction
 //
 // @param data
@@ -877,7 +1792,7 @@ func (rawTransaction *RawTransaction) MessageType() string {
 // @param storageFee
 // @param transactionId
 // @param utime
-func NewRawTransaction(data string, fee JSONInt64, inMsg *RawMessage, otherFee JSONInt64, outMsgs []RawMessage, storageFee JSONInt64, transactionId *InternalTransactionId, utime int64) *RawTransaction {
+func NewRawTransaction(data Bytes, fee JSONInt64, inMsg *RawMessage, otherFee JSONInt64, outMsgs []RawMessage, storageFee JSONInt64, transactionId *InternalTransactionId, utime int64) *RawTransaction {
 	rawTransactionTemp := RawTransaction{
 		tonCommon:     tonCommon{Type: "raw.transaction"},
 		Data:          data,
@@ -905,6 +1820,25 @@ func (rawTransactions *RawTransactions) MessageType() string {
 	return "raw.transactions"
 }
 
+// Validate checks RawTransactions before it is sent to tonlib
+func (rawTransactions *RawTransactions) Validate() error {
+	if rawTransactions == nil {
+		return nil
+	}
+	if rawTransactions.PreviousTransactionId == nil {
+		return fmt.Errorf("raw.transactions: previous_transaction_id is required")
+	}
+	if err := validate(rawTransactions.PreviousTransactionId); err != nil {
+		return fmt.Errorf("raw.transactions: previous_transaction_id: %w", err)
+	}
+	for i := range rawTransactions.Transactions {
+		if err := validate(&rawTransactions.Transactions[i]); err != nil {
+			return fmt.Errorf("raw.transactions: transactions[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewRawTransactions creates a new RawTransactions
 //
 // @param previousTransactionId
@@ -936,6 +1870,26 @@ func (pchanConfig *PchanConfig) MessageType() string {
 	return "pchan.config"
 }
 
+// Validate checks PchanConfig before it is sent to tonlib
+func (pchanConfig *PchanConfig) Validate() error {
+	if pchanConfig == nil {
+		return nil
+	}
+	if pchanConfig.AliceAddress == nil {
+		return fmt.Errorf("pchan.config: alice_address is required")
+	}
+	if err := validate(pchanConfig.AliceAddress); err != nil {
+		return fmt.Errorf("pchan.config: alice_address: %w", err)
+	}
+	if pchanConfig.BobAddress == nil {
+		return fmt.Errorf("pchan.config: bob_address is required")
+	}
+	if err := validate(pchanConfig.BobAddress); err != nil {
+		return fmt.Errorf("pchan.config: bob_address: %w", err)
+	}
+	return nil
+}
+
 // NewPchanConfig creates a new PchanConfig
 //
 // @param aliceAddress
@@ -963,8 +1917,8 @@ func NewPchanConfig(aliceAddress *AccountAddress, alicePublicKey string, bobAddr
 // RawInitialAccountState
 type RawInitialAccountState struct {
 	tonCommon
-	Code string `json:"code"` //
-	Data string `json:"data"` //
+	Code Bytes `json:"code"` //
+	Data Bytes `json:"data"` //
 }
 
 // MessageType return the string telegram-type of RawInitialAccountState
@@ -972,11 +1926,19 @@ func (rawInitialAccountState *RawInitialAccountState) MessageType() string {
 	return "raw.initialAccountState"
 }
 
+// Validate checks RawInitialAccountState before it is sent to tonlib
+func (rawInitialAccountState *RawInitialAccountState) Validate() error {
+	if rawInitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewRawInitialAccountState creates a new RawInitialAccountState
 //
 // @param code
 // @param data
-func NewRawInitialAccountState(code string, data string) *RawInitialAccountState {
+func NewRawInitialAccountState(code Bytes, data Bytes) *RawInitialAccountState {
 	rawInitialAccountStateTemp := RawInitialAccountState{
 		tonCommon: tonCommon{Type: "raw.initialAccountState"},
 		Code:      code,
@@ -986,6 +1948,11 @@ func NewRawInitialAccountState(code string, data string) *RawInitialAccountState
 	return &rawInitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (rawInitialAccountState *RawInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return RawInitialAccountStateType
+}
+
 // TestGiverInitialAccountState
 type TestGiverInitialAccountState struct {
 	tonCommon
@@ -996,6 +1963,14 @@ func (testGiverInitialAccountState *TestGiverInitialAccountState) MessageType()
 	return "testGiver.initialAccountState"
 }
 
+// Validate checks TestGiverInitialAccountState before it is sent to tonlib
+func (testGiverInitialAccountState *TestGiverInitialAccountState) Validate() error {
+	if testGiverInitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTestGiverInitialAccountState creates a new TestGiverInitialAccountState
 //
 func NewTestGiverInitialAccountState() *TestGiverInitialAccountState {
@@ -1006,6 +1981,11 @@ func NewTestGiverInitialAccountState() *TestGiverInitialAccountState {
 	return &testGiverInitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (testGiverInitialAccountState *TestGiverInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return TestGiverInitialAccountStateType
+}
+
 // TestWalletInitialAccountState
 type TestWalletInitialAccountState struct {
 	tonCommon
@@ -1017,6 +1997,14 @@ func (testWalletInitialAccountState *TestWalletInitialAccountState) MessageType(
 	return "testWallet.initialAccountState"
 }
 
+// Validate checks TestWalletInitialAccountState before it is sent to tonlib
+func (testWalletInitialAccountState *TestWalletInitialAccountState) Validate() error {
+	if testWalletInitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTestWalletInitialAccountState creates a new TestWalletInitialAccountState
 //
 // @param publicKey
@@ -1029,6 +2017,11 @@ func NewTestWalletInitialAccountState(publicKey string) *TestWalletInitialAccoun
 	return &testWalletInitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (testWalletInitialAccountState *TestWalletInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return TestWalletInitialAccountStateType
+}
+
 // WalletInitialAccountState
 type WalletInitialAccountState struct {
 	tonCommon
@@ -1040,6 +2033,14 @@ func (walletInitialAccountState *WalletInitialAccountState) MessageType() string
 	return "wallet.initialAccountState"
 }
 
+// Validate checks WalletInitialAccountState before it is sent to tonlib
+func (walletInitialAccountState *WalletInitialAccountState) Validate() error {
+	if walletInitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletInitialAccountState creates a new WalletInitialAccountState
 //
 // @param publicKey
@@ -1052,6 +2053,11 @@ func NewWalletInitialAccountState(publicKey string) *WalletInitialAccountState {
 	return &walletInitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (walletInitialAccountState *WalletInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return WalletInitialAccountStateType
+}
+
 // WalletV3InitialAccountState
 type WalletV3InitialAccountState struct {
 	tonCommon
@@ -1064,6 +2070,14 @@ func (walletV3InitialAccountState *WalletV3InitialAccountState) MessageType() st
 	return "wallet.v3.initialAccountState"
 }
 
+// Validate checks WalletV3InitialAccountState before it is sent to tonlib
+func (walletV3InitialAccountState *WalletV3InitialAccountState) Validate() error {
+	if walletV3InitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletV3InitialAccountState creates a new WalletV3InitialAccountState
 //
 // @param publicKey
@@ -1078,6 +2092,11 @@ func NewWalletV3InitialAccountState(publicKey string, walletId JSONInt64) *Walle
 	return &walletV3InitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (walletV3InitialAccountState *WalletV3InitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return WalletV3InitialAccountStateType
+}
+
 // WalletHighloadV1InitialAccountState
 type WalletHighloadV1InitialAccountState struct {
 	tonCommon
@@ -1090,6 +2109,14 @@ func (walletHighloadV1InitialAccountState *WalletHighloadV1InitialAccountState)
 	return "wallet.highload.v1.initialAccountState"
 }
 
+// Validate checks WalletHighloadV1InitialAccountState before it is sent to tonlib
+func (walletHighloadV1InitialAccountState *WalletHighloadV1InitialAccountState) Validate() error {
+	if walletHighloadV1InitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletHighloadV1InitialAccountState creates a new WalletHighloadV1InitialAccountState
 //
 // @param publicKey
@@ -1104,6 +2131,11 @@ func NewWalletHighloadV1InitialAccountState(publicKey string, walletId JSONInt64
 	return &walletHighloadV1InitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (walletHighloadV1InitialAccountState *WalletHighloadV1InitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return WalletHighloadV1InitialAccountStateType
+}
+
 // WalletHighloadV2InitialAccountState
 type WalletHighloadV2InitialAccountState struct {
 	tonCommon
@@ -1116,6 +2148,14 @@ func (walletHighloadV2InitialAccountState *WalletHighloadV2InitialAccountState)
 	return "wallet.highload.v2.initialAccountState"
 }
 
+// Validate checks WalletHighloadV2InitialAccountState before it is sent to tonlib
+func (walletHighloadV2InitialAccountState *WalletHighloadV2InitialAccountState) Validate() error {
+	if walletHighloadV2InitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletHighloadV2InitialAccountState creates a new WalletHighloadV2InitialAccountState
 //
 // @param publicKey
@@ -1130,6 +2170,11 @@ func NewWalletHighloadV2InitialAccountState(publicKey string, walletId JSONInt64
 	return &walletHighloadV2InitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (walletHighloadV2InitialAccountState *WalletHighloadV2InitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return WalletHighloadV2InitialAccountStateType
+}
+
 // RwalletLimit
 type RwalletLimit struct {
 	tonCommon
@@ -1142,6 +2187,14 @@ func (rwalletLimit *RwalletLimit) MessageType() string {
 	return "rwallet.limit"
 }
 
+// Validate checks RwalletLimit before it is sent to tonlib
+func (rwalletLimit *RwalletLimit) Validate() error {
+	if rwalletLimit == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewRwalletLimit creates a new RwalletLimit
 //
 // @param seconds
@@ -1168,6 +2221,19 @@ func (rwalletConfig *RwalletConfig) MessageType() string {
 	return "rwallet.config"
 }
 
+// Validate checks RwalletConfig before it is sent to tonlib
+func (rwalletConfig *RwalletConfig) Validate() error {
+	if rwalletConfig == nil {
+		return nil
+	}
+	for i := range rwalletConfig.Limits {
+		if err := validate(&rwalletConfig.Limits[i]); err != nil {
+			return fmt.Errorf("rwallet.config: limits[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewRwalletConfig creates a new RwalletConfig
 //
 // @param limits
@@ -1195,6 +2261,14 @@ func (rwalletInitialAccountState *RwalletInitialAccountState) MessageType() stri
 	return "rwallet.initialAccountState"
 }
 
+// Validate checks RwalletInitialAccountState before it is sent to tonlib
+func (rwalletInitialAccountState *RwalletInitialAccountState) Validate() error {
+	if rwalletInitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewRwalletInitialAccountState creates a new RwalletInitialAccountState
 //
 // @param initPublicKey
@@ -1211,6 +2285,11 @@ func NewRwalletInitialAccountState(initPublicKey string, publicKey string, walle
 	return &rwalletInitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (rwalletInitialAccountState *RwalletInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return RwalletInitialAccountStateType
+}
+
 // DnsInitialAccountState
 type DnsInitialAccountState struct {
 	tonCommon
@@ -1223,6 +2302,14 @@ func (dnsInitialAccountState *DnsInitialAccountState) MessageType() string {
 	return "dns.initialAccountState"
 }
 
+// Validate checks DnsInitialAccountState before it is sent to tonlib
+func (dnsInitialAccountState *DnsInitialAccountState) Validate() error {
+	if dnsInitialAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewDnsInitialAccountState creates a new DnsInitialAccountState
 //
 // @param publicKey
@@ -1237,6 +2324,11 @@ func NewDnsInitialAccountState(publicKey string, walletId JSONInt64) *DnsInitial
 	return &dnsInitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (dnsInitialAccountState *DnsInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return DnsInitialAccountStateType
+}
+
 // PchanInitialAccountState
 type PchanInitialAccountState struct {
 	tonCommon
@@ -1248,6 +2340,20 @@ func (pchanInitialAccountState *PchanInitialAccountState) MessageType() string {
 	return "pchan.initialAccountState"
 }
 
+// Validate checks PchanInitialAccountState before it is sent to tonlib
+func (pchanInitialAccountState *PchanInitialAccountState) Validate() error {
+	if pchanInitialAccountState == nil {
+		return nil
+	}
+	if pchanInitialAccountState.Config == nil {
+		return fmt.Errorf("pchan.initialAccountState: config is required")
+	}
+	if err := validate(pchanInitialAccountState.Config); err != nil {
+		return fmt.Errorf("pchan.initialAccountState: config: %w", err)
+	}
+	return nil
+}
+
 // NewPchanInitialAccountState creates a new PchanInitialAccountState
 //
 // @param config
@@ -1260,12 +2366,17 @@ func NewPchanInitialAccountState(config *PchanConfig) *PchanInitialAccountState
 	return &pchanInitialAccountStateTemp
 }
 
+// GetInitialAccountStateEnum return the enum type of this object
+func (pchanInitialAccountState *PchanInitialAccountState) GetInitialAccountStateEnum() InitialAccountStateEnum {
+	return PchanInitialAccountStateType
+}
+
 // RawAccountState
 type RawAccountState struct {
 	tonCommon
-	Code       string `json:"code"`        //
-	Data       string `json:"data"`        //
-	FrozenHash string `json:"frozen_hash"` //
+	Code       Bytes `json:"code"`        //
+	Data       Bytes `json:"data"`        //
+	FrozenHash Bytes `json:"frozen_hash"` //
 }
 
 // MessageType return the string telegram-type of RawAccountState
@@ -1273,12 +2384,20 @@ func (rawAccountState *RawAccountState) MessageType() string {
 	return "raw.accountState"
 }
 
+// Validate checks RawAccountState before it is sent to tonlib
+func (rawAccountState *RawAccountState) Validate() error {
+	if rawAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewRawAccountState creates a new RawAccountState
 //
 // @param code
 // @param data
 // @param frozenHash
-func NewRawAccountState(code string, data string, frozenHash string) *RawAccountState {
+func NewRawAccountState(code Bytes, data Bytes, frozenHash Bytes) *RawAccountState {
 	rawAccountStateTemp := RawAccountState{
 		tonCommon:  tonCommon{Type: "raw.accountState"},
 		Code:       code,
@@ -1289,6 +2408,11 @@ func NewRawAccountState(code string, data string, frozenHash string) *RawAccount
 	return &rawAccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (rawAccountState *RawAccountState) GetAccountStateEnum() AccountStateEnum {
+	return RawAccountStateType
+}
+
 // TestWalletAccountState
 type TestWalletAccountState struct {
 	tonCommon
@@ -1300,6 +2424,14 @@ func (testWalletAccountState *TestWalletAccountState) MessageType() string {
 	return "testWallet.accountState"
 }
 
+// Validate checks TestWalletAccountState before it is sent to tonlib
+func (testWalletAccountState *TestWalletAccountState) Validate() error {
+	if testWalletAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTestWalletAccountState creates a new TestWalletAccountState
 //
 // @param seqno
@@ -1312,6 +2444,11 @@ func NewTestWalletAccountState(seqno int32) *TestWalletAccountState {
 	return &testWalletAccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (testWalletAccountState *TestWalletAccountState) GetAccountStateEnum() AccountStateEnum {
+	return TestWalletAccountStateType
+}
+
 // WalletAccountState
 type WalletAccountState struct {
 	tonCommon
@@ -1323,6 +2460,14 @@ func (walletAccountState *WalletAccountState) MessageType() string {
 	return "wallet.accountState"
 }
 
+// Validate checks WalletAccountState before it is sent to tonlib
+func (walletAccountState *WalletAccountState) Validate() error {
+	if walletAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletAccountState creates a new WalletAccountState
 //
 // @param seqno
@@ -1335,6 +2480,11 @@ func NewWalletAccountState(seqno int32) *WalletAccountState {
 	return &walletAccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (walletAccountState *WalletAccountState) GetAccountStateEnum() AccountStateEnum {
+	return WalletAccountStateType
+}
+
 // WalletV3AccountState
 type WalletV3AccountState struct {
 	tonCommon
@@ -1347,6 +2497,14 @@ func (walletV3AccountState *WalletV3AccountState) MessageType() string {
 	return "wallet.v3.accountState"
 }
 
+// Validate checks WalletV3AccountState before it is sent to tonlib
+func (walletV3AccountState *WalletV3AccountState) Validate() error {
+	if walletV3AccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletV3AccountState creates a new WalletV3AccountState
 //
 // @param seqno
@@ -1361,6 +2519,11 @@ func NewWalletV3AccountState(seqno int32, walletId JSONInt64) *WalletV3AccountSt
 	return &walletV3AccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (walletV3AccountState *WalletV3AccountState) GetAccountStateEnum() AccountStateEnum {
+	return WalletV3AccountStateType
+}
+
 // WalletHighloadV1AccountState
 type WalletHighloadV1AccountState struct {
 	tonCommon
@@ -1373,6 +2536,14 @@ func (walletHighloadV1AccountState *WalletHighloadV1AccountState) MessageType()
 	return "wallet.highload.v1.accountState"
 }
 
+// Validate checks WalletHighloadV1AccountState before it is sent to tonlib
+func (walletHighloadV1AccountState *WalletHighloadV1AccountState) Validate() error {
+	if walletHighloadV1AccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletHighloadV1AccountState creates a new WalletHighloadV1AccountState
 //
 // @param seqno
@@ -1387,6 +2558,11 @@ func NewWalletHighloadV1AccountState(seqno int32, walletId JSONInt64) *WalletHig
 	return &walletHighloadV1AccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (walletHighloadV1AccountState *WalletHighloadV1AccountState) GetAccountStateEnum() AccountStateEnum {
+	return WalletHighloadV1AccountStateType
+}
+
 // WalletHighloadV2AccountState
 type WalletHighloadV2AccountState struct {
 	tonCommon
@@ -1398,6 +2574,14 @@ func (walletHighloadV2AccountState *WalletHighloadV2AccountState) MessageType()
 	return "wallet.highload.v2.accountState"
 }
 
+// Validate checks WalletHighloadV2AccountState before it is sent to tonlib
+func (walletHighloadV2AccountState *WalletHighloadV2AccountState) Validate() error {
+	if walletHighloadV2AccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewWalletHighloadV2AccountState creates a new WalletHighloadV2AccountState
 //
 // @param walletId
@@ -1410,7 +2594,12 @@ func NewWalletHighloadV2AccountState(walletId JSONInt64) *WalletHighloadV2Accoun
 	return &walletHighloadV2AccountStateTemp
 }
 
-// TestGiverAccountState
+// GetAccountStateEnum return the enum type of this object
+func (walletHighloadV2AccountState *WalletHighloadV2AccountState) GetAccountStateEnum() AccountStateEnum {
+	return WalletHighloadV2AccountStateType
+}
+
+// TestGiverAccountState
 type TestGiverAccountState struct {
 	tonCommon
 	Seqno int32 `json:"seqno"` //
@@ -1421,6 +2610,14 @@ func (testGiverAccountState *TestGiverAccountState) MessageType() string {
 	return "testGiver.accountState"
 }
 
+// Validate checks TestGiverAccountState before it is sent to tonlib
+func (testGiverAccountState *TestGiverAccountState) Validate() error {
+	if testGiverAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTestGiverAccountState creates a new TestGiverAccountState
 //
 // @param seqno
@@ -1433,6 +2630,11 @@ func NewTestGiverAccountState(seqno int32) *TestGiverAccountState {
 	return &testGiverAccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (testGiverAccountState *TestGiverAccountState) GetAccountStateEnum() AccountStateEnum {
+	return TestGiverAccountStateType
+}
+
 // DnsAccountState
 type DnsAccountState struct {
 	tonCommon
@@ -1444,6 +2646,14 @@ func (dnsAccountState *DnsAccountState) MessageType() string {
 	return "dns.accountState"
 }
 
+// Validate checks DnsAccountState before it is sent to tonlib
+func (dnsAccountState *DnsAccountState) Validate() error {
+	if dnsAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewDnsAccountState creates a new DnsAccountState
 //
 // @param walletId
@@ -1456,6 +2666,11 @@ func NewDnsAccountState(walletId JSONInt64) *DnsAccountState {
 	return &dnsAccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (dnsAccountState *DnsAccountState) GetAccountStateEnum() AccountStateEnum {
+	return DnsAccountStateType
+}
+
 // RwalletAccountState
 type RwalletAccountState struct {
 	tonCommon
@@ -1470,6 +2685,20 @@ func (rwalletAccountState *RwalletAccountState) MessageType() string {
 	return "rwallet.accountState"
 }
 
+// Validate checks RwalletAccountState before it is sent to tonlib
+func (rwalletAccountState *RwalletAccountState) Validate() error {
+	if rwalletAccountState == nil {
+		return nil
+	}
+	if rwalletAccountState.Config == nil {
+		return fmt.Errorf("rwallet.accountState: config is required")
+	}
+	if err := validate(rwalletAccountState.Config); err != nil {
+		return fmt.Errorf("rwallet.accountState: config: %w", err)
+	}
+	return nil
+}
+
 // NewRwalletAccountState creates a new RwalletAccountState
 //
 // @param config
@@ -1488,6 +2717,11 @@ func NewRwalletAccountState(config *RwalletConfig, seqno int32, unlockedBalance
 	return &rwalletAccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (rwalletAccountState *RwalletAccountState) GetAccountStateEnum() AccountStateEnum {
+	return RwalletAccountStateType
+}
+
 // PchanStateInit
 type PchanStateInit struct {
 	tonCommon
@@ -1505,6 +2739,14 @@ func (pchanStateInit *PchanStateInit) MessageType() string {
 	return "pchan.stateInit"
 }
 
+// Validate checks PchanStateInit before it is sent to tonlib
+func (pchanStateInit *PchanStateInit) Validate() error {
+	if pchanStateInit == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewPchanStateInit creates a new PchanStateInit
 //
 // @param a
@@ -1529,6 +2771,11 @@ func NewPchanStateInit(a JSONInt64, b JSONInt64, expireAt int64, minA JSONInt64,
 	return &pchanStateInitTemp
 }
 
+// GetPchanStateEnum return the enum type of this object
+func (pchanStateInit *PchanStateInit) GetPchanStateEnum() PchanStateEnum {
+	return PchanStateInitType
+}
+
 // PchanStateClose
 type PchanStateClose struct {
 	tonCommon
@@ -1546,6 +2793,14 @@ func (pchanStateClose *PchanStateClose) MessageType() string {
 	return "pchan.stateClose"
 }
 
+// Validate checks PchanStateClose before it is sent to tonlib
+func (pchanStateClose *PchanStateClose) Validate() error {
+	if pchanStateClose == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewPchanStateClose creates a new PchanStateClose
 //
 // @param a
@@ -1570,6 +2825,11 @@ func NewPchanStateClose(a JSONInt64, b JSONInt64, expireAt int64, minA JSONInt64
 	return &pchanStateCloseTemp
 }
 
+// GetPchanStateEnum return the enum type of this object
+func (pchanStateClose *PchanStateClose) GetPchanStateEnum() PchanStateEnum {
+	return PchanStateCloseType
+}
+
 // PchanStatePayout
 type PchanStatePayout struct {
 	tonCommon
@@ -1582,6 +2842,14 @@ func (pchanStatePayout *PchanStatePayout) MessageType() string {
 	return "pchan.statePayout"
 }
 
+// Validate checks PchanStatePayout before it is sent to tonlib
+func (pchanStatePayout *PchanStatePayout) Validate() error {
+	if pchanStatePayout == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewPchanStatePayout creates a new PchanStatePayout
 //
 // @param a
@@ -1596,12 +2864,17 @@ func NewPchanStatePayout(a JSONInt64, b JSONInt64) *PchanStatePayout {
 	return &pchanStatePayoutTemp
 }
 
+// GetPchanStateEnum return the enum type of this object
+func (pchanStatePayout *PchanStatePayout) GetPchanStateEnum() PchanStateEnum {
+	return PchanStatePayoutType
+}
+
 // PchanAccountState
 type PchanAccountState struct {
 	tonCommon
 	Config      *PchanConfig `json:"config"`      //
 	Description string       `json:"description"` //
-	State       PchanState  `json:"state"`       //
+	State       PchanState   `json:"state"`       //
 }
 
 // MessageType return the string telegram-type of PchanAccountState
@@ -1609,6 +2882,23 @@ func (pchanAccountState *PchanAccountState) MessageType() string {
 	return "pchan.accountState"
 }
 
+// Validate checks PchanAccountState before it is sent to tonlib
+func (pchanAccountState *PchanAccountState) Validate() error {
+	if pchanAccountState == nil {
+		return nil
+	}
+	if pchanAccountState.Config == nil {
+		return fmt.Errorf("pchan.accountState: config is required")
+	}
+	if err := validate(pchanAccountState.Config); err != nil {
+		return fmt.Errorf("pchan.accountState: config: %w", err)
+	}
+	if err := validate(pchanAccountState.State); err != nil {
+		return fmt.Errorf("pchan.accountState: state: %w", err)
+	}
+	return nil
+}
+
 // NewPchanAccountState creates a new PchanAccountState
 //
 // @param config
@@ -1625,10 +2915,46 @@ func NewPchanAccountState(config *PchanConfig, description string, state PchanSt
 	return &pchanAccountStateTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (pchanAccountState *PchanAccountState) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		Config      *PchanConfig `json:"config"`      //
+		Description string       `json:"description"` //
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	pchanAccountState.tonCommon = tempObj.tonCommon
+	pchanAccountState.Config = tempObj.Config
+	pchanAccountState.Description = tempObj.Description
+
+	fieldState, err := unmarshalPchanState(objMap["state"])
+	if err != nil {
+		return err
+	}
+	pchanAccountState.State = fieldState
+
+	return nil
+}
+
+// GetAccountStateEnum return the enum type of this object
+func (pchanAccountState *PchanAccountState) GetAccountStateEnum() AccountStateEnum {
+	return PchanAccountStateType
+}
+
 // UninitedAccountState
 type UninitedAccountState struct {
 	tonCommon
-	FrozenHash string `json:"frozen_hash"` //
+	FrozenHash Bytes `json:"frozen_hash"` //
 }
 
 // MessageType return the string telegram-type of UninitedAccountState
@@ -1636,10 +2962,18 @@ func (uninitedAccountState *UninitedAccountState) MessageType() string {
 	return "uninited.accountState"
 }
 
+// Validate checks UninitedAccountState before it is sent to tonlib
+func (uninitedAccountState *UninitedAccountState) Validate() error {
+	if uninitedAccountState == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewUninitedAccountState creates a new UninitedAccountState
 //
 // @param frozenHash
-func NewUninitedAccountState(frozenHash string) *UninitedAccountState {
+func NewUninitedAccountState(frozenHash Bytes) *UninitedAccountState {
 	uninitedAccountStateTemp := UninitedAccountState{
 		tonCommon:  tonCommon{Type: "uninited.accountState"},
 		FrozenHash: frozenHash,
@@ -1648,10 +2982,15 @@ func NewUninitedAccountState(frozenHash string) *UninitedAccountState {
 	return &uninitedAccountStateTemp
 }
 
+// GetAccountStateEnum return the enum type of this object
+func (uninitedAccountState *UninitedAccountState) GetAccountStateEnum() AccountStateEnum {
+	return UninitedAccountStateType
+}
+
 // FullAccountState
 type FullAccountState struct {
 	tonCommon
-	AccountState      *AccountState          `json:"account_state"`       //
+	AccountState      AccountState           `json:"account_state"`       //
 	Address           *AccountAddress        `json:"address"`             //
 	Balance           JSONInt64              `json:"balance"`             //
 	BlockId           *TonBlockIdExt         `json:"block_id"`            //
@@ -1665,6 +3004,35 @@ func (fullAccountState *FullAccountState) MessageType() string {
 	return "fullAccountState"
 }
 
+// Validate checks FullAccountState before it is sent to tonlib
+func (fullAccountState *FullAccountState) Validate() error {
+	if fullAccountState == nil {
+		return nil
+	}
+	if err := validate(fullAccountState.AccountState); err != nil {
+		return fmt.Errorf("fullAccountState: account_state: %w", err)
+	}
+	if fullAccountState.Address == nil {
+		return fmt.Errorf("fullAccountState: address is required")
+	}
+	if err := validate(fullAccountState.Address); err != nil {
+		return fmt.Errorf("fullAccountState: address: %w", err)
+	}
+	if fullAccountState.BlockId == nil {
+		return fmt.Errorf("fullAccountState: block_id is required")
+	}
+	if err := validate(fullAccountState.BlockId); err != nil {
+		return fmt.Errorf("fullAccountState: block_id: %w", err)
+	}
+	if fullAccountState.LastTransactionId == nil {
+		return fmt.Errorf("fullAccountState: last_transaction_id is required")
+	}
+	if err := validate(fullAccountState.LastTransactionId); err != nil {
+		return fmt.Errorf("fullAccountState: last_transaction_id: %w", err)
+	}
+	return nil
+}
+
 // NewFullAccountState creates a new FullAccountState
 //
 // @param accountState
@@ -1674,7 +3042,7 @@ func (fullAccountState *FullAccountState) MessageType() string {
 // @param lastTransactionId
 // @param revision
 // @param syncUtime
-func NewFullAccountState(accountState *AccountState, address *AccountAddress, balance JSONInt64, blockId *TonBlockIdExt, lastTransactionId *InternalTransactionId, revision int32, syncUtime int64) *FullAccountState {
+func NewFullAccountState(accountState AccountState, address *AccountAddress, balance JSONInt64, blockId *TonBlockIdExt, lastTransactionId *InternalTransactionId, revision int32, syncUtime int64) *FullAccountState {
 	fullAccountStateTemp := FullAccountState{
 		tonCommon:         tonCommon{Type: "fullAccountState"},
 		AccountState:      accountState,
@@ -1689,6 +3057,44 @@ func NewFullAccountState(accountState *AccountState, address *AccountAddress, ba
 	return &fullAccountStateTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (fullAccountState *FullAccountState) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		Address           *AccountAddress        `json:"address"`             //
+		Balance           JSONInt64              `json:"balance"`             //
+		BlockId           *TonBlockIdExt         `json:"block_id"`            //
+		LastTransactionId *InternalTransactionId `json:"last_transaction_id"` //
+		Revision          int32                  `json:"revision"`            //
+		SyncUtime         int64                  `json:"sync_utime"`          //
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	fullAccountState.tonCommon = tempObj.tonCommon
+	fullAccountState.Address = tempObj.Address
+	fullAccountState.Balance = tempObj.Balance
+	fullAccountState.BlockId = tempObj.BlockId
+	fullAccountState.LastTransactionId = tempObj.LastTransactionId
+	fullAccountState.Revision = tempObj.Revision
+	fullAccountState.SyncUtime = tempObj.SyncUtime
+
+	fieldAccountState, err := unmarshalAccountState(objMap["account_state"])
+	if err != nil {
+		return err
+	}
+	fullAccountState.AccountState = fieldAccountState
+
+	return nil
+}
+
 // AccountRevisionList
 type AccountRevisionList struct {
 	tonCommon
@@ -1700,6 +3106,19 @@ func (accountRevisionList *AccountRevisionList) MessageType() string {
 	return "accountRevisionList"
 }
 
+// Validate checks AccountRevisionList before it is sent to tonlib
+func (accountRevisionList *AccountRevisionList) Validate() error {
+	if accountRevisionList == nil {
+		return nil
+	}
+	for i := range accountRevisionList.Revisions {
+		if err := validate(&accountRevisionList.Revisions[i]); err != nil {
+			return fmt.Errorf("accountRevisionList: revisions[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewAccountRevisionList creates a new AccountRevisionList
 //
 // @param revisions
@@ -1723,6 +3142,19 @@ func (accountList *AccountList) MessageType() string {
 	return "accountList"
 }
 
+// Validate checks AccountList before it is sent to tonlib
+func (accountList *AccountList) Validate() error {
+	if accountList == nil {
+		return nil
+	}
+	for i := range accountList.Accounts {
+		if err := validate(&accountList.Accounts[i]); err != nil {
+			return fmt.Errorf("accountList: accounts[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewAccountList creates a new AccountList
 //
 // @param accounts
@@ -1745,6 +3177,14 @@ func (syncStateDone *SyncStateDone) MessageType() string {
 	return "syncStateDone"
 }
 
+// Validate checks SyncStateDone before it is sent to tonlib
+func (syncStateDone *SyncStateDone) Validate() error {
+	if syncStateDone == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewSyncStateDone creates a new SyncStateDone
 //
 func NewSyncStateDone() *SyncStateDone {
@@ -1768,6 +3208,14 @@ func (syncStateInProgress *SyncStateInProgress) MessageType() string {
 	return "syncStateInProgress"
 }
 
+// Validate checks SyncStateInProgress before it is sent to tonlib
+func (syncStateInProgress *SyncStateInProgress) Validate() error {
+	if syncStateInProgress == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewSyncStateInProgress creates a new SyncStateInProgress
 //
 // @param currentSeqno
@@ -1787,8 +3235,8 @@ func NewSyncStateInProgress(currentSeqno int32, fromSeqno int32, toSeqno int32)
 // MsgDataRaw
 type MsgDataRaw struct {
 	tonCommon
-	Body      string `json:"body"`       //
-	InitState string `json:"init_state"` //
+	Body      Bytes `json:"body"`       //
+	InitState Bytes `json:"init_state"` //
 }
 
 // MessageType return the string telegram-type of MsgDataRaw
@@ -1796,11 +3244,19 @@ func (msgDataRaw *MsgDataRaw) MessageType() string {
 	return "msg.dataRaw"
 }
 
+// Validate checks MsgDataRaw before it is sent to tonlib
+func (msgDataRaw *MsgDataRaw) Validate() error {
+	if msgDataRaw == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewMsgDataRaw creates a new MsgDataRaw
 //
 // @param body
 // @param initState
-func NewMsgDataRaw(body string, initState string) *MsgDataRaw {
+func NewMsgDataRaw(body Bytes, initState Bytes) *MsgDataRaw {
 	msgDataRawTemp := MsgDataRaw{
 		tonCommon: tonCommon{Type: "msg.dataRaw"},
 		Body:      body,
@@ -1810,10 +3266,15 @@ func NewMsgDataRaw(body string, initState string) *MsgDataRaw {
 	return &msgDataRawTemp
 }
 
+// GetMsgDataEnum return the enum type of this object
+func (msgDataRaw *MsgDataRaw) GetMsgDataEnum() MsgDataEnum {
+	return MsgDataRawType
+}
+
 // MsgDataText
 type MsgDataText struct {
 	tonCommon
-	Text string `json:"text"` //
+	Text Bytes `json:"text"` //
 }
 
 // MessageType return the string telegram-type of MsgDataText
@@ -1821,10 +3282,18 @@ func (msgDataText *MsgDataText) MessageType() string {
 	return "msg.dataText"
 }
 
+// Validate checks MsgDataText before it is sent to tonlib
+func (msgDataText *MsgDataText) Validate() error {
+	if msgDataText == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewMsgDataText creates a new MsgDataText
 //
 // @param text
-func NewMsgDataText(text string) *MsgDataText {
+func NewMsgDataText(text Bytes) *MsgDataText {
 	msgDataTextTemp := MsgDataText{
 		tonCommon: tonCommon{Type: "msg.dataText"},
 		Text:      text,
@@ -1833,10 +3302,15 @@ func NewMsgDataText(text string) *MsgDataText {
 	return &msgDataTextTemp
 }
 
+// GetMsgDataEnum return the enum type of this object
+func (msgDataText *MsgDataText) GetMsgDataEnum() MsgDataEnum {
+	return MsgDataTextType
+}
+
 // MsgDataDecryptedText
 type MsgDataDecryptedText struct {
 	tonCommon
-	Text string `json:"text"` //
+	Text Bytes `json:"text"` //
 }
 
 // MessageType return the string telegram-type of MsgDataDecryptedText
@@ -1844,10 +3318,18 @@ func (msgDataDecryptedText *MsgDataDecryptedText) MessageType() string {
 	return "msg.dataDecryptedText"
 }
 
+// Validate checks MsgDataDecryptedText before it is sent to tonlib
+func (msgDataDecryptedText *MsgDataDecryptedText) Validate() error {
+	if msgDataDecryptedText == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewMsgDataDecryptedText creates a new MsgDataDecryptedText
 //
 // @param text
-func NewMsgDataDecryptedText(text string) *MsgDataDecryptedText {
+func NewMsgDataDecryptedText(text Bytes) *MsgDataDecryptedText {
 	msgDataDecryptedTextTemp := MsgDataDecryptedText{
 		tonCommon: tonCommon{Type: "msg.dataDecryptedText"},
 		Text:      text,
@@ -1856,10 +3338,15 @@ func NewMsgDataDecryptedText(text string) *MsgDataDecryptedText {
 	return &msgDataDecryptedTextTemp
 }
 
+// GetMsgDataEnum return the enum type of this object
+func (msgDataDecryptedText *MsgDataDecryptedText) GetMsgDataEnum() MsgDataEnum {
+	return MsgDataDecryptedTextType
+}
+
 // MsgDataEncryptedText
 type MsgDataEncryptedText struct {
 	tonCommon
-	Text string `json:"text"` //
+	Text Bytes `json:"text"` //
 }
 
 // MessageType return the string telegram-type of MsgDataEncryptedText
@@ -1867,10 +3354,18 @@ func (msgDataEncryptedText *MsgDataEncryptedText) MessageType() string {
 	return "msg.dataEncryptedText"
 }
 
+// Validate checks MsgDataEncryptedText before it is sent to tonlib
+func (msgDataEncryptedText *MsgDataEncryptedText) Validate() error {
+	if msgDataEncryptedText == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewMsgDataEncryptedText creates a new MsgDataEncryptedText
 //
 // @param text
-func NewMsgDataEncryptedText(text string) *MsgDataEncryptedText {
+func NewMsgDataEncryptedText(text Bytes) *MsgDataEncryptedText {
 	msgDataEncryptedTextTemp := MsgDataEncryptedText{
 		tonCommon: tonCommon{Type: "msg.dataEncryptedText"},
 		Text:      text,
@@ -1879,10 +3374,15 @@ func NewMsgDataEncryptedText(text string) *MsgDataEncryptedText {
 	return &msgDataEncryptedTextTemp
 }
 
+// GetMsgDataEnum return the enum type of this object
+func (msgDataEncryptedText *MsgDataEncryptedText) GetMsgDataEnum() MsgDataEnum {
+	return MsgDataEncryptedTextType
+}
+
 // MsgDataEncrypted
 type MsgDataEncrypted struct {
 	tonCommon
-	Data   MsgData        `json:"data"`   //
+	Data   MsgData         `json:"data"`   //
 	Source *AccountAddress `json:"source"` //
 }
 
@@ -1891,6 +3391,23 @@ func (msgDataEncrypted *MsgDataEncrypted) MessageType() string {
 	return "msg.dataEncrypted"
 }
 
+// Validate checks MsgDataEncrypted before it is sent to tonlib
+func (msgDataEncrypted *MsgDataEncrypted) Validate() error {
+	if msgDataEncrypted == nil {
+		return nil
+	}
+	if err := validate(msgDataEncrypted.Data); err != nil {
+		return fmt.Errorf("msg.dataEncrypted: data: %w", err)
+	}
+	if msgDataEncrypted.Source == nil {
+		return fmt.Errorf("msg.dataEncrypted: source is required")
+	}
+	if err := validate(msgDataEncrypted.Source); err != nil {
+		return fmt.Errorf("msg.dataEncrypted: source: %w", err)
+	}
+	return nil
+}
+
 // NewMsgDataEncrypted creates a new MsgDataEncrypted
 //
 // @param data
@@ -1905,11 +3422,39 @@ func NewMsgDataEncrypted(data MsgData, source *AccountAddress) *MsgDataEncrypted
 	return &msgDataEncryptedTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (msgDataEncrypted *MsgDataEncrypted) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		Source *AccountAddress `json:"source"` //
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	msgDataEncrypted.tonCommon = tempObj.tonCommon
+	msgDataEncrypted.Source = tempObj.Source
+
+	fieldData, err := unmarshalMsgData(objMap["data"])
+	if err != nil {
+		return err
+	}
+	msgDataEncrypted.Data = fieldData
+
+	return nil
+}
+
 // MsgDataDecrypted
 type MsgDataDecrypted struct {
 	tonCommon
 	Data  MsgData `json:"data"`  //
-	Proof string   `json:"proof"` //
+	Proof Bytes   `json:"proof"` //
 }
 
 // MessageType return the string telegram-type of MsgDataDecrypted
@@ -1917,11 +3462,22 @@ func (msgDataDecrypted *MsgDataDecrypted) MessageType() string {
 	return "msg.dataDecrypted"
 }
 
+// Validate checks MsgDataDecrypted before it is sent to tonlib
+func (msgDataDecrypted *MsgDataDecrypted) Validate() error {
+	if msgDataDecrypted == nil {
+		return nil
+	}
+	if err := validate(msgDataDecrypted.Data); err != nil {
+		return fmt.Errorf("msg.dataDecrypted: data: %w", err)
+	}
+	return nil
+}
+
 // NewMsgDataDecrypted creates a new MsgDataDecrypted
 //
 // @param data
 // @param proof
-func NewMsgDataDecrypted(data MsgData, proof string) *MsgDataDecrypted {
+func NewMsgDataDecrypted(data MsgData, proof Bytes) *MsgDataDecrypted {
 	msgDataDecryptedTemp := MsgDataDecrypted{
 		tonCommon: tonCommon{Type: "msg.dataDecrypted"},
 		Data:      data,
@@ -1931,6 +3487,34 @@ func NewMsgDataDecrypted(data MsgData, proof string) *MsgDataDecrypted {
 	return &msgDataDecryptedTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (msgDataDecrypted *MsgDataDecrypted) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		Proof Bytes `json:"proof"` //
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	msgDataDecrypted.tonCommon = tempObj.tonCommon
+	msgDataDecrypted.Proof = tempObj.Proof
+
+	fieldData, err := unmarshalMsgData(objMap["data"])
+	if err != nil {
+		return err
+	}
+	msgDataDecrypted.Data = fieldData
+
+	return nil
+}
+
 // MsgDataEncryptedArray
 type MsgDataEncryptedArray struct {
 	tonCommon
@@ -1942,6 +3526,19 @@ func (msgDataEncryptedArray *MsgDataEncryptedArray) MessageType() string {
 	return "msg.dataEncryptedArray"
 }
 
+// Validate checks MsgDataEncryptedArray before it is sent to tonlib
+func (msgDataEncryptedArray *MsgDataEncryptedArray) Validate() error {
+	if msgDataEncryptedArray == nil {
+		return nil
+	}
+	for i := range msgDataEncryptedArray.Elements {
+		if err := validate(&msgDataEncryptedArray.Elements[i]); err != nil {
+			return fmt.Errorf("msg.dataEncryptedArray: elements[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewMsgDataEncryptedArray creates a new MsgDataEncryptedArray
 //
 // @param elements
@@ -1965,6 +3562,19 @@ func (msgDataDecryptedArray *MsgDataDecryptedArray) MessageType() string {
 	return "msg.dataDecryptedArray"
 }
 
+// Validate checks MsgDataDecryptedArray before it is sent to tonlib
+func (msgDataDecryptedArray *MsgDataDecryptedArray) Validate() error {
+	if msgDataDecryptedArray == nil {
+		return nil
+	}
+	for i := range msgDataDecryptedArray.Elements {
+		if err := validate(&msgDataDecryptedArray.Elements[i]); err != nil {
+			return fmt.Errorf("msg.dataDecryptedArray: elements[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewMsgDataDecryptedArray creates a new MsgDataDecryptedArray
 //
 // @param elements
@@ -1981,7 +3591,7 @@ func NewMsgDataDecryptedArray(elements []MsgDataDecrypted) *MsgDataDecryptedArra
 type MsgMessage struct {
 	tonCommon
 	Amount      JSONInt64       `json:"amount"`      //
-	Data        MsgData        `json:"data"`        //
+	Data        MsgData         `json:"data"`        //
 	Destination *AccountAddress `json:"destination"` //
 	PublicKey   string          `json:"public_key"`  //
 }
@@ -1991,6 +3601,26 @@ func (msgMessage *MsgMessage) MessageType() string {
 	return "msg.message"
 }
 
+// Validate checks MsgMessage before it is sent to tonlib
+func (msgMessage *MsgMessage) Validate() error {
+	if msgMessage == nil {
+		return nil
+	}
+	if msgMessage.Amount < 0 {
+		return fmt.Errorf("msg.message: amount is negative")
+	}
+	if err := validate(msgMessage.Data); err != nil {
+		return fmt.Errorf("msg.message: data: %w", err)
+	}
+	if msgMessage.Destination == nil {
+		return fmt.Errorf("msg.message: destination is required")
+	}
+	if err := validate(msgMessage.Destination); err != nil {
+		return fmt.Errorf("msg.message: destination: %w", err)
+	}
+	return nil
+}
+
 // NewMsgMessage creates a new MsgMessage
 //
 // @param amount
@@ -2009,10 +3639,42 @@ func NewMsgMessage(amount JSONInt64, data MsgData, destination *AccountAddress,
 	return &msgMessageTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (msgMessage *MsgMessage) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		Amount      JSONInt64       `json:"amount"`      //
+		Destination *AccountAddress `json:"destination"` //
+		PublicKey   string          `json:"public_key"`  //
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	msgMessage.tonCommon = tempObj.tonCommon
+	msgMessage.Amount = tempObj.Amount
+	msgMessage.Destination = tempObj.Destination
+	msgMessage.PublicKey = tempObj.PublicKey
+
+	fieldData, err := unmarshalMsgData(objMap["data"])
+	if err != nil {
+		return err
+	}
+	msgMessage.Data = fieldData
+
+	return nil
+}
+
 // DnsEntryDataUnknown
 type DnsEntryDataUnknown struct {
 	tonCommon
-	Bytes string `json:"bytes"` //
+	Bytes Bytes `json:"bytes"` //
 }
 
 // MessageType return the string telegram-type of DnsEntryDataUnknown
@@ -2020,10 +3682,18 @@ func (dnsEntryDataUnknown *DnsEntryDataUnknown) MessageType() string {
 	return "dns.entryDataUnknown"
 }
 
+// Validate checks DnsEntryDataUnknown before it is sent to tonlib
+func (dnsEntryDataUnknown *DnsEntryDataUnknown) Validate() error {
+	if dnsEntryDataUnknown == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewDnsEntryDataUnknown creates a new DnsEntryDataUnknown
 //
 // @param bytes
-func NewDnsEntryDataUnknown(bytes string) *DnsEntryDataUnknown {
+func NewDnsEntryDataUnknown(bytes Bytes) *DnsEntryDataUnknown {
 	dnsEntryDataUnknownTemp := DnsEntryDataUnknown{
 		tonCommon: tonCommon{Type: "dns.entryDataUnknown"},
 		Bytes:     bytes,
@@ -2032,6 +3702,11 @@ func NewDnsEntryDataUnknown(bytes string) *DnsEntryDataUnknown {
 	return &dnsEntryDataUnknownTemp
 }
 
+// GetDnsEntryDataEnum return the enum type of this object
+func (dnsEntryDataUnknown *DnsEntryDataUnknown) GetDnsEntryDataEnum() DnsEntryDataEnum {
+	return DnsEntryDataUnknownType
+}
+
 // DnsEntryDataText
 type DnsEntryDataText struct {
 	tonCommon
@@ -2043,6 +3718,14 @@ func (dnsEntryDataText *DnsEntryDataText) MessageType() string {
 	return "dns.entryDataText"
 }
 
+// Validate checks DnsEntryDataText before it is sent to tonlib
+func (dnsEntryDataText *DnsEntryDataText) Validate() error {
+	if dnsEntryDataText == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewDnsEntryDataText creates a new DnsEntryDataText
 //
 // @param text
@@ -2055,6 +3738,11 @@ func NewDnsEntryDataText(text string) *DnsEntryDataText {
 	return &dnsEntryDataTextTemp
 }
 
+// GetDnsEntryDataEnum return the enum type of this object
+func (dnsEntryDataText *DnsEntryDataText) GetDnsEntryDataEnum() DnsEntryDataEnum {
+	return DnsEntryDataTextType
+}
+
 // DnsEntryDataNextResolver
 type DnsEntryDataNextResolver struct {
 	tonCommon
@@ -2066,6 +3754,20 @@ func (dnsEntryDataNextResolver *DnsEntryDataNextResolver) MessageType() string {
 	return "dns.entryDataNextResolver"
 }
 
+// Validate checks DnsEntryDataNextResolver before it is sent to tonlib
+func (dnsEntryDataNextResolver *DnsEntryDataNextResolver) Validate() error {
+	if dnsEntryDataNextResolver == nil {
+		return nil
+	}
+	if dnsEntryDataNextResolver.Resolver == nil {
+		return fmt.Errorf("dns.entryDataNextResolver: resolver is required")
+	}
+	if err := validate(dnsEntryDataNextResolver.Resolver); err != nil {
+		return fmt.Errorf("dns.entryDataNextResolver: resolver: %w", err)
+	}
+	return nil
+}
+
 // NewDnsEntryDataNextResolver creates a new DnsEntryDataNextResolver
 //
 // @param resolver
@@ -2078,6 +3780,11 @@ func NewDnsEntryDataNextResolver(resolver *AccountAddress) *DnsEntryDataNextReso
 	return &dnsEntryDataNextResolverTemp
 }
 
+// GetDnsEntryDataEnum return the enum type of this object
+func (dnsEntryDataNextResolver *DnsEntryDataNextResolver) GetDnsEntryDataEnum() DnsEntryDataEnum {
+	return DnsEntryDataNextResolverType
+}
+
 // DnsEntryDataSmcAddress
 type DnsEntryDataSmcAddress struct {
 	tonCommon
@@ -2089,6 +3796,20 @@ func (dnsEntryDataSmcAddress *DnsEntryDataSmcAddress) MessageType() string {
 	return "dns.entryDataSmcAddress"
 }
 
+// Validate checks DnsEntryDataSmcAddress before it is sent to tonlib
+func (dnsEntryDataSmcAddress *DnsEntryDataSmcAddress) Validate() error {
+	if dnsEntryDataSmcAddress == nil {
+		return nil
+	}
+	if dnsEntryDataSmcAddress.SmcAddress == nil {
+		return fmt.Errorf("dns.entryDataSmcAddress: smc_address is required")
+	}
+	if err := validate(dnsEntryDataSmcAddress.SmcAddress); err != nil {
+		return fmt.Errorf("dns.entryDataSmcAddress: smc_address: %w", err)
+	}
+	return nil
+}
+
 // NewDnsEntryDataSmcAddress creates a new DnsEntryDataSmcAddress
 //
 // @param smcAddress
@@ -2101,6 +3822,11 @@ func NewDnsEntryDataSmcAddress(smcAddress *AccountAddress) *DnsEntryDataSmcAddre
 	return &dnsEntryDataSmcAddressTemp
 }
 
+// GetDnsEntryDataEnum return the enum type of this object
+func (dnsEntryDataSmcAddress *DnsEntryDataSmcAddress) GetDnsEntryDataEnum() DnsEntryDataEnum {
+	return DnsEntryDataSmcAddressType
+}
+
 // DnsEntryDataAdnlAddress
 type DnsEntryDataAdnlAddress struct {
 	tonCommon
@@ -2112,6 +3838,20 @@ func (dnsEntryDataAdnlAddress *DnsEntryDataAdnlAddress) MessageType() string {
 	return "dns.entryDataAdnlAddress"
 }
 
+// Validate checks DnsEntryDataAdnlAddress before it is sent to tonlib
+func (dnsEntryDataAdnlAddress *DnsEntryDataAdnlAddress) Validate() error {
+	if dnsEntryDataAdnlAddress == nil {
+		return nil
+	}
+	if dnsEntryDataAdnlAddress.AdnlAddress == nil {
+		return fmt.Errorf("dns.entryDataAdnlAddress: adnl_address is required")
+	}
+	if err := validate(dnsEntryDataAdnlAddress.AdnlAddress); err != nil {
+		return fmt.Errorf("dns.entryDataAdnlAddress: adnl_address: %w", err)
+	}
+	return nil
+}
+
 // NewDnsEntryDataAdnlAddress creates a new DnsEntryDataAdnlAddress
 //
 // @param adnlAddress
@@ -2124,12 +3864,17 @@ func NewDnsEntryDataAdnlAddress(adnlAddress *AdnlAddress) *DnsEntryDataAdnlAddre
 	return &dnsEntryDataAdnlAddressTemp
 }
 
+// GetDnsEntryDataEnum return the enum type of this object
+func (dnsEntryDataAdnlAddress *DnsEntryDataAdnlAddress) GetDnsEntryDataEnum() DnsEntryDataEnum {
+	return DnsEntryDataAdnlAddressType
+}
+
 // DnsEntry
 type DnsEntry struct {
 	tonCommon
-	Category int32         `json:"category"` //
-	Entry    *DnsEntryData `json:"entry"`    //
-	Name     string        `json:"name"`     //
+	Category int32        `json:"category"` //
+	Entry    DnsEntryData `json:"entry"`    //
+	Name     string       `json:"name"`     //
 }
 
 // MessageType return the string telegram-type of DnsEntry
@@ -2137,12 +3882,23 @@ func (dnsEntry *DnsEntry) MessageType() string {
 	return "dns.entry"
 }
 
-// NewDnsEntry creates a new DnsEntry
-//
+// Validate checks DnsEntry before it is sent to tonlib
+func (dnsEntry *DnsEntry) Validate() error {
+	if dnsEntry == nil {
+		return nil
+	}
+	if err := validate(dnsEntry.Entry); err != nil {
+		return fmt.Errorf("dns.entry: entry: %w", err)
+	}
+	return nil
+}
+
+// NewDnsEntry creates a new DnsEntry
+//
 // @param category
 // @param entry
 // @param name
-func NewDnsEntry(category int32, entry *DnsEntryData, name string) *DnsEntry {
+func NewDnsEntry(category int32, entry DnsEntryData, name string) *DnsEntry {
 	dnsEntryTemp := DnsEntry{
 		tonCommon: tonCommon{Type: "dns.entry"},
 		Category:  category,
@@ -2153,6 +3909,36 @@ func NewDnsEntry(category int32, entry *DnsEntryData, name string) *DnsEntry {
 	return &dnsEntryTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (dnsEntry *DnsEntry) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		Category int32  `json:"category"` //
+		Name     string `json:"name"`     //
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	dnsEntry.tonCommon = tempObj.tonCommon
+	dnsEntry.Category = tempObj.Category
+	dnsEntry.Name = tempObj.Name
+
+	fieldEntry, err := unmarshalDnsEntryData(objMap["entry"])
+	if err != nil {
+		return err
+	}
+	dnsEntry.Entry = fieldEntry
+
+	return nil
+}
+
 // DnsActionDeleteAll
 type DnsActionDeleteAll struct {
 	tonCommon
@@ -2163,6 +3949,14 @@ func (dnsActionDeleteAll *DnsActionDeleteAll) MessageType() string {
 	return "dns.actionDeleteAll"
 }
 
+// Validate checks DnsActionDeleteAll before it is sent to tonlib
+func (dnsActionDeleteAll *DnsActionDeleteAll) Validate() error {
+	if dnsActionDeleteAll == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewDnsActionDeleteAll creates a new DnsActionDeleteAll
 //
 func NewDnsActionDeleteAll() *DnsActionDeleteAll {
@@ -2173,6 +3967,11 @@ func NewDnsActionDeleteAll() *DnsActionDeleteAll {
 	return &dnsActionDeleteAllTemp
 }
 
+// GetDnsActionEnum return the enum type of this object
+func (dnsActionDeleteAll *DnsActionDeleteAll) GetDnsActionEnum() DnsActionEnum {
+	return DnsActionDeleteAllType
+}
+
 // DnsActionDelete
 type DnsActionDelete struct {
 	tonCommon
@@ -2185,6 +3984,14 @@ func (dnsActionDelete *DnsActionDelete) MessageType() string {
 	return "dns.actionDelete"
 }
 
+// Validate checks DnsActionDelete before it is sent to tonlib
+func (dnsActionDelete *DnsActionDelete) Validate() error {
+	if dnsActionDelete == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewDnsActionDelete creates a new DnsActionDelete
 //
 // @param category
@@ -2199,6 +4006,11 @@ func NewDnsActionDelete(category int32, name string) *DnsActionDelete {
 	return &dnsActionDeleteTemp
 }
 
+// GetDnsActionEnum return the enum type of this object
+func (dnsActionDelete *DnsActionDelete) GetDnsActionEnum() DnsActionEnum {
+	return DnsActionDeleteType
+}
+
 // DnsActionSet
 type DnsActionSet struct {
 	tonCommon
@@ -2210,6 +4022,20 @@ func (dnsActionSet *DnsActionSet) MessageType() string {
 	return "dns.actionSet"
 }
 
+// Validate checks DnsActionSet before it is sent to tonlib
+func (dnsActionSet *DnsActionSet) Validate() error {
+	if dnsActionSet == nil {
+		return nil
+	}
+	if dnsActionSet.Entry == nil {
+		return fmt.Errorf("dns.actionSet: entry is required")
+	}
+	if err := validate(dnsActionSet.Entry); err != nil {
+		return fmt.Errorf("dns.actionSet: entry: %w", err)
+	}
+	return nil
+}
+
 // NewDnsActionSet creates a new DnsActionSet
 //
 // @param entry
@@ -2222,6 +4048,11 @@ func NewDnsActionSet(entry *DnsEntry) *DnsActionSet {
 	return &dnsActionSetTemp
 }
 
+// GetDnsActionEnum return the enum type of this object
+func (dnsActionSet *DnsActionSet) GetDnsActionEnum() DnsActionEnum {
+	return DnsActionSetType
+}
+
 // DnsResolved
 type DnsResolved struct {
 	tonCommon
@@ -2233,6 +4064,19 @@ func (dnsResolved *DnsResolved) MessageType() string {
 	return "dns.resolved"
 }
 
+// Validate checks DnsResolved before it is sent to tonlib
+func (dnsResolved *DnsResolved) Validate() error {
+	if dnsResolved == nil {
+		return nil
+	}
+	for i := range dnsResolved.Entries {
+		if err := validate(&dnsResolved.Entries[i]); err != nil {
+			return fmt.Errorf("dns.resolved: entries[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewDnsResolved creates a new DnsResolved
 //
 // @param entries
@@ -2251,7 +4095,7 @@ type PchanPromise struct {
 	ChannelId JSONInt64 `json:"channel_id"` //
 	PromiseA  JSONInt64 `json:"promise_A"`  //
 	PromiseB  JSONInt64 `json:"promise_B"`  //
-	Signature string    `json:"signature"`  //
+	Signature Bytes     `json:"signature"`  //
 }
 
 // MessageType return the string telegram-type of PchanPromise
@@ -2259,13 +4103,21 @@ func (pchanPromise *PchanPromise) MessageType() string {
 	return "pchan.promise"
 }
 
+// Validate checks PchanPromise before it is sent to tonlib
+func (pchanPromise *PchanPromise) Validate() error {
+	if pchanPromise == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewPchanPromise creates a new PchanPromise
 //
 // @param channelId
 // @param promiseA
 // @param promiseB
 // @param signature
-func NewPchanPromise(channelId JSONInt64, promiseA JSONInt64, promiseB JSONInt64, signature string) *PchanPromise {
+func NewPchanPromise(channelId JSONInt64, promiseA JSONInt64, promiseB JSONInt64, signature Bytes) *PchanPromise {
 	pchanPromiseTemp := PchanPromise{
 		tonCommon: tonCommon{Type: "pchan.promise"},
 		ChannelId: channelId,
@@ -2291,6 +4143,14 @@ func (pchanActionInit *PchanActionInit) MessageType() string {
 	return "pchan.actionInit"
 }
 
+// Validate checks PchanActionInit before it is sent to tonlib
+func (pchanActionInit *PchanActionInit) Validate() error {
+	if pchanActionInit == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewPchanActionInit creates a new PchanActionInit
 //
 // @param incA
@@ -2309,6 +4169,11 @@ func NewPchanActionInit(incA JSONInt64, incB JSONInt64, minA JSONInt64, minB JSO
 	return &pchanActionInitTemp
 }
 
+// GetPchanActionEnum return the enum type of this object
+func (pchanActionInit *PchanActionInit) GetPchanActionEnum() PchanActionEnum {
+	return PchanActionInitType
+}
+
 // PchanActionClose
 type PchanActionClose struct {
 	tonCommon
@@ -2322,6 +4187,20 @@ func (pchanActionClose *PchanActionClose) MessageType() string {
 	return "pchan.actionClose"
 }
 
+// Validate checks PchanActionClose before it is sent to tonlib
+func (pchanActionClose *PchanActionClose) Validate() error {
+	if pchanActionClose == nil {
+		return nil
+	}
+	if pchanActionClose.Promise == nil {
+		return fmt.Errorf("pchan.actionClose: promise is required")
+	}
+	if err := validate(pchanActionClose.Promise); err != nil {
+		return fmt.Errorf("pchan.actionClose: promise: %w", err)
+	}
+	return nil
+}
+
 // NewPchanActionClose creates a new PchanActionClose
 //
 // @param extraA
@@ -2338,6 +4217,11 @@ func NewPchanActionClose(extraA JSONInt64, extraB JSONInt64, promise *PchanPromi
 	return &pchanActionCloseTemp
 }
 
+// GetPchanActionEnum return the enum type of this object
+func (pchanActionClose *PchanActionClose) GetPchanActionEnum() PchanActionEnum {
+	return PchanActionCloseType
+}
+
 // PchanActionTimeout
 type PchanActionTimeout struct {
 	tonCommon
@@ -2348,6 +4232,14 @@ func (pchanActionTimeout *PchanActionTimeout) MessageType() string {
 	return "pchan.actionTimeout"
 }
 
+// Validate checks PchanActionTimeout before it is sent to tonlib
+func (pchanActionTimeout *PchanActionTimeout) Validate() error {
+	if pchanActionTimeout == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewPchanActionTimeout creates a new PchanActionTimeout
 //
 func NewPchanActionTimeout() *PchanActionTimeout {
@@ -2358,6 +4250,11 @@ func NewPchanActionTimeout() *PchanActionTimeout {
 	return &pchanActionTimeoutTemp
 }
 
+// GetPchanActionEnum return the enum type of this object
+func (pchanActionTimeout *PchanActionTimeout) GetPchanActionEnum() PchanActionEnum {
+	return PchanActionTimeoutType
+}
+
 // RwalletActionInit
 type RwalletActionInit struct {
 	tonCommon
@@ -2369,6 +4266,20 @@ func (rwalletActionInit *RwalletActionInit) MessageType() string {
 	return "rwallet.actionInit"
 }
 
+// Validate checks RwalletActionInit before it is sent to tonlib
+func (rwalletActionInit *RwalletActionInit) Validate() error {
+	if rwalletActionInit == nil {
+		return nil
+	}
+	if rwalletActionInit.Config == nil {
+		return fmt.Errorf("rwallet.actionInit: config is required")
+	}
+	if err := validate(rwalletActionInit.Config); err != nil {
+		return fmt.Errorf("rwallet.actionInit: config: %w", err)
+	}
+	return nil
+}
+
 // NewRwalletActionInit creates a new RwalletActionInit
 //
 // @param config
@@ -2381,6 +4292,11 @@ func NewRwalletActionInit(config *RwalletConfig) *RwalletActionInit {
 	return &rwalletActionInitTemp
 }
 
+// GetRwalletActionEnum return the enum type of this object
+func (rwalletActionInit *RwalletActionInit) GetRwalletActionEnum() RwalletActionEnum {
+	return RwalletActionInitType
+}
+
 // ActionNoop
 type ActionNoop struct {
 	tonCommon
@@ -2391,6 +4307,14 @@ func (actionNoop *ActionNoop) MessageType() string {
 	return "actionNoop"
 }
 
+// Validate checks ActionNoop before it is sent to tonlib
+func (actionNoop *ActionNoop) Validate() error {
+	if actionNoop == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewActionNoop creates a new ActionNoop
 //
 func NewActionNoop() *ActionNoop {
@@ -2401,6 +4325,11 @@ func NewActionNoop() *ActionNoop {
 	return &actionNoopTemp
 }
 
+// GetActionEnum return the enum type of this object
+func (actionNoop *ActionNoop) GetActionEnum() ActionEnum {
+	return ActionNoopType
+}
+
 // ActionMsg
 type ActionMsg struct {
 	tonCommon
@@ -2413,6 +4342,19 @@ func (actionMsg *ActionMsg) MessageType() string {
 	return "actionMsg"
 }
 
+// Validate checks ActionMsg before it is sent to tonlib
+func (actionMsg *ActionMsg) Validate() error {
+	if actionMsg == nil {
+		return nil
+	}
+	for i := range actionMsg.Messages {
+		if err := validate(&actionMsg.Messages[i]); err != nil {
+			return fmt.Errorf("actionMsg: messages[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewActionMsg creates a new ActionMsg
 //
 // @param allowSendToUninited
@@ -2427,6 +4369,11 @@ func NewActionMsg(allowSendToUninited bool, messages []MsgMessage) *ActionMsg {
 	return &actionMsgTemp
 }
 
+// GetActionEnum return the enum type of this object
+func (actionMsg *ActionMsg) GetActionEnum() ActionEnum {
+	return ActionMsgType
+}
+
 // ActionDns
 type ActionDns struct {
 	tonCommon
@@ -2438,6 +4385,19 @@ func (actionDns *ActionDns) MessageType() string {
 	return "actionDns"
 }
 
+// Validate checks ActionDns before it is sent to tonlib
+func (actionDns *ActionDns) Validate() error {
+	if actionDns == nil {
+		return nil
+	}
+	for i := range actionDns.Actions {
+		if err := validate(actionDns.Actions[i]); err != nil {
+			return fmt.Errorf("actionDns: actions[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewActionDns creates a new ActionDns
 //
 // @param actions
@@ -2450,6 +4410,37 @@ func NewActionDns(actions []DnsAction) *ActionDns {
 	return &actionDnsTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (actionDns *ActionDns) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	actionDns.tonCommon = tempObj.tonCommon
+
+	fieldActions, err := unmarshalListOfDnsAction(objMap["actions"])
+	if err != nil {
+		return err
+	}
+	actionDns.Actions = fieldActions
+
+	return nil
+}
+
+// GetActionEnum return the enum type of this object
+func (actionDns *ActionDns) GetActionEnum() ActionEnum {
+	return ActionDnsType
+}
+
 // ActionPchan
 type ActionPchan struct {
 	tonCommon
@@ -2461,6 +4452,17 @@ func (actionPchan *ActionPchan) MessageType() string {
 	return "actionPchan"
 }
 
+// Validate checks ActionPchan before it is sent to tonlib
+func (actionPchan *ActionPchan) Validate() error {
+	if actionPchan == nil {
+		return nil
+	}
+	if err := validate(actionPchan.Action); err != nil {
+		return fmt.Errorf("actionPchan: action: %w", err)
+	}
+	return nil
+}
+
 // NewActionPchan creates a new ActionPchan
 //
 // @param action
@@ -2473,6 +4475,37 @@ func NewActionPchan(action PchanAction) *ActionPchan {
 	return &actionPchanTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (actionPchan *ActionPchan) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	actionPchan.tonCommon = tempObj.tonCommon
+
+	fieldAction, err := unmarshalPchanAction(objMap["action"])
+	if err != nil {
+		return err
+	}
+	actionPchan.Action = fieldAction
+
+	return nil
+}
+
+// GetActionEnum return the enum type of this object
+func (actionPchan *ActionPchan) GetActionEnum() ActionEnum {
+	return ActionPchanType
+}
+
 // ActionRwallet
 type ActionRwallet struct {
 	tonCommon
@@ -2484,6 +4517,20 @@ func (actionRwallet *ActionRwallet) MessageType() string {
 	return "actionRwallet"
 }
 
+// Validate checks ActionRwallet before it is sent to tonlib
+func (actionRwallet *ActionRwallet) Validate() error {
+	if actionRwallet == nil {
+		return nil
+	}
+	if actionRwallet.Action == nil {
+		return fmt.Errorf("actionRwallet: action is required")
+	}
+	if err := validate(actionRwallet.Action); err != nil {
+		return fmt.Errorf("actionRwallet: action: %w", err)
+	}
+	return nil
+}
+
 // NewActionRwallet creates a new ActionRwallet
 //
 // @param action
@@ -2496,6 +4543,11 @@ func NewActionRwallet(action *RwalletActionInit) *ActionRwallet {
 	return &actionRwalletTemp
 }
 
+// GetActionEnum return the enum type of this object
+func (actionRwallet *ActionRwallet) GetActionEnum() ActionEnum {
+	return ActionRwalletType
+}
+
 // Fees
 type Fees struct {
 	tonCommon
@@ -2510,6 +4562,14 @@ func (fees *Fees) MessageType() string {
 	return "fees"
 }
 
+// Validate checks Fees before it is sent to tonlib
+func (fees *Fees) Validate() error {
+	if fees == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewFees creates a new Fees
 //
 // @param fwdFee
@@ -2540,6 +4600,25 @@ func (queryFees *QueryFees) MessageType() string {
 	return "query.fees"
 }
 
+// Validate checks QueryFees before it is sent to tonlib
+func (queryFees *QueryFees) Validate() error {
+	if queryFees == nil {
+		return nil
+	}
+	for i := range queryFees.DestinationFees {
+		if err := validate(&queryFees.DestinationFees[i]); err != nil {
+			return fmt.Errorf("query.fees: destination_fees[%d]: %w", i, err)
+		}
+	}
+	if queryFees.SourceFees == nil {
+		return fmt.Errorf("query.fees: source_fees is required")
+	}
+	if err := validate(queryFees.SourceFees); err != nil {
+		return fmt.Errorf("query.fees: source_fees: %w", err)
+	}
+	return nil
+}
+
 // NewQueryFees creates a new QueryFees
 //
 // @param destinationFees
@@ -2557,11 +4636,11 @@ func NewQueryFees(destinationFees []Fees, sourceFees *Fees) *QueryFees {
 // QueryInfo
 type QueryInfo struct {
 	tonCommon
-	Body       string `json:"body"`        //
-	BodyHash   string `json:"body_hash"`   //
-	Id         int64  `json:"id"`          //
-	InitState  string `json:"init_state"`  //
-	ValidUntil int64  `json:"valid_until"` //
+	Body       Bytes `json:"body"`        //
+	BodyHash   Bytes `json:"body_hash"`   //
+	Id         int64 `json:"id"`          //
+	InitState  Bytes `json:"init_state"`  //
+	ValidUntil int64 `json:"valid_until"` //
 }
 
 // MessageType return the string telegram-type of QueryInfo
@@ -2569,6 +4648,14 @@ func (queryInfo *QueryInfo) MessageType() string {
 	return "query.info"
 }
 
+// Validate checks QueryInfo before it is sent to tonlib
+func (queryInfo *QueryInfo) Validate() error {
+	if queryInfo == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewQueryInfo creates a new QueryInfo
 //
 // @param body
@@ -2576,7 +4663,7 @@ func (queryInfo *QueryInfo) MessageType() string {
 // @param id
 // @param initState
 // @param validUntil
-func NewQueryInfo(body string, bodyHash string, id int64, initState string, validUntil int64) *QueryInfo {
+func NewQueryInfo(body Bytes, bodyHash Bytes, id int64, initState Bytes, validUntil int64) *QueryInfo {
 	queryInfoTemp := QueryInfo{
 		tonCommon:  tonCommon{Type: "query.info"},
 		Body:       body,
@@ -2592,7 +4679,7 @@ func NewQueryInfo(body string, bodyHash string, id int64, initState string, vali
 // TvmSlice
 type TvmSlice struct {
 	tonCommon
-	Bytes string `json:"bytes"` //
+	Bytes Bytes `json:"bytes"` //
 }
 
 // MessageType return the string telegram-type of TvmSlice
@@ -2600,10 +4687,18 @@ func (tvmSlice *TvmSlice) MessageType() string {
 	return "tvm.slice"
 }
 
+// Validate checks TvmSlice before it is sent to tonlib
+func (tvmSlice *TvmSlice) Validate() error {
+	if tvmSlice == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTvmSlice creates a new TvmSlice
 //
 // @param bytes
-func NewTvmSlice(bytes string) *TvmSlice {
+func NewTvmSlice(bytes Bytes) *TvmSlice {
 	tvmSliceTemp := TvmSlice{
 		tonCommon: tonCommon{Type: "tvm.slice"},
 		Bytes:     bytes,
@@ -2615,7 +4710,7 @@ func NewTvmSlice(bytes string) *TvmSlice {
 // TvmCell
 type TvmCell struct {
 	tonCommon
-	Bytes string `json:"bytes"` //
+	Bytes Bytes `json:"bytes"` //
 }
 
 // MessageType return the string telegram-type of TvmCell
@@ -2623,10 +4718,18 @@ func (tvmCell *TvmCell) MessageType() string {
 	return "tvm.cell"
 }
 
+// Validate checks TvmCell before it is sent to tonlib
+func (tvmCell *TvmCell) Validate() error {
+	if tvmCell == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTvmCell creates a new TvmCell
 //
 // @param bytes
-func NewTvmCell(bytes string) *TvmCell {
+func NewTvmCell(bytes Bytes) *TvmCell {
 	tvmCellTemp := TvmCell{
 		tonCommon: tonCommon{Type: "tvm.cell"},
 		Bytes:     bytes,
@@ -2646,6 +4749,14 @@ func (tvmNumberDecimal *TvmNumberDecimal) MessageType() string {
 	return "tvm.numberDecimal"
 }
 
+// Validate checks TvmNumberDecimal before it is sent to tonlib
+func (tvmNumberDecimal *TvmNumberDecimal) Validate() error {
+	if tvmNumberDecimal == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTvmNumberDecimal creates a new TvmNumberDecimal
 //
 // @param number
@@ -2658,6 +4769,11 @@ func NewTvmNumberDecimal(number string) *TvmNumberDecimal {
 	return &tvmNumberDecimalTemp
 }
 
+// GetTvmNumberEnum return the enum type of this object
+func (tvmNumberDecimal *TvmNumberDecimal) GetTvmNumberEnum() TvmNumberEnum {
+	return TvmNumberDecimalType
+}
+
 // TvmTuple
 type TvmTuple struct {
 	tonCommon
@@ -2669,6 +4785,19 @@ func (tvmTuple *TvmTuple) MessageType() string {
 	return "tvm.tuple"
 }
 
+// Validate checks TvmTuple before it is sent to tonlib
+func (tvmTuple *TvmTuple) Validate() error {
+	if tvmTuple == nil {
+		return nil
+	}
+	for i := range tvmTuple.Elements {
+		if err := validate(tvmTuple.Elements[i]); err != nil {
+			return fmt.Errorf("tvm.tuple: elements[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewTvmTuple creates a new TvmTuple
 //
 // @param elements
@@ -2681,6 +4810,32 @@ func NewTvmTuple(elements []TvmStackEntry) *TvmTuple {
 	return &tvmTupleTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (tvmTuple *TvmTuple) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	tvmTuple.tonCommon = tempObj.tonCommon
+
+	fieldElements, err := unmarshalListOfTvmStackEntry(objMap["elements"])
+	if err != nil {
+		return err
+	}
+	tvmTuple.Elements = fieldElements
+
+	return nil
+}
+
 // TvmList
 type TvmList struct {
 	tonCommon
@@ -2692,6 +4847,19 @@ func (tvmList *TvmList) MessageType() string {
 	return "tvm.list"
 }
 
+// Validate checks TvmList before it is sent to tonlib
+func (tvmList *TvmList) Validate() error {
+	if tvmList == nil {
+		return nil
+	}
+	for i := range tvmList.Elements {
+		if err := validate(tvmList.Elements[i]); err != nil {
+			return fmt.Errorf("tvm.list: elements[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewTvmList creates a new TvmList
 //
 // @param elements
@@ -2704,6 +4872,32 @@ func NewTvmList(elements []TvmStackEntry) *TvmList {
 	return &tvmListTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (tvmList *TvmList) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	tvmList.tonCommon = tempObj.tonCommon
+
+	fieldElements, err := unmarshalListOfTvmStackEntry(objMap["elements"])
+	if err != nil {
+		return err
+	}
+	tvmList.Elements = fieldElements
+
+	return nil
+}
+
 // TvmStackEntrySlice
 type TvmStackEntrySlice struct {
 	tonCommon
@@ -2715,6 +4909,20 @@ func (tvmStackEntrySlice *TvmStackEntrySlice) MessageType() string {
 	return "tvm.stackEntrySlice"
 }
 
+// Validate checks TvmStackEntrySlice before it is sent to tonlib
+func (tvmStackEntrySlice *TvmStackEntrySlice) Validate() error {
+	if tvmStackEntrySlice == nil {
+		return nil
+	}
+	if tvmStackEntrySlice.Slice == nil {
+		return fmt.Errorf("tvm.stackEntrySlice: slice is required")
+	}
+	if err := validate(tvmStackEntrySlice.Slice); err != nil {
+		return fmt.Errorf("tvm.stackEntrySlice: slice: %w", err)
+	}
+	return nil
+}
+
 // NewTvmStackEntrySlice creates a new TvmStackEntrySlice
 //
 // @param slice
@@ -2727,6 +4935,11 @@ func NewTvmStackEntrySlice(slice *TvmSlice) *TvmStackEntrySlice {
 	return &tvmStackEntrySliceTemp
 }
 
+// GetTvmStackEntryEnum return the enum type of this object
+func (tvmStackEntrySlice *TvmStackEntrySlice) GetTvmStackEntryEnum() TvmStackEntryEnum {
+	return TvmStackEntrySliceType
+}
+
 // TvmStackEntryCell
 type TvmStackEntryCell struct {
 	tonCommon
@@ -2738,6 +4951,20 @@ func (tvmStackEntryCell *TvmStackEntryCell) MessageType() string {
 	return "tvm.stackEntryCell"
 }
 
+// Validate checks TvmStackEntryCell before it is sent to tonlib
+func (tvmStackEntryCell *TvmStackEntryCell) Validate() error {
+	if tvmStackEntryCell == nil {
+		return nil
+	}
+	if tvmStackEntryCell.Cell == nil {
+		return fmt.Errorf("tvm.stackEntryCell: cell is required")
+	}
+	if err := validate(tvmStackEntryCell.Cell); err != nil {
+		return fmt.Errorf("tvm.stackEntryCell: cell: %w", err)
+	}
+	return nil
+}
+
 // NewTvmStackEntryCell creates a new TvmStackEntryCell
 //
 // @param cell
@@ -2750,6 +4977,11 @@ func NewTvmStackEntryCell(cell *TvmCell) *TvmStackEntryCell {
 	return &tvmStackEntryCellTemp
 }
 
+// GetTvmStackEntryEnum return the enum type of this object
+func (tvmStackEntryCell *TvmStackEntryCell) GetTvmStackEntryEnum() TvmStackEntryEnum {
+	return TvmStackEntryCellType
+}
+
 // TvmStackEntryNumber
 type TvmStackEntryNumber struct {
 	tonCommon
@@ -2761,6 +4993,17 @@ func (tvmStackEntryNumber *TvmStackEntryNumber) MessageType() string {
 	return "tvm.stackEntryNumber"
 }
 
+// Validate checks TvmStackEntryNumber before it is sent to tonlib
+func (tvmStackEntryNumber *TvmStackEntryNumber) Validate() error {
+	if tvmStackEntryNumber == nil {
+		return nil
+	}
+	if err := validate(tvmStackEntryNumber.Number); err != nil {
+		return fmt.Errorf("tvm.stackEntryNumber: number: %w", err)
+	}
+	return nil
+}
+
 // NewTvmStackEntryNumber creates a new TvmStackEntryNumber
 //
 // @param number
@@ -2773,6 +5016,37 @@ func NewTvmStackEntryNumber(number TvmNumber) *TvmStackEntryNumber {
 	return &tvmStackEntryNumberTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (tvmStackEntryNumber *TvmStackEntryNumber) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	tvmStackEntryNumber.tonCommon = tempObj.tonCommon
+
+	fieldNumber, err := unmarshalTvmNumber(objMap["number"])
+	if err != nil {
+		return err
+	}
+	tvmStackEntryNumber.Number = fieldNumber
+
+	return nil
+}
+
+// GetTvmStackEntryEnum return the enum type of this object
+func (tvmStackEntryNumber *TvmStackEntryNumber) GetTvmStackEntryEnum() TvmStackEntryEnum {
+	return TvmStackEntryNumberType
+}
+
 // TvmStackEntryTuple
 type TvmStackEntryTuple struct {
 	tonCommon
@@ -2784,11 +5058,25 @@ func (tvmStackEntryTuple *TvmStackEntryTuple) MessageType() string {
 	return "tvm.stackEntryTuple"
 }
 
-// NewTvmStackEntryTuple creates a new TvmStackEntryTuple
-//
-// @param tuple
-func NewTvmStackEntryTuple(tuple *TvmTuple) *TvmStackEntryTuple {
-	tvmStackEntryTupleTemp := TvmStackEntryTuple{
+// Validate checks TvmStackEntryTuple before it is sent to tonlib
+func (tvmStackEntryTuple *TvmStackEntryTuple) Validate() error {
+	if tvmStackEntryTuple == nil {
+		return nil
+	}
+	if tvmStackEntryTuple.Tuple == nil {
+		return fmt.Errorf("tvm.stackEntryTuple: tuple is required")
+	}
+	if err := validate(tvmStackEntryTuple.Tuple); err != nil {
+		return fmt.Errorf("tvm.stackEntryTuple: tuple: %w", err)
+	}
+	return nil
+}
+
+// NewTvmStackEntryTuple creates a new TvmStackEntryTuple
+//
+// @param tuple
+func NewTvmStackEntryTuple(tuple *TvmTuple) *TvmStackEntryTuple {
+	tvmStackEntryTupleTemp := TvmStackEntryTuple{
 		tonCommon: tonCommon{Type: "tvm.stackEntryTuple"},
 		Tuple:     tuple,
 	}
@@ -2796,6 +5084,11 @@ func NewTvmStackEntryTuple(tuple *TvmTuple) *TvmStackEntryTuple {
 	return &tvmStackEntryTupleTemp
 }
 
+// GetTvmStackEntryEnum return the enum type of this object
+func (tvmStackEntryTuple *TvmStackEntryTuple) GetTvmStackEntryEnum() TvmStackEntryEnum {
+	return TvmStackEntryTupleType
+}
+
 // TvmStackEntryList
 type TvmStackEntryList struct {
 	tonCommon
@@ -2807,6 +5100,20 @@ func (tvmStackEntryList *TvmStackEntryList) MessageType() string {
 	return "tvm.stackEntryList"
 }
 
+// Validate checks TvmStackEntryList before it is sent to tonlib
+func (tvmStackEntryList *TvmStackEntryList) Validate() error {
+	if tvmStackEntryList == nil {
+		return nil
+	}
+	if tvmStackEntryList.List == nil {
+		return fmt.Errorf("tvm.stackEntryList: list is required")
+	}
+	if err := validate(tvmStackEntryList.List); err != nil {
+		return fmt.Errorf("tvm.stackEntryList: list: %w", err)
+	}
+	return nil
+}
+
 // NewTvmStackEntryList creates a new TvmStackEntryList
 //
 // @param list
@@ -2819,6 +5126,11 @@ func NewTvmStackEntryList(list *TvmList) *TvmStackEntryList {
 	return &tvmStackEntryListTemp
 }
 
+// GetTvmStackEntryEnum return the enum type of this object
+func (tvmStackEntryList *TvmStackEntryList) GetTvmStackEntryEnum() TvmStackEntryEnum {
+	return TvmStackEntryListType
+}
+
 // TvmStackEntryUnsupported
 type TvmStackEntryUnsupported struct {
 	tonCommon
@@ -2829,6 +5141,14 @@ func (tvmStackEntryUnsupported *TvmStackEntryUnsupported) MessageType() string {
 	return "tvm.stackEntryUnsupported"
 }
 
+// Validate checks TvmStackEntryUnsupported before it is sent to tonlib
+func (tvmStackEntryUnsupported *TvmStackEntryUnsupported) Validate() error {
+	if tvmStackEntryUnsupported == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewTvmStackEntryUnsupported creates a new TvmStackEntryUnsupported
 //
 func NewTvmStackEntryUnsupported() *TvmStackEntryUnsupported {
@@ -2839,6 +5159,11 @@ func NewTvmStackEntryUnsupported() *TvmStackEntryUnsupported {
 	return &tvmStackEntryUnsupportedTemp
 }
 
+// GetTvmStackEntryEnum return the enum type of this object
+func (tvmStackEntryUnsupported *TvmStackEntryUnsupported) GetTvmStackEntryEnum() TvmStackEntryEnum {
+	return TvmStackEntryUnsupportedType
+}
+
 // SmcInfo
 type SmcInfo struct {
 	tonCommon
@@ -2850,6 +5175,14 @@ func (smcInfo *SmcInfo) MessageType() string {
 	return "smc.info"
 }
 
+// Validate checks SmcInfo before it is sent to tonlib
+func (smcInfo *SmcInfo) Validate() error {
+	if smcInfo == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewSmcInfo creates a new SmcInfo
 //
 // @param id
@@ -2873,6 +5206,14 @@ func (smcMethodIdNumber *SmcMethodIdNumber) MessageType() string {
 	return "smc.methodIdNumber"
 }
 
+// Validate checks SmcMethodIdNumber before it is sent to tonlib
+func (smcMethodIdNumber *SmcMethodIdNumber) Validate() error {
+	if smcMethodIdNumber == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewSmcMethodIdNumber creates a new SmcMethodIdNumber
 //
 // @param number
@@ -2885,6 +5226,11 @@ func NewSmcMethodIdNumber(number int32) *SmcMethodIdNumber {
 	return &smcMethodIdNumberTemp
 }
 
+// GetSmcMethodIdEnum return the enum type of this object
+func (smcMethodIdNumber *SmcMethodIdNumber) GetSmcMethodIdEnum() SmcMethodIdEnum {
+	return SmcMethodIdNumberType
+}
+
 // SmcMethodIdName
 type SmcMethodIdName struct {
 	tonCommon
@@ -2896,6 +5242,14 @@ func (smcMethodIdName *SmcMethodIdName) MessageType() string {
 	return "smc.methodIdName"
 }
 
+// Validate checks SmcMethodIdName before it is sent to tonlib
+func (smcMethodIdName *SmcMethodIdName) Validate() error {
+	if smcMethodIdName == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewSmcMethodIdName creates a new SmcMethodIdName
 //
 // @param name
@@ -2908,6 +5262,11 @@ func NewSmcMethodIdName(name string) *SmcMethodIdName {
 	return &smcMethodIdNameTemp
 }
 
+// GetSmcMethodIdEnum return the enum type of this object
+func (smcMethodIdName *SmcMethodIdName) GetSmcMethodIdEnum() SmcMethodIdEnum {
+	return SmcMethodIdNameType
+}
+
 // SmcRunResult
 type SmcRunResult struct {
 	tonCommon
@@ -2921,6 +5280,19 @@ func (smcRunResult *SmcRunResult) MessageType() string {
 	return "smc.runResult"
 }
 
+// Validate checks SmcRunResult before it is sent to tonlib
+func (smcRunResult *SmcRunResult) Validate() error {
+	if smcRunResult == nil {
+		return nil
+	}
+	for i := range smcRunResult.Stack {
+		if err := validate(smcRunResult.Stack[i]); err != nil {
+			return fmt.Errorf("smc.runResult: stack[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // NewSmcRunResult creates a new SmcRunResult
 //
 // @param exitCode
@@ -2937,10 +5309,41 @@ func NewSmcRunResult(exitCode int32, gasUsed int64, stack []TvmStackEntry) *SmcR
 	return &smcRunResultTemp
 }
 
+// UnmarshalJSON unmarshal to json
+func (smcRunResult *SmcRunResult) UnmarshalJSON(b []byte) error {
+	var objMap map[string]*json.RawMessage
+	err := json.Unmarshal(b, &objMap)
+	if err != nil {
+		return err
+	}
+	tempObj := struct {
+		tonCommon
+		ExitCode int32 `json:"exit_code"` //
+		GasUsed  int64 `json:"gas_used"`  //
+
+	}{}
+	err = json.Unmarshal(b, &tempObj)
+	if err != nil {
+		return err
+	}
+
+	smcRunResult.tonCommon = tempObj.tonCommon
+	smcRunResult.ExitCode = tempObj.ExitCode
+	smcRunResult.GasUsed = tempObj.GasUsed
+
+	fieldStack, err := unmarshalListOfTvmStackEntry(objMap["stack"])
+	if err != nil {
+		return err
+	}
+	smcRunResult.Stack = fieldStack
+
+	return nil
+}
+
 // UpdateSendLiteServerQuery
 type UpdateSendLiteServerQuery struct {
 	tonCommon
-	Data string    `json:"data"` //
+	Data Bytes     `json:"data"` //
 	Id   JSONInt64 `json:"id"`   //
 }
 
@@ -2949,11 +5352,19 @@ func (updateSendLiteServerQuery *UpdateSendLiteServerQuery) MessageType() string
 	return "updateSendLiteServerQuery"
 }
 
+// Validate checks UpdateSendLiteServerQuery before it is sent to tonlib
+func (updateSendLiteServerQuery *UpdateSendLiteServerQuery) Validate() error {
+	if updateSendLiteServerQuery == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewUpdateSendLiteServerQuery creates a new UpdateSendLiteServerQuery
 //
 // @param data
 // @param id
-func NewUpdateSendLiteServerQuery(data string, id JSONInt64) *UpdateSendLiteServerQuery {
+func NewUpdateSendLiteServerQuery(data Bytes, id JSONInt64) *UpdateSendLiteServerQuery {
 	updateSendLiteServerQueryTemp := UpdateSendLiteServerQuery{
 		tonCommon: tonCommon{Type: "updateSendLiteServerQuery"},
 		Data:      data,
@@ -2963,6 +5374,11 @@ func NewUpdateSendLiteServerQuery(data string, id JSONInt64) *UpdateSendLiteServ
 	return &updateSendLiteServerQueryTemp
 }
 
+// GetUpdateEnum return the enum type of this object
+func (updateSendLiteServerQuery *UpdateSendLiteServerQuery) GetUpdateEnum() UpdateEnum {
+	return UpdateSendLiteServerQueryType
+}
+
 // UpdateSyncState
 type UpdateSyncState struct {
 	tonCommon
@@ -2974,6 +5390,20 @@ func (updateSyncState *UpdateSyncState) MessageType() string {
 	return "updateSyncState"
 }
 
+// Validate checks UpdateSyncState before it is sent to tonlib
+func (updateSyncState *UpdateSyncState) Validate() error {
+	if updateSyncState == nil {
+		return nil
+	}
+	if updateSyncState.SyncState == nil {
+		return fmt.Errorf("updateSyncState: sync_state is required")
+	}
+	if err := validate(updateSyncState.SyncState); err != nil {
+		return fmt.Errorf("updateSyncState: sync_state: %w", err)
+	}
+	return nil
+}
+
 // NewUpdateSyncState creates a new UpdateSyncState
 //
 // @param syncState
@@ -2986,6 +5416,11 @@ func NewUpdateSyncState(syncState *SyncState) *UpdateSyncState {
 	return &updateSyncStateTemp
 }
 
+// GetUpdateEnum return the enum type of this object
+func (updateSyncState *UpdateSyncState) GetUpdateEnum() UpdateEnum {
+	return UpdateSyncStateType
+}
+
 // LogStreamDefault The log is written to stderr or an OS specific log
 type LogStreamDefault struct {
 	tonCommon
@@ -2996,6 +5431,14 @@ func (logStreamDefault *LogStreamDefault) MessageType() string {
 	return "logStreamDefault"
 }
 
+// Validate checks LogStreamDefault before it is sent to tonlib
+func (logStreamDefault *LogStreamDefault) Validate() error {
+	if logStreamDefault == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewLogStreamDefault creates a new LogStreamDefault
 //
 func NewLogStreamDefault() *LogStreamDefault {
@@ -3023,6 +5466,14 @@ func (logStreamFile *LogStreamFile) MessageType() string {
 	return "logStreamFile"
 }
 
+// Validate checks LogStreamFile before it is sent to tonlib
+func (logStreamFile *LogStreamFile) Validate() error {
+	if logStreamFile == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewLogStreamFile creates a new LogStreamFile
 //
 // @param maxFileSize Maximum size of the file to where the internal tonlib log is written before the file will be auto-rotated
@@ -3052,6 +5503,14 @@ func (logStreamEmpty *LogStreamEmpty) MessageType() string {
 	return "logStreamEmpty"
 }
 
+// Validate checks LogStreamEmpty before it is sent to tonlib
+func (logStreamEmpty *LogStreamEmpty) Validate() error {
+	if logStreamEmpty == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewLogStreamEmpty creates a new LogStreamEmpty
 //
 func NewLogStreamEmpty() *LogStreamEmpty {
@@ -3078,6 +5537,14 @@ func (logVerbosityLevel *LogVerbosityLevel) MessageType() string {
 	return "logVerbosityLevel"
 }
 
+// Validate checks LogVerbosityLevel before it is sent to tonlib
+func (logVerbosityLevel *LogVerbosityLevel) Validate() error {
+	if logVerbosityLevel == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewLogVerbosityLevel creates a new LogVerbosityLevel
 //
 // @param verbosityLevel Log verbosity level
@@ -3101,6 +5568,14 @@ func (logTags *LogTags) MessageType() string {
 	return "logTags"
 }
 
+// Validate checks LogTags before it is sent to tonlib
+func (logTags *LogTags) Validate() error {
+	if logTags == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewLogTags creates a new LogTags
 //
 // @param tags List of log tags
@@ -3116,7 +5591,7 @@ func NewLogTags(tags []string) *LogTags {
 // Data
 type Data struct {
 	tonCommon
-	Bytes *SecureBytes `json:"bytes"` //
+	Bytes SecureBytes `json:"bytes"` //
 }
 
 // MessageType return the string telegram-type of Data
@@ -3124,10 +5599,18 @@ func (data *Data) MessageType() string {
 	return "data"
 }
 
+// Validate checks Data before it is sent to tonlib
+func (data *Data) Validate() error {
+	if data == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewData creates a new Data
 //
 // @param bytes
-func NewData(bytes *SecureBytes) *Data {
+func NewData(bytes SecureBytes) *Data {
 	dataTemp := Data{
 		tonCommon: tonCommon{Type: "data"},
 		Bytes:     bytes,
@@ -3149,6 +5632,14 @@ func (liteServerInfo *LiteServerInfo) MessageType() string {
 	return "liteServer.info"
 }
 
+// Validate checks LiteServerInfo before it is sent to tonlib
+func (liteServerInfo *LiteServerInfo) Validate() error {
+	if liteServerInfo == nil {
+		return nil
+	}
+	return nil
+}
+
 // NewLiteServerInfo creates a new LiteServerInfo
 //
 // @param capabilities
@@ -3175,8 +5666,12 @@ func unmarshalLogStream(rawMsg *json.RawMessage) (LogStream, error) {
 	if err != nil {
 		return nil, err
 	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
 
-	switch LogStreamEnum(objMap["@type"].(string)) {
+	switch LogStreamEnum(typeName) {
 	case LogStreamDefaultType:
 		var logStreamDefault LogStreamDefault
 		err := json.Unmarshal(*rawMsg, &logStreamDefault)
@@ -3193,6 +5688,627 @@ func unmarshalLogStream(rawMsg *json.RawMessage) (LogStream, error) {
 		return &logStreamEmpty, err
 
 	default:
-		return nil, fmt.Errorf("Error unmarshaling, unknown type:" + objMap["@type"].(string))
+		return &UnknownLogStream{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalInternalBlockId(rawMsg *json.RawMessage) (InternalBlockId, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch InternalBlockIdEnum(typeName) {
+	case TonBlockIdType:
+		var tonBlockId TonBlockId
+		err := json.Unmarshal(*rawMsg, &tonBlockId)
+		return &tonBlockId, err
+
+	default:
+		return &UnknownInternalBlockId{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalInitialAccountState(rawMsg *json.RawMessage) (InitialAccountState, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch InitialAccountStateEnum(typeName) {
+	case RawInitialAccountStateType:
+		var rawInitialAccountState RawInitialAccountState
+		err := json.Unmarshal(*rawMsg, &rawInitialAccountState)
+		return &rawInitialAccountState, err
+
+	case TestGiverInitialAccountStateType:
+		var testGiverInitialAccountState TestGiverInitialAccountState
+		err := json.Unmarshal(*rawMsg, &testGiverInitialAccountState)
+		return &testGiverInitialAccountState, err
+
+	case TestWalletInitialAccountStateType:
+		var testWalletInitialAccountState TestWalletInitialAccountState
+		err := json.Unmarshal(*rawMsg, &testWalletInitialAccountState)
+		return &testWalletInitialAccountState, err
+
+	case WalletInitialAccountStateType:
+		var walletInitialAccountState WalletInitialAccountState
+		err := json.Unmarshal(*rawMsg, &walletInitialAccountState)
+		return &walletInitialAccountState, err
+
+	case WalletV3InitialAccountStateType:
+		var walletV3InitialAccountState WalletV3InitialAccountState
+		err := json.Unmarshal(*rawMsg, &walletV3InitialAccountState)
+		return &walletV3InitialAccountState, err
+
+	case WalletHighloadV1InitialAccountStateType:
+		var walletHighloadV1InitialAccountState WalletHighloadV1InitialAccountState
+		err := json.Unmarshal(*rawMsg, &walletHighloadV1InitialAccountState)
+		return &walletHighloadV1InitialAccountState, err
+
+	case WalletHighloadV2InitialAccountStateType:
+		var walletHighloadV2InitialAccountState WalletHighloadV2InitialAccountState
+		err := json.Unmarshal(*rawMsg, &walletHighloadV2InitialAccountState)
+		return &walletHighloadV2InitialAccountState, err
+
+	case RwalletInitialAccountStateType:
+		var rwalletInitialAccountState RwalletInitialAccountState
+		err := json.Unmarshal(*rawMsg, &rwalletInitialAccountState)
+		return &rwalletInitialAccountState, err
+
+	case DnsInitialAccountStateType:
+		var dnsInitialAccountState DnsInitialAccountState
+		err := json.Unmarshal(*rawMsg, &dnsInitialAccountState)
+		return &dnsInitialAccountState, err
+
+	case PchanInitialAccountStateType:
+		var pchanInitialAccountState PchanInitialAccountState
+		err := json.Unmarshal(*rawMsg, &pchanInitialAccountState)
+		return &pchanInitialAccountState, err
+
+	default:
+		return &UnknownInitialAccountState{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalAccountState(rawMsg *json.RawMessage) (AccountState, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch AccountStateEnum(typeName) {
+	case RawAccountStateType:
+		var rawAccountState RawAccountState
+		err := json.Unmarshal(*rawMsg, &rawAccountState)
+		return &rawAccountState, err
+
+	case TestWalletAccountStateType:
+		var testWalletAccountState TestWalletAccountState
+		err := json.Unmarshal(*rawMsg, &testWalletAccountState)
+		return &testWalletAccountState, err
+
+	case WalletAccountStateType:
+		var walletAccountState WalletAccountState
+		err := json.Unmarshal(*rawMsg, &walletAccountState)
+		return &walletAccountState, err
+
+	case WalletV3AccountStateType:
+		var walletV3AccountState WalletV3AccountState
+		err := json.Unmarshal(*rawMsg, &walletV3AccountState)
+		return &walletV3AccountState, err
+
+	case WalletHighloadV1AccountStateType:
+		var walletHighloadV1AccountState WalletHighloadV1AccountState
+		err := json.Unmarshal(*rawMsg, &walletHighloadV1AccountState)
+		return &walletHighloadV1AccountState, err
+
+	case WalletHighloadV2AccountStateType:
+		var walletHighloadV2AccountState WalletHighloadV2AccountState
+		err := json.Unmarshal(*rawMsg, &walletHighloadV2AccountState)
+		return &walletHighloadV2AccountState, err
+
+	case TestGiverAccountStateType:
+		var testGiverAccountState TestGiverAccountState
+		err := json.Unmarshal(*rawMsg, &testGiverAccountState)
+		return &testGiverAccountState, err
+
+	case DnsAccountStateType:
+		var dnsAccountState DnsAccountState
+		err := json.Unmarshal(*rawMsg, &dnsAccountState)
+		return &dnsAccountState, err
+
+	case RwalletAccountStateType:
+		var rwalletAccountState RwalletAccountState
+		err := json.Unmarshal(*rawMsg, &rwalletAccountState)
+		return &rwalletAccountState, err
+
+	case PchanAccountStateType:
+		var pchanAccountState PchanAccountState
+		err := json.Unmarshal(*rawMsg, &pchanAccountState)
+		return &pchanAccountState, err
+
+	case UninitedAccountStateType:
+		var uninitedAccountState UninitedAccountState
+		err := json.Unmarshal(*rawMsg, &uninitedAccountState)
+		return &uninitedAccountState, err
+
+	default:
+		return &UnknownAccountState{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalPchanState(rawMsg *json.RawMessage) (PchanState, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch PchanStateEnum(typeName) {
+	case PchanStateInitType:
+		var pchanStateInit PchanStateInit
+		err := json.Unmarshal(*rawMsg, &pchanStateInit)
+		return &pchanStateInit, err
+
+	case PchanStateCloseType:
+		var pchanStateClose PchanStateClose
+		err := json.Unmarshal(*rawMsg, &pchanStateClose)
+		return &pchanStateClose, err
+
+	case PchanStatePayoutType:
+		var pchanStatePayout PchanStatePayout
+		err := json.Unmarshal(*rawMsg, &pchanStatePayout)
+		return &pchanStatePayout, err
+
+	default:
+		return &UnknownPchanState{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalMsgData(rawMsg *json.RawMessage) (MsgData, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch MsgDataEnum(typeName) {
+	case MsgDataRawType:
+		var msgDataRaw MsgDataRaw
+		err := json.Unmarshal(*rawMsg, &msgDataRaw)
+		return &msgDataRaw, err
+
+	case MsgDataTextType:
+		var msgDataText MsgDataText
+		err := json.Unmarshal(*rawMsg, &msgDataText)
+		return &msgDataText, err
+
+	case MsgDataDecryptedTextType:
+		var msgDataDecryptedText MsgDataDecryptedText
+		err := json.Unmarshal(*rawMsg, &msgDataDecryptedText)
+		return &msgDataDecryptedText, err
+
+	case MsgDataEncryptedTextType:
+		var msgDataEncryptedText MsgDataEncryptedText
+		err := json.Unmarshal(*rawMsg, &msgDataEncryptedText)
+		return &msgDataEncryptedText, err
+
+	default:
+		return &UnknownMsgData{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalDnsEntryData(rawMsg *json.RawMessage) (DnsEntryData, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch DnsEntryDataEnum(typeName) {
+	case DnsEntryDataUnknownType:
+		var dnsEntryDataUnknown DnsEntryDataUnknown
+		err := json.Unmarshal(*rawMsg, &dnsEntryDataUnknown)
+		return &dnsEntryDataUnknown, err
+
+	case DnsEntryDataTextType:
+		var dnsEntryDataText DnsEntryDataText
+		err := json.Unmarshal(*rawMsg, &dnsEntryDataText)
+		return &dnsEntryDataText, err
+
+	case DnsEntryDataNextResolverType:
+		var dnsEntryDataNextResolver DnsEntryDataNextResolver
+		err := json.Unmarshal(*rawMsg, &dnsEntryDataNextResolver)
+		return &dnsEntryDataNextResolver, err
+
+	case DnsEntryDataSmcAddressType:
+		var dnsEntryDataSmcAddress DnsEntryDataSmcAddress
+		err := json.Unmarshal(*rawMsg, &dnsEntryDataSmcAddress)
+		return &dnsEntryDataSmcAddress, err
+
+	case DnsEntryDataAdnlAddressType:
+		var dnsEntryDataAdnlAddress DnsEntryDataAdnlAddress
+		err := json.Unmarshal(*rawMsg, &dnsEntryDataAdnlAddress)
+		return &dnsEntryDataAdnlAddress, err
+
+	default:
+		return &UnknownDnsEntryData{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalDnsAction(rawMsg *json.RawMessage) (DnsAction, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch DnsActionEnum(typeName) {
+	case DnsActionDeleteAllType:
+		var dnsActionDeleteAll DnsActionDeleteAll
+		err := json.Unmarshal(*rawMsg, &dnsActionDeleteAll)
+		return &dnsActionDeleteAll, err
+
+	case DnsActionDeleteType:
+		var dnsActionDelete DnsActionDelete
+		err := json.Unmarshal(*rawMsg, &dnsActionDelete)
+		return &dnsActionDelete, err
+
+	case DnsActionSetType:
+		var dnsActionSet DnsActionSet
+		err := json.Unmarshal(*rawMsg, &dnsActionSet)
+		return &dnsActionSet, err
+
+	default:
+		return &UnknownDnsAction{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalPchanAction(rawMsg *json.RawMessage) (PchanAction, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch PchanActionEnum(typeName) {
+	case PchanActionInitType:
+		var pchanActionInit PchanActionInit
+		err := json.Unmarshal(*rawMsg, &pchanActionInit)
+		return &pchanActionInit, err
+
+	case PchanActionCloseType:
+		var pchanActionClose PchanActionClose
+		err := json.Unmarshal(*rawMsg, &pchanActionClose)
+		return &pchanActionClose, err
+
+	case PchanActionTimeoutType:
+		var pchanActionTimeout PchanActionTimeout
+		err := json.Unmarshal(*rawMsg, &pchanActionTimeout)
+		return &pchanActionTimeout, err
+
+	default:
+		return &UnknownPchanAction{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalRwalletAction(rawMsg *json.RawMessage) (RwalletAction, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch RwalletActionEnum(typeName) {
+	case RwalletActionInitType:
+		var rwalletActionInit RwalletActionInit
+		err := json.Unmarshal(*rawMsg, &rwalletActionInit)
+		return &rwalletActionInit, err
+
+	default:
+		return &UnknownRwalletAction{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalAction(rawMsg *json.RawMessage) (Action, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch ActionEnum(typeName) {
+	case ActionNoopType:
+		var actionNoop ActionNoop
+		err := json.Unmarshal(*rawMsg, &actionNoop)
+		return &actionNoop, err
+
+	case ActionMsgType:
+		var actionMsg ActionMsg
+		err := json.Unmarshal(*rawMsg, &actionMsg)
+		return &actionMsg, err
+
+	case ActionDnsType:
+		var actionDns ActionDns
+		err := json.Unmarshal(*rawMsg, &actionDns)
+		return &actionDns, err
+
+	case ActionPchanType:
+		var actionPchan ActionPchan
+		err := json.Unmarshal(*rawMsg, &actionPchan)
+		return &actionPchan, err
+
+	case ActionRwalletType:
+		var actionRwallet ActionRwallet
+		err := json.Unmarshal(*rawMsg, &actionRwallet)
+		return &actionRwallet, err
+
+	default:
+		return &UnknownAction{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalTvmNumber(rawMsg *json.RawMessage) (TvmNumber, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch TvmNumberEnum(typeName) {
+	case TvmNumberDecimalType:
+		var tvmNumberDecimal TvmNumberDecimal
+		err := json.Unmarshal(*rawMsg, &tvmNumberDecimal)
+		return &tvmNumberDecimal, err
+
+	default:
+		return &UnknownTvmNumber{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalTvmStackEntry(rawMsg *json.RawMessage) (TvmStackEntry, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch TvmStackEntryEnum(typeName) {
+	case TvmStackEntrySliceType:
+		var tvmStackEntrySlice TvmStackEntrySlice
+		err := json.Unmarshal(*rawMsg, &tvmStackEntrySlice)
+		return &tvmStackEntrySlice, err
+
+	case TvmStackEntryCellType:
+		var tvmStackEntryCell TvmStackEntryCell
+		err := json.Unmarshal(*rawMsg, &tvmStackEntryCell)
+		return &tvmStackEntryCell, err
+
+	case TvmStackEntryNumberType:
+		var tvmStackEntryNumber TvmStackEntryNumber
+		err := json.Unmarshal(*rawMsg, &tvmStackEntryNumber)
+		return &tvmStackEntryNumber, err
+
+	case TvmStackEntryTupleType:
+		var tvmStackEntryTuple TvmStackEntryTuple
+		err := json.Unmarshal(*rawMsg, &tvmStackEntryTuple)
+		return &tvmStackEntryTuple, err
+
+	case TvmStackEntryListType:
+		var tvmStackEntryList TvmStackEntryList
+		err := json.Unmarshal(*rawMsg, &tvmStackEntryList)
+		return &tvmStackEntryList, err
+
+	case TvmStackEntryUnsupportedType:
+		var tvmStackEntryUnsupported TvmStackEntryUnsupported
+		err := json.Unmarshal(*rawMsg, &tvmStackEntryUnsupported)
+		return &tvmStackEntryUnsupported, err
+
+	default:
+		return &UnknownTvmStackEntry{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalSmcMethodId(rawMsg *json.RawMessage) (SmcMethodId, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch SmcMethodIdEnum(typeName) {
+	case SmcMethodIdNumberType:
+		var smcMethodIdNumber SmcMethodIdNumber
+		err := json.Unmarshal(*rawMsg, &smcMethodIdNumber)
+		return &smcMethodIdNumber, err
+
+	case SmcMethodIdNameType:
+		var smcMethodIdName SmcMethodIdName
+		err := json.Unmarshal(*rawMsg, &smcMethodIdName)
+		return &smcMethodIdName, err
+
+	default:
+		return &UnknownSmcMethodId{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalUpdate(rawMsg *json.RawMessage) (Update, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var objMap map[string]interface{}
+	err := json.Unmarshal(*rawMsg, &objMap)
+	if err != nil {
+		return nil, err
+	}
+	if objMap == nil {
+		return nil, nil
+	}
+	typeName, _ := objMap["@type"].(string)
+
+	switch UpdateEnum(typeName) {
+	case UpdateSendLiteServerQueryType:
+		var updateSendLiteServerQuery UpdateSendLiteServerQuery
+		err := json.Unmarshal(*rawMsg, &updateSendLiteServerQuery)
+		return &updateSendLiteServerQuery, err
+
+	case UpdateSyncStateType:
+		var updateSyncState UpdateSyncState
+		err := json.Unmarshal(*rawMsg, &updateSyncState)
+		return &updateSyncState, err
+
+	default:
+		return &UnknownUpdate{Type: typeName, Raw: append(json.RawMessage(nil), *rawMsg...)}, nil
+	}
+}
+
+func unmarshalListOfDnsAction(rawMsg *json.RawMessage) ([]DnsAction, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := json.Unmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+	var items []DnsAction
+	for _, rawItem := range rawItems {
+		item, err := unmarshalDnsAction(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func unmarshalListOfTvmStackEntry(rawMsg *json.RawMessage) ([]TvmStackEntry, error) {
+
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var rawItems []*json.RawMessage
+	err := json.Unmarshal(*rawMsg, &rawItems)
+	if err != nil {
+		return nil, err
+	}
+	var items []TvmStackEntry
+	for _, rawItem := range rawItems {
+		item, err := unmarshalTvmStackEntry(rawItem)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
 	}
+	return items, nil
 }
@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalAbstractClasses(t *testing.T) {
+	data := `{"@type":"fullAccountState","balance":"100","account_state":{"@type":"wallet.v3.accountState",` +
+		`"wallet_id":"698983191","seqno":5},"revision":2}`
+	var state FullAccountState
+	if err := json.Unmarshal([]byte(data), &state); err != nil {
+		t.Fatal(err)
+	}
+	wallet, ok := state.AccountState.(*WalletV3AccountState)
+	if !ok || wallet.Seqno != 5 || wallet.WalletId != 698983191 || state.Balance != 100 || state.Revision != 2 {
+		t.Fatalf("unexpected account state: %#v", state)
+	}
+
+	data = `{"@type":"raw.transaction","in_msg":{"@type":"raw.message","value":"10","msg_data":{"@type":"msg.dataText",` +
+		`"text":"aGk="}},"out_msgs":[{"@type":"raw.message","msg_data":{"@type":"msg.dataRaw","body":"te6c"}}]}`
+	var tx RawTransaction
+	if err := json.Unmarshal([]byte(data), &tx); err != nil {
+		t.Fatal(err)
+	}
+	if text, ok := tx.InMsg.MsgData.(*MsgDataText); !ok || string(text.Text) != "hi" || tx.InMsg.Value != 10 {
+		t.Fatalf("unexpected in msg: %#v", tx.InMsg)
+	}
+	if raw, ok := tx.OutMsgs[0].MsgData.(*MsgDataRaw); len(tx.OutMsgs) != 1 || !ok || raw.Body.Base64() != "te6c" {
+		t.Fatalf("unexpected out msgs: %#v", tx.OutMsgs)
+	}
+
+	data = `{"@type":"actionDns","actions":[{"@type":"dns.actionDeleteAll"},{"@type":"dns.actionSet","entry":` +
+		`{"@type":"dns.entry","name":"foo","category":1,"entry":{"@type":"dns.entryDataText","text":"bar"}}}]}`
+	var action ActionDns
+	if err := json.Unmarshal([]byte(data), &action); err != nil {
+		t.Fatal(err)
+	}
+	if len(action.Actions) != 2 || action.Actions[0].GetDnsActionEnum() != DnsActionDeleteAllType {
+		t.Fatalf("unexpected actions: %#v", action.Actions)
+	}
+	set, ok := action.Actions[1].(*DnsActionSet)
+	if !ok {
+		t.Fatalf("unexpected action: %#v", action.Actions[1])
+	}
+	if text, ok := set.Entry.Entry.(*DnsEntryDataText); !ok || text.Text != "bar" {
+		t.Fatalf("unexpected entry: %#v", set.Entry)
+	}
+
+	var missing RawMessage
+	if err := json.Unmarshal([]byte(`{"@type":"raw.message","msg_data":null}`), &missing); err != nil || missing.MsgData != nil {
+		t.Fatalf("expected nil msg data, got %#v, %v", missing.MsgData, err)
+	}
+	if err := json.Unmarshal([]byte(`{"@type":"raw.message","msg_data":{"@type":"msg.dataFuture"}}`), &missing); err != nil {
+		t.Fatal(err)
+	}
+	if unknown, ok := missing.MsgData.(*UnknownMsgData); !ok || unknown.GetMsgDataEnum() != "msg.dataFuture" {
+		t.Fatalf("unexpected msg data: %#v", missing.MsgData)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	destination := NewAccountAddress("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	valid := NewMsgMessage(100, NewMsgDataRaw(Bytes{0xb5, 0xee, 0x9c, 0x72}, nil), destination, "")
+	if err := validate(NewActionMsg(true, []MsgMessage{*valid}), NewAccountAddress(""), &InputKey{}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		value validator
+		err   string
+	}{
+		{NewMsgMessage(100, nil, nil, ""), "msg.message: destination is required"},
+		{NewMsgMessage(-1, nil, destination, ""), "msg.message: amount is negative"},
+		{NewDnsActionSet(NewDnsEntry(1, NewDnsEntryDataSmcAddress(nil), "wallet")),
+			"dns.actionSet: entry: dns.entry: entry: dns.entryDataSmcAddress: smc_address is required"},
+		{NewActionMsg(true, []MsgMessage{*valid, *NewMsgMessage(1, nil, NewAccountAddress("EQD"), "")}),
+			"actionMsg: messages[1]: msg.message: destination: accountAddress: account_address is not valid"},
+		{NewInputKeyRegular(nil, SecureBytes("password")), "inputKeyRegular: key is required"},
+	}
+	for _, test := range tests {
+		err := validate(test.value)
+		if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+			t.Errorf("expected error %q, got %v", test.err, err)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package tonlibjson
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// Bytes is tl bytes, it is base64 in json. Unlike []byte nil is encoded as empty string, tonlib rejects null bytes
+type Bytes []byte
+
+// BytesFromBase64 decodes standard or url base64, with or without padding
+func BytesFromBase64(s string) (Bytes, error) {
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if b, err := encoding.DecodeString(s); err == nil {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid base64 %q", s)
+}
+
+// BytesFromHex decodes hex string
+func BytesFromHex(s string) (Bytes, error) {
+	return hex.DecodeString(s)
+}
+
+// Base64 returns standard base64 of the bytes
+func (b Bytes) Base64() string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// Hex returns lowercase hex of the bytes
+func (b Bytes) Hex() string {
+	return hex.EncodeToString(b)
+}
+
+// MarshalJSON marshals to base64 string
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Base64())
+}
+
+// UnmarshalJSON unmarshals from base64 string, null is decoded as nil
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*b = nil
+		return nil
+	}
+	decoded, err := BytesFromBase64(*s)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// Int256 is tl int256, tonlib sends it as base64 of 32 bytes
+type Int256 [32]byte
+
+// Int256FromBytes copies 32 bytes to Int256
+func Int256FromBytes(b []byte) (Int256, error) {
+	var value Int256
+	if len(b) != len(value) {
+		return value, fmt.Errorf("int256 must be %d bytes, got %d", len(value), len(b))
+	}
+	copy(value[:], b)
+	return value, nil
+}
+
+// Int256FromBase64 decodes base64 of 32 bytes
+func Int256FromBase64(s string) (Int256, error) {
+	b, err := BytesFromBase64(s)
+	if err != nil {
+		return Int256{}, err
+	}
+	return Int256FromBytes(b)
+}
+
+// Int256FromHex decodes hex of 32 bytes, e.g. a hash printed by explorers
+func Int256FromHex(s string) (Int256, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return Int256{}, err
+	}
+	return Int256FromBytes(b)
+}
+
+// Base64 returns standard base64 of the value
+func (value Int256) Base64() string {
+	return base64.StdEncoding.EncodeToString(value[:])
+}
+
+// Hex returns lowercase hex of the value
+func (value Int256) Hex() string {
+	return hex.EncodeToString(value[:])
+}
+
+// MarshalJSON marshals to base64 string
+func (value Int256) MarshalJSON() ([]byte, error) {
+	return json.Marshal(value.Base64())
+}
+
+// UnmarshalJSON unmarshals from base64 string, null and empty string are zero value
+func (value *Int256) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*value = Int256{}
+		return nil
+	}
+	decoded, err := Int256FromBase64(*s)
+	if err != nil {
+		return err
+	}
+	*value = decoded
+	return nil
+}
@@ -0,0 +1,35 @@
+// Package tonlibjson is the json encoding of tonlib api objects shared by the v1 and v2 packages:
+// tonlib errors, int64 and bytes. It does not link tonlib, the json client is in transport
+package tonlibjson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Error is an error response of tonlib
+type Error struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("error! code: %d msg: %s", err.Code, err.Message)
+}
+
+// ParseError decodes the error response
+func ParseError(raw []byte) error {
+	var tonErr Error
+	if err := json.Unmarshal(raw, &tonErr); err != nil {
+		return err
+	}
+	return &tonErr
+}
+
+// IsError reports whether the response is an error
+func IsError(raw []byte) bool {
+	var resp struct {
+		Type string `json:"@type"`
+	}
+	return json.Unmarshal(raw, &resp) == nil && resp.Type == "error"
+}
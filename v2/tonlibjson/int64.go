@@ -0,0 +1,28 @@
+package tonlibjson
+
+import (
+	"strconv"
+	"strings"
+)
+
+// JSONInt64 alias for int64, in order to deal with json big number problem:
+// tonlib sends and accepts int64 as strings
+type JSONInt64 int64
+
+// MarshalJSON marshals to json
+func (jsonInt *JSONInt64) MarshalJSON() ([]byte, error) {
+	intStr := strconv.FormatInt(int64(*jsonInt), 10)
+	return []byte(intStr), nil
+}
+
+// UnmarshalJSON unmarshals from json
+func (jsonInt *JSONInt64) UnmarshalJSON(b []byte) error {
+	intStr := string(b)
+	intStr = strings.Replace(intStr, "\"", "", 2)
+	jsonBigInt, err := strconv.ParseInt(intStr, 10, 64)
+	if err != nil {
+		return err
+	}
+	*jsonInt = JSONInt64(jsonBigInt)
+	return nil
+}
@@ -0,0 +1,91 @@
+package tonlibjson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSONInt64(t *testing.T) {
+	var value struct {
+		Quoted JSONInt64 `json:"quoted"`
+		Plain  JSONInt64 `json:"plain"`
+	}
+	if err := json.Unmarshal([]byte(`{"quoted":"-9223372036854775808","plain":42}`), &value); err != nil {
+		t.Fatal(err)
+	}
+	if value.Quoted != -9223372036854775808 || value.Plain != 42 {
+		t.Fatalf("unexpected values: %d, %d", value.Quoted, value.Plain)
+	}
+	if err := json.Unmarshal([]byte(`{"plain":"1.5"}`), &value); err == nil {
+		t.Fatal("expected error for not integer value")
+	}
+	b, err := json.Marshal(&value.Quoted)
+	if err != nil || string(b) != "-9223372036854775808" {
+		t.Fatalf("unexpected json: %s, %v", b, err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	raw := []byte(`{"@type":"error","code":500,"message":"LITE_SERVER_NOTREADY"}`)
+	if !IsError(raw) || IsError([]byte(`{"@type":"ok"}`)) || IsError([]byte(`not json`)) {
+		t.Fatal("unexpected IsError result")
+	}
+	err := ParseError(raw)
+	var tonErr *Error
+	if !errors.As(err, &tonErr) || tonErr.Code != 500 || tonErr.Message != "LITE_SERVER_NOTREADY" {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if err.Error() != "error! code: 500 msg: LITE_SERVER_NOTREADY" {
+		t.Fatalf("unexpected message: %s", err)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	var value struct {
+		Body  Bytes `json:"body"`
+		Empty Bytes `json:"empty"`
+		Null  Bytes `json:"null"`
+	}
+	if err := json.Unmarshal([]byte(`{"body":"3q2+7w==","empty":"","null":null}`), &value); err != nil {
+		t.Fatal(err)
+	}
+	if value.Body.Hex() != "deadbeef" || value.Empty == nil || len(value.Empty) != 0 || value.Null != nil {
+		t.Fatalf("unexpected bytes: %#v", value)
+	}
+	if b, err := BytesFromBase64("3q2-7w"); err != nil || b.Hex() != "deadbeef" {
+		t.Fatalf("url base64 is not decoded: %x, %v", b, err)
+	}
+	if err := json.Unmarshal([]byte(`{"body":"#"}`), &value); err == nil {
+		t.Fatal("expected error for invalid base64")
+	}
+	b, err := json.Marshal(struct {
+		Nil  Bytes `json:"nil"`
+		Body Bytes `json:"body"`
+	}{Body: Bytes{0xde, 0xad, 0xbe, 0xef}})
+	if err != nil || string(b) != `{"nil":"","body":"3q2+7w=="}` {
+		t.Fatalf("unexpected json: %s, %v", b, err)
+	}
+}
+
+func TestInt256(t *testing.T) {
+	hash := "be1e7d7e6c5a1b5d2e1e6e3bb1e1c6cbb8b4c1e5c4f7f0c2f3a6b5d4e3c2b1a0"
+	value, err := Int256FromHex(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Int256
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded != value || decoded.Hex() != hash {
+		t.Fatalf("unexpected round trip: %s, %v", decoded.Hex(), err)
+	}
+	if err := json.Unmarshal([]byte(`""`), &decoded); err != nil || decoded != (Int256{}) {
+		t.Fatalf("empty string is not zero: %v", err)
+	}
+	if _, err := Int256FromBase64("3q2+7w=="); err == nil {
+		t.Fatal("expected error for short value")
+	}
+}
@@ -0,0 +1,72 @@
+// Package transport is the cgo binding of the tonlib json client shared by the v1 and v2 packages:
+// requests and responses are json encoded tonlib api objects. It does not link tonlib,
+// each package links the libraries bundled with it, so v1 keeps its own tonlib version
+package transport
+
+//#cgo CFLAGS: -I${SRCDIR}/../lib
+//#include <stdlib.h>
+//#include "tonlib_client_json.h"
+import "C"
+import (
+	"errors"
+	"time"
+	"unsafe"
+)
+
+// ErrNoResponse is returned when tonlib has not responded after all retries
+var ErrNoResponse = errors.New("exceeded limit of retries to get json response from tonlib")
+
+// Transport is a tonlib json client, requests and responses are json encoded tonlib api objects
+type Transport struct {
+	client unsafe.Pointer
+}
+
+// New creates a new tonlib json client
+func New() *Transport {
+	return &Transport{client: C.tonlib_client_json_create()}
+}
+
+// Send sends the request asynchronously, the response is read with Receive
+func (transport *Transport) Send(req []byte) {
+	cs := C.CString(string(req))
+	defer C.free(unsafe.Pointer(cs))
+	C.tonlib_client_json_send(transport.client, cs)
+}
+
+// Receive waits for the next response or update for timeout seconds, nil is returned if there is none
+func (transport *Transport) Receive(timeout float64) []byte {
+	result := C.tonlib_client_json_receive(transport.client, C.double(timeout))
+	if result == nil {
+		return nil
+	}
+	return []byte(C.GoString(result))
+}
+
+// ReceiveRetrying calls Receive until a response is got, sleeping a second between retries
+func (transport *Transport) ReceiveRetrying(timeout float64, retries int) ([]byte, error) {
+	res := transport.Receive(timeout)
+	for num := 0; res == nil; num++ {
+		if num >= retries {
+			return nil, ErrNoResponse
+		}
+		time.Sleep(1 * time.Second)
+		res = transport.Receive(timeout)
+	}
+	return res, nil
+}
+
+// Execute executes the request synchronously, only some requests like setLogVerbosityLevel can be executed so
+func (transport *Transport) Execute(req []byte) []byte {
+	cs := C.CString(string(req))
+	defer C.free(unsafe.Pointer(cs))
+	result := C.tonlib_client_json_execute(transport.client, cs)
+	if result == nil {
+		return nil
+	}
+	return []byte(C.GoString(result))
+}
+
+// Destroy destroys the tonlib client, the transport must not be used after it
+func (transport *Transport) Destroy() {
+	C.tonlib_client_json_destroy(transport.client)
+}
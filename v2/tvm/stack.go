@@ -0,0 +1,129 @@
+// Package tvm decodes get method stack entries to Go values and builds entries for get method arguments.
+// The client converts tonlib tvm.StackEntry objects to entries: *big.Int for numbers, *cell.Cell for cells,
+// *cell.Slice for slices, TupleEntry, ListEntry and nil for null.
+package tvm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+)
+
+// Entry is a single stack entry
+type Entry = interface{}
+
+// TupleEntry is a tuple entry with its elements
+type TupleEntry []Entry
+
+// ListEntry is a list entry with its elements
+type ListEntry []Entry
+
+// Number decodes a number entry
+func Number(entry Entry) (*big.Int, error) {
+	number, ok := entry.(*big.Int)
+	if !ok || number == nil {
+		return nil, fmt.Errorf("expected number, got %T", entry)
+	}
+	return number, nil
+}
+
+// Int64 decodes a number entry which has to fit into int64
+func Int64(entry Entry) (int64, error) {
+	number, err := Number(entry)
+	if err != nil {
+		return 0, err
+	}
+	if !number.IsInt64() {
+		return 0, fmt.Errorf("number %s overflows int64", number)
+	}
+	return number.Int64(), nil
+}
+
+// Bool decodes a number used as boolean: zero is false, anything else (usually -1) is true
+func Bool(entry Entry) (bool, error) {
+	number, err := Number(entry)
+	if err != nil {
+		return false, err
+	}
+	return number.Sign() != 0, nil
+}
+
+// Cell decodes a cell entry
+func Cell(entry Entry) (*cell.Cell, error) {
+	c, ok := entry.(*cell.Cell)
+	if !ok || c == nil {
+		return nil, fmt.Errorf("expected cell, got %T", entry)
+	}
+	return c, nil
+}
+
+// Slice decodes a slice entry, the returned slice is a copy, so the entry can be decoded again
+func Slice(entry Entry) (*cell.Slice, error) {
+	slice, ok := entry.(*cell.Slice)
+	if !ok || slice == nil {
+		return nil, fmt.Errorf("expected slice, got %T", entry)
+	}
+	return slice.Copy(), nil
+}
+
+// Address decodes a slice holding MsgAddressInt, nil is returned for addr_none
+func Address(entry Entry) (*address.Address, error) {
+	slice, err := Slice(entry)
+	if err != nil {
+		return nil, err
+	}
+	return slice.LoadAddress()
+}
+
+// Tuple decodes tuple elements
+func Tuple(entry Entry) ([]Entry, error) {
+	tuple, ok := entry.(TupleEntry)
+	if !ok {
+		return nil, fmt.Errorf("expected tuple, got %T", entry)
+	}
+	return tuple, nil
+}
+
+// List decodes list elements
+func List(entry Entry) ([]Entry, error) {
+	list, ok := entry.(ListEntry)
+	if !ok {
+		return nil, fmt.Errorf("expected list, got %T", entry)
+	}
+	return list, nil
+}
+
+// IsNull reports whether the entry is a null, tonlib returns it as an empty list or an unsupported entry
+func IsNull(entry Entry) bool {
+	if entry == nil {
+		return true
+	}
+	list, ok := entry.(ListEntry)
+	return ok && len(list) == 0
+}
+
+// NumberEntry builds a number argument
+func NumberEntry(number *big.Int) Entry {
+	return number
+}
+
+// CellEntry builds a cell argument
+func CellEntry(c *cell.Cell) Entry {
+	return c
+}
+
+// SliceEntry builds a slice argument with the cell contents
+func SliceEntry(c *cell.Cell) Entry {
+	return c.BeginParse()
+}
+
+// AddressEntry builds slice argument holding the address
+func AddressEntry(addr *address.Address) (Entry, error) {
+	b := cell.NewBuilder()
+	if err := b.StoreAddress(addr); err != nil {
+		return nil, err
+	}
+	return SliceEntry(b.EndCell()), nil
+}
@@ -0,0 +1,62 @@
+package tvm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+)
+
+func TestStackEntries(t *testing.T) {
+	big1, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	number, err := Number(NumberEntry(big1))
+	if err != nil || number.Cmp(big1) != 0 {
+		t.Fatalf("unexpected number: %v %v", number, err)
+	}
+	if _, err := Int64(NumberEntry(big1)); err == nil {
+		t.Fatal("expected int64 overflow")
+	}
+	flag, err := Bool(NumberEntry(big.NewInt(-1)))
+	if err != nil || !flag {
+		t.Fatalf("unexpected bool: %v %v", flag, err)
+	}
+
+	addr := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	addrEntry, err := AddressEntry(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a slice entry is decoded from the beginning every time
+	for i := 0; i < 2; i++ {
+		decodedAddr, err := Address(addrEntry)
+		if err != nil || !decodedAddr.Equal(addr) {
+			t.Fatalf("unexpected address: %v %v", decodedAddr, err)
+		}
+	}
+
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(0xdeadbeef, 32); err != nil {
+		t.Fatal(err)
+	}
+	c := b.EndCell()
+	decodedCell, err := Cell(CellEntry(c))
+	if err != nil || string(decodedCell.Hash()) != string(c.Hash()) {
+		t.Fatalf("unexpected cell: %v %v", decodedCell, err)
+	}
+	if _, err := Cell(SliceEntry(c)); err == nil {
+		t.Fatal("expected type mismatch error")
+	}
+
+	tuple := TupleEntry{NumberEntry(big.NewInt(1)), CellEntry(c)}
+	items, err := Tuple(tuple)
+	if err != nil || len(items) != 2 {
+		t.Fatalf("unexpected tuple: %v %v", items, err)
+	}
+	if _, err := List(tuple); err == nil {
+		t.Fatal("expected type mismatch error")
+	}
+	if !IsNull(ListEntry{}) || !IsNull(nil) || IsNull(tuple) {
+		t.Fatal("unexpected null detection")
+	}
+}
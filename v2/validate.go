@@ -0,0 +1,35 @@
+package v2
+
+import (
+	"github.com/mercuryoio/tonlib-go/v2/address"
+)
+
+// validator is implemented by the generated tonlib api objects
+type validator interface {
+	Validate() error
+}
+
+// validate checks the values with their Validate methods, generated Client methods call it before sending params.
+// Hand-written types without Validate are skipped, nil objects are valid: required fields are checked by their parents
+func validate(values ...interface{}) error {
+	for _, value := range values {
+		v, ok := value.(validator)
+		if !ok {
+			continue
+		}
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateAddress checks raw and user-friendly account addresses, empty address is allowed:
+// tonlib treats it as not set, e.g. dns.resolve starts from the root dns then
+func validateAddress(value string) error {
+	if value == "" {
+		return nil
+	}
+	_, err := address.Parse(value)
+	return err
+}
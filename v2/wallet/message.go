@@ -0,0 +1,175 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+)
+
+// send modes of the wallet's SENDRAWMSG
+const (
+	SendModePayFeesSeparately = 1
+	SendModeIgnoreErrors      = 2
+	SendModeDestroyIfZero     = 32
+	SendModeCarryRemaining    = 64
+	SendModeCarryAllBalance   = 128
+
+	DefaultSendMode = SendModePayFeesSeparately | SendModeIgnoreErrors
+)
+
+// SendMode returns the send mode for Transfer.Mode, mode 0 is a valid mode too
+func SendMode(mode uint8) *uint8 {
+	return &mode
+}
+
+// Transfer describes one internal message sent by a wallet
+type Transfer struct {
+	Destination *address.Address
+	Amount      uint64 // nanograms
+	Bounce      bool
+	// Mode is the send mode, DefaultSendMode is used when it is nil
+	Mode *uint8
+	// Body is an arbitrary message body, Comment is used only when Body is nil
+	Body      *cell.Cell
+	Comment   string
+	StateInit *cell.Cell
+}
+
+// BuildComment creates a text comment body: zero op code followed by the text in snake format
+func BuildComment(text string) (*cell.Cell, error) {
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(0, 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreSnakeBytes([]byte(text)); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// BuildStateInit creates StateInit with the code and the data
+func BuildStateInit(code, data *cell.Cell) (*cell.Cell, error) {
+	b := cell.NewBuilder()
+	// split_depth:(Maybe (## 5)) special:(Maybe TickTock)
+	if err := b.StoreUInt(0, 2); err != nil {
+		return nil, err
+	}
+	if err := b.StoreMaybeRef(code); err != nil {
+		return nil, err
+	}
+	if err := b.StoreMaybeRef(data); err != nil {
+		return nil, err
+	}
+	// library:(HashmapE 256 SimpleLib)
+	if err := b.StoreBit(false); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// BuildInternalMessage creates an internal message cell for the transfer
+func BuildInternalMessage(transfer Transfer) (*cell.Cell, error) {
+	if transfer.Destination == nil {
+		return nil, fmt.Errorf("transfer destination is not set")
+	}
+	body := transfer.Body
+	if body == nil && transfer.Comment != "" {
+		var err error
+		body, err = BuildComment(transfer.Comment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b := cell.NewBuilder()
+	// int_msg_info$0 ihr_disabled:Bool bounce:Bool bounced:Bool
+	if err := b.StoreUInt(0, 1); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBit(true); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBit(transfer.Bounce); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBit(false); err != nil {
+		return nil, err
+	}
+	// src:MsgAddress is filled by the wallet
+	if err := b.StoreAddress(nil); err != nil {
+		return nil, err
+	}
+	if err := b.StoreAddress(transfer.Destination); err != nil {
+		return nil, err
+	}
+	// value:CurrencyCollection without extra currencies
+	if err := b.StoreCoins(transfer.Amount); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBit(false); err != nil {
+		return nil, err
+	}
+	// ihr_fee, fwd_fee, created_lt and created_at are filled by the validator
+	if err := b.StoreCoins(0); err != nil {
+		return nil, err
+	}
+	if err := b.StoreCoins(0); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(0, 64); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(0, 32); err != nil {
+		return nil, err
+	}
+	if err := storeInitAndBody(b, transfer.StateInit, body); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// BuildExternalMessage creates an inbound external message to dest
+func BuildExternalMessage(dest *address.Address, stateInit, body *cell.Cell) (*cell.Cell, error) {
+	b := cell.NewBuilder()
+	// ext_in_msg_info$10 src:addr_none
+	if err := b.StoreUInt(2, 2); err != nil {
+		return nil, err
+	}
+	if err := b.StoreAddress(nil); err != nil {
+		return nil, err
+	}
+	if err := b.StoreAddress(dest); err != nil {
+		return nil, err
+	}
+	// import_fee:Grams
+	if err := b.StoreCoins(0); err != nil {
+		return nil, err
+	}
+	if err := storeInitAndBody(b, stateInit, body); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// storeInitAndBody stores init:(Maybe (Either StateInit ^StateInit)) body:(Either X ^X), both as references
+func storeInitAndBody(b *cell.Builder, stateInit, body *cell.Cell) error {
+	if err := b.StoreBit(stateInit != nil); err != nil {
+		return err
+	}
+	if stateInit != nil {
+		if err := b.StoreBit(true); err != nil {
+			return err
+		}
+		if err := b.StoreRef(stateInit); err != nil {
+			return err
+		}
+	}
+	if body == nil {
+		return b.StoreBit(false)
+	}
+	if err := b.StoreBit(true); err != nil {
+		return err
+	}
+	return b.StoreRef(body)
+}
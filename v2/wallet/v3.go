@@ -0,0 +1,164 @@
+// Package wallet builds and signs wallet external messages entirely in Go, so a cold
+// machine can produce a BOC that is later pushed with RawSendMessage or `tongo sendFile`.
+package wallet
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/signer"
+)
+
+const (
+	// DefaultWalletID is the subwallet id tonlib uses by default, the workchain id is added to it
+	DefaultWalletID = 698983191
+	// MaxTransfers is the amount of messages wallet v3 can send in one query
+	MaxTransfers = 4
+
+	// wallet v3 revision 2 code, the one tonlib uses for wallet.v3 accounts
+	walletV3R2Code = "te6cckEBAQEAcQAA3v8AIN0gggFMl7ohggEznLqxn3Gw7UTQ0x/THzHXC//jBOCk8mCDCNcYINMf0x/TH/gjE7vyY+1E0NMf0x/T/9FRMrryoVFEuvKiBPkBVBBV+RDyo/gAkyDXSpbTB9QC+wDo0QGkyMsfyx/L/8ntVBC9ba0="
+)
+
+// V3 is a wallet v3 (revision 2) account
+type V3 struct {
+	PublicKey ed25519.PublicKey
+	WalletID  uint32
+	Workchain int32
+}
+
+// NewV3 creates wallet v3 for the key with the default wallet id of the workchain
+func NewV3(publicKey ed25519.PublicKey, workchain int32) *V3 {
+	return &V3{
+		PublicKey: publicKey,
+		WalletID:  uint32(DefaultWalletID + workchain),
+		Workchain: workchain,
+	}
+}
+
+// Code returns the wallet code cell
+func (w *V3) Code() (*cell.Cell, error) {
+	return cell.FromBOCBase64(walletV3R2Code)
+}
+
+// Data returns the initial wallet data: seqno, wallet id and public key
+func (w *V3) Data() (*cell.Cell, error) {
+	if len(w.PublicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key length: %d", len(w.PublicKey))
+	}
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(0, 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(uint64(w.WalletID), 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreBytes(w.PublicKey); err != nil {
+		return nil, err
+	}
+	return b.EndCell(), nil
+}
+
+// StateInit returns the wallet StateInit used to deploy it
+func (w *V3) StateInit() (*cell.Cell, error) {
+	code, err := w.Code()
+	if err != nil {
+		return nil, err
+	}
+	data, err := w.Data()
+	if err != nil {
+		return nil, err
+	}
+	return BuildStateInit(code, data)
+}
+
+// Address returns the wallet address derived from its StateInit
+func (w *V3) Address() (*address.Address, error) {
+	stateInit, err := w.StateInit()
+	if err != nil {
+		return nil, err
+	}
+	return address.New(w.Workchain, stateInit.Hash())
+}
+
+// SigningMessage builds the unsigned body: wallet id, valid until, seqno and the transfers
+func (w *V3) SigningMessage(seqno, validUntil uint32, transfers []Transfer) (*cell.Cell, error) {
+	if len(transfers) > MaxTransfers {
+		return nil, fmt.Errorf("wallet v3 can send up to %d messages, got %d", MaxTransfers, len(transfers))
+	}
+	b := cell.NewBuilder()
+	if err := b.StoreUInt(uint64(w.WalletID), 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(uint64(validUntil), 32); err != nil {
+		return nil, err
+	}
+	if err := b.StoreUInt(uint64(seqno), 32); err != nil {
+		return nil, err
+	}
+	for i, transfer := range transfers {
+		msg, err := BuildInternalMessage(transfer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build message #%d: %v", i, err)
+		}
+		mode := uint8(DefaultSendMode)
+		if transfer.Mode != nil {
+			mode = *transfer.Mode
+		}
+		if err := b.StoreUInt(uint64(mode), 8); err != nil {
+			return nil, err
+		}
+		if err := b.StoreRef(msg); err != nil {
+			return nil, err
+		}
+	}
+	return b.EndCell(), nil
+}
+
+// BuildTransfer builds and signs an external message with the transfers.
+// StateInit is attached for seqno 0, so the first transfer also deploys the wallet
+func (w *V3) BuildTransfer(s signer.Signer, seqno, validUntil uint32, transfers []Transfer) (*cell.Cell, error) {
+	if !bytes.Equal(w.PublicKey, s.PublicKey()) {
+		return nil, fmt.Errorf("signer key doesn't match wallet public key")
+	}
+	body, err := w.SigningMessage(seqno, validUntil, transfers)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := s.Sign(body.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %v", err)
+	}
+
+	signed := cell.NewBuilder()
+	if err := signed.StoreBytes(signature); err != nil {
+		return nil, err
+	}
+	if err := signed.StoreSlice(body.BeginParse()); err != nil {
+		return nil, err
+	}
+
+	dest, err := w.Address()
+	if err != nil {
+		return nil, err
+	}
+	var stateInit *cell.Cell
+	if seqno == 0 {
+		stateInit, err = w.StateInit()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return BuildExternalMessage(dest, stateInit, signed.EndCell())
+}
+
+// BuildTransferBOC is like BuildTransfer but returns serialized BOC ready for RawSendMessage
+func (w *V3) BuildTransferBOC(s signer.Signer, seqno, validUntil uint32, transfers []Transfer) ([]byte, error) {
+	msg, err := w.BuildTransfer(s, seqno, validUntil, transfers)
+	if err != nil {
+		return nil, err
+	}
+	return msg.ToBOC(), nil
+}
@@ -0,0 +1,140 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/mercuryoio/tonlib-go/v2/address"
+	"github.com/mercuryoio/tonlib-go/v2/cell"
+	"github.com/mercuryoio/tonlib-go/v2/signer"
+)
+
+func TestV3BuildTransfer(t *testing.T) {
+	s, err := signer.NewMemorySignerFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewV3(s.PublicKey(), address.BasechainID)
+	dest := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+
+	boc, err := w.BuildTransferBOC(s, 0, 1600000000, []Transfer{
+		{Destination: dest, Amount: 100000000, Bounce: true, Comment: "hello"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := cell.FromBOC(boc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ext_in_msg_info$10 src:addr_none dest import_fee
+	sl := msg.BeginParse()
+	if tag, _ := sl.LoadUInt(2); tag != 2 {
+		t.Fatalf("unexpected message tag: %d", tag)
+	}
+	if src, err := sl.LoadAddress(); err != nil || src != nil {
+		t.Fatalf("unexpected source: %v %v", src, err)
+	}
+	walletAddress, err := w.Address()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err := sl.LoadAddress(); err != nil || !d.Equal(walletAddress) {
+		t.Fatalf("unexpected destination: %v %v", d, err)
+	}
+	if _, err := sl.LoadCoins(); err != nil {
+		t.Fatal(err)
+	}
+	// state init is attached for the first transfer
+	if hasInit, _ := sl.LoadBit(); !hasInit {
+		t.Fatal("state init is missing for seqno 0")
+	}
+	if _, err := sl.LoadBit(); err != nil {
+		t.Fatal(err)
+	}
+	stateInit, err := sl.LoadRef()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stateInit.Hash(), walletAddress.Hash[:]) {
+		t.Fatal("state init doesn't match wallet address")
+	}
+	if _, err := sl.LoadBit(); err != nil {
+		t.Fatal(err)
+	}
+	body, err := sl.LoadRef()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := body.BeginParse()
+	signature, err := bs.LoadBytes(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsigned, err := bs.ToCell()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ed25519.Verify(s.PublicKey(), unsigned.Hash(), signature) {
+		t.Fatal("invalid signature")
+	}
+	if walletID, _ := bs.LoadUInt(32); walletID != DefaultWalletID {
+		t.Fatalf("unexpected wallet id: %d", walletID)
+	}
+}
+
+func TestV3BuildTransferWrongSigner(t *testing.T) {
+	s, err := signer.GenerateMemorySigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := signer.GenerateMemorySigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := NewV3(s.PublicKey(), address.BasechainID)
+	if _, err := w.BuildTransfer(other, 1, 0, nil); err == nil {
+		t.Fatal("expected key mismatch error")
+	}
+}
+
+func TestV3Code(t *testing.T) {
+	code, err := (&V3{}).Code()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// published hash of wallet-v3 revision 2 code, the code of wallet.v3 accounts deployed by tonlib
+	if hash := hex.EncodeToString(code.Hash()); hash != "84dafa449f98a6987789ba232358072bc0f76dc4524002a5d0918b9a75d2d599" {
+		t.Fatalf("unexpected code hash: %s", hash)
+	}
+}
+
+func TestV3SendMode(t *testing.T) {
+	w := NewV3(make(ed25519.PublicKey, ed25519.PublicKeySize), address.BasechainID)
+	dest := address.MustParse("EQDfYZhDfNJ0EePoT5ibfI9oG9bWIU6g872oX5h9rL5PHY9a")
+	for _, test := range []struct {
+		mode     *uint8
+		expected uint64
+	}{
+		{nil, DefaultSendMode},
+		{SendMode(0), 0},
+		{SendMode(SendModeCarryAllBalance), SendModeCarryAllBalance},
+	} {
+		body, err := w.SigningMessage(1, 1600000000, []Transfer{{Destination: dest, Mode: test.mode}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sl := body.BeginParse()
+		// wallet id, valid until and seqno
+		if _, err := sl.LoadBytes(12); err != nil {
+			t.Fatal(err)
+		}
+		if mode, err := sl.LoadUInt(8); err != nil || mode != test.expected {
+			t.Fatalf("unexpected mode %d, expected %d: %v", mode, test.expected, err)
+		}
+	}
+}